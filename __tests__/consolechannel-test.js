@@ -9,14 +9,14 @@ var consolechannel = consolechannel || require("../js/consolechannel.js");
 @param {string} url
 @param {string} body
 @param {function(string)} onSuccess
-@param {function()} onError
+@param {function(number, string)} onError
 */
 var PostArgs = function(url, body, onSuccess, onError) {
   this.url = url;
   this.struct = JSON.parse(body);
   /** @type {function(string)} */
   this.onSuccess = onSuccess;
-  /** @type {function()} */
+  /** @type {function(number, string)} */
   this.onError = onError;
 };
 
@@ -59,4 +59,196 @@ it("consolechannel multiple keystrokes are batched", () => {
   // on success: the batch is flushed
   env.posts[0].onSuccess('{}');
   expect(env.posts[1].struct["data"]).toBe("onetwo");
-});
\ No newline at end of file
+});
+it("consolechannel sendBreak posts to sendBreak", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+
+  channel.sendBreak();
+  expect(env.posts.length).toBe(1);
+  expect(env.posts[0].url).toBe("http://localhost:8080/sendBreak");
+  expect(env.posts[0].struct["data"]).toBe(undefined);
+});
+
+it("consolechannel sends the size with every request", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+
+  channel.setSize(80, 24);
+  expect(env.posts[0].url).toBe("http://localhost:8080/setSize");
+  expect(env.posts[0].struct["columns"]).toBe(80);
+  expect(env.posts[0].struct["rows"]).toBe(24);
+
+  // the first read carries the size, in case it arrives before setSize
+  channel.startRead(/** @type {!hterm.Terminal.IO} */ ({}));
+  expect(env.posts[1].url).toBe("http://localhost:8080/read");
+  expect(env.posts[1].struct["columns"]).toBe(80);
+  expect(env.posts[1].struct["rows"]).toBe(24);
+});
+
+it("consolechannel sends the cell size in whole pixels", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+
+  channel.setSize(80, 24, 8.6, 17);
+  expect(env.posts[0].struct["cell_width"]).toBe(9);
+  expect(env.posts[0].struct["cell_height"]).toBe(17);
+
+  // the cell size is optional
+  channel.setSize(100, 40);
+  expect(env.posts[1].struct["columns"]).toBe(100);
+  expect(env.posts[1].struct["cell_width"]).toBe(0);
+  expect(env.posts[1].struct["cell_height"]).toBe(0);
+});
+
+it("consolechannel broadcasts writes while in a group", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+
+  channel.joinGroup("servers");
+  expect(env.posts[0].url).toBe("http://localhost:8080/joinGroup");
+  expect(env.posts[0].struct["group"]).toBe("servers");
+
+  // writes only broadcast once the server has added the session
+  channel.write("a");
+  expect(env.posts[1].struct["broadcast"]).toBe(false);
+  env.posts[1].onSuccess('{}');
+  env.posts[0].onSuccess('{}');
+  channel.write("b");
+  expect(env.posts[2].struct["broadcast"]).toBe(true);
+  env.posts[2].onSuccess('{}');
+
+  channel.leaveGroup();
+  expect(env.posts[3].url).toBe("http://localhost:8080/leaveGroup");
+  channel.write("c");
+  expect(env.posts[4].struct["broadcast"]).toBe(false);
+});
+
+it("consolechannel group controls join and leave the typed group", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+  var name = {value: "", disabled: false};
+  var button = {
+    textContent: "",
+    /** @type {function()} */
+    onClick: function() {},
+    addEventListener: function(type, listener) { this.onClick = listener; },
+  };
+  consolechannel.installGroupControls(channel,
+      /** @type {!HTMLInputElement} */ (/** @type {?} */ (name)), /** @type {!Element} */ (/** @type {?} */ (button)));
+  expect(button.textContent).toBe("Join group");
+
+  // an empty name does nothing
+  button.onClick();
+  expect(env.posts.length).toBe(0);
+
+  name.value = " servers ";
+  button.onClick();
+  expect(env.posts[0].url).toBe("http://localhost:8080/joinGroup");
+  expect(env.posts[0].struct["group"]).toBe("servers");
+  expect(button.textContent).toBe("Join group");
+  env.posts[0].onSuccess('{}');
+  expect(button.textContent).toBe("Leave group");
+  expect(name.disabled).toBe(true);
+
+  button.onClick();
+  expect(env.posts[1].url).toBe("http://localhost:8080/leaveGroup");
+  expect(button.textContent).toBe("Join group");
+  expect(name.disabled).toBe(false);
+});
+
+it("consolechannel watches a shared session", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+  var output = "";
+  var io = /** @type {!hterm.Terminal.IO} */ ({writeUTF16: function(data) { output += data; }});
+
+  channel.startWatch(io, "token");
+  expect(env.posts[0].url).toBe("http://localhost:8080/watch");
+  expect(env.posts[0].struct["share"]).toBe("token");
+
+  // each response is written, then the next one is requested
+  env.posts[0].onSuccess('{"data": "hello"}');
+  expect(output).toBe("hello");
+  expect(env.posts[1].struct["share"]).toBe("token");
+  env.posts[1].onError(410, "session finished\n");
+  expect(env.posts.length).toBe(2);
+});
+
+it("consolechannel shows why the session was refused", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+  var output = "";
+  var io = /** @type {!hterm.Terminal.IO} */ ({writeUTF16: function(data) { output += data; }});
+
+  channel.startRead(io);
+  env.posts[0].onError(500, "internal error\n");
+  expect(output).toBe("");
+
+  channel.startRead(io);
+  env.posts[1].onError(429, "too many sessions: the limit is 2\n");
+  expect(output).toBe("\r\n[too many sessions: the limit is 2]\r\n");
+  expect(env.posts.length).toBe(2);
+});
+
+it("consolechannel creates, lists and revokes share links", () => {
+  var env = new FakeEnvironment();
+  var channel = new consolechannel.Channel(env, "http://localhost:8080/", {});
+
+  var created = null;
+  channel.createShare(60, function(share) { created = share; });
+  expect(env.posts[0].url).toBe("http://localhost:8080/createShare");
+  expect(env.posts[0].struct["ttl_seconds"]).toBe(60);
+  env.posts[0].onSuccess('{"id": "s1", "token": "t", "url": "watch.html?share=t", "expires": "2020-01-01T00:00:00Z"}');
+  expect(created).toEqual({id: "s1", url: "watch.html?share=t", expires: "2020-01-01T00:00:00Z"});
+
+  var listed = null;
+  channel.listShares(function(shares) { listed = shares; });
+  expect(env.posts[1].url).toBe("http://localhost:8080/listShares");
+  env.posts[1].onSuccess('{"shares": [{"id": "s1", "expires": "e", "viewers": [' +
+      '{"principal": "bob", "remote_addr": "192.0.2.1:1", "joined": "j", "last_seen": "l"}]}]}');
+  expect(listed).toEqual([{id: "s1", expires: "e",
+      viewers: [{principal: "bob", remoteAddr: "192.0.2.1:1", joined: "j", lastSeen: "l"}]}]);
+
+  var revoked = false;
+  channel.revokeShare("s1", function() { revoked = true; });
+  expect(env.posts[2].url).toBe("http://localhost:8080/revokeShare");
+  expect(env.posts[2].struct["share_id"]).toBe("s1");
+  env.posts[2].onSuccess('{}');
+  expect(revoked).toBe(true);
+});
+
+it("consolechannel measures the terminal's cell size", () => {
+  var appended = [];
+  var screen = {
+    appendChild: function(node) { appended.push(node); },
+    removeChild: function(node) { appended.splice(appended.indexOf(node), 1); },
+  };
+  var row = {
+    parentNode: screen,
+    getBoundingClientRect: function() { return {width: 800, height: 17}; },
+  };
+  var ruler = {
+    style: {cssText: ""},
+    textContent: "",
+    getBoundingClientRect: function() {
+      // the ruler must be measured in the rows' font
+      expect(appended.length).toBe(1);
+      return {width: this.textContent.length * 8.5, height: 17};
+    },
+  };
+  var terminal = {
+    getRowCount: function() { return 25; },
+    getRowNode: function(index) {
+      expect(index).toBe(24);
+      return row;
+    },
+    getDocument: function() {
+      return {createElement: function(tag) { return ruler; }};
+    },
+  };
+
+  var size = consolechannel.cellSize(/** @type {!hterm.Terminal} */ (/** @type {?} */ (terminal)));
+  expect(size).toEqual({width: 8.5, height: 17});
+  expect(appended.length).toBe(0);
+});
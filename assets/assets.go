@@ -0,0 +1,70 @@
+// Package assets contains the frontend files for the hterm commands: the shared hterm library,
+// and the HTML and compiled Javascript for each command.
+package assets
+
+import (
+	"embed"
+	"errors"
+	"io/fs"
+	"os"
+)
+
+// The static directory contains a directory per command, plus shared, which contains files
+// served to all commands.
+//
+//go:embed static
+var embedded embed.FS
+
+const sharedDir = "shared"
+
+// FS returns the files for the command named app, plus the shared files, as a single file
+// system. If dir is not empty, the files are read from that directory instead of the compiled
+// in files, so changes are visible without rebuilding. It must have the same layout as the
+// static directory in this package.
+func FS(app string, dir string) (fs.FS, error) {
+	var root fs.FS
+	if dir != "" {
+		root = os.DirFS(dir)
+	} else {
+		var err error
+		root, err = fs.Sub(embedded, "static")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if app == sharedDir || !fs.ValidPath(app) {
+		return nil, errors.New("assets: invalid app name: " + app)
+	}
+	info, err := fs.Stat(root, app)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.New("assets: not a directory: " + app)
+	}
+
+	appFS, err := fs.Sub(root, app)
+	if err != nil {
+		return nil, err
+	}
+	sharedFS, err := fs.Sub(root, sharedDir)
+	if err != nil {
+		return nil, err
+	}
+	return &overlayFS{appFS, sharedFS}, nil
+}
+
+// overlayFS opens files from app, or from shared if they do not exist in app.
+type overlayFS struct {
+	app    fs.FS
+	shared fs.FS
+}
+
+func (o *overlayFS) Open(name string) (fs.File, error) {
+	f, err := o.app.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return o.shared.Open(name)
+	}
+	return f, err
+}
@@ -0,0 +1,39 @@
+package assets
+
+import (
+	"io/fs"
+	"testing"
+)
+
+func TestFS(t *testing.T) {
+	for _, dir := range []string{"", "static"} {
+		for _, app := range []string{"htermshell", "htermmenu"} {
+			files, err := FS(app, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range []string{app + ".js", "hterm_all.js", "watch.html", "htermwatch.js"} {
+				_, err = fs.Stat(files, name)
+				if err != nil {
+					t.Errorf("dir=%#v app=%s: %s", dir, app, err.Error())
+				}
+			}
+		}
+	}
+
+	files, err := FS("htermshell", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = fs.Stat(files, "execute.html")
+	if err == nil {
+		t.Error("htermshell must not contain htermmenu's files")
+	}
+
+	for _, app := range []string{"shared", "missing", "../static", "htermshell/index.html"} {
+		_, err = FS(app, "")
+		if err == nil {
+			t.Errorf("FS(%#v) must return an error", app)
+		}
+	}
+}
@@ -0,0 +1,642 @@
+"use strict";
+
+/** @const */
+var consolechannel = {};
+
+/**
+@typedef {{data: (string|undefined), broadcast: (boolean|undefined), group: (string|undefined),
+  share: (string|undefined), ttlSeconds: (number|undefined), shareId: (string|undefined)}}
+*/
+consolechannel.PartialRequest;
+
+/**
+A read-only link to the session. url is relative to the channel's url.
+@typedef {{id: string, url: string, expires: string}}
+*/
+consolechannel.ShareLink;
+/** @typedef {{principal: string, remoteAddr: string, joined: string, lastSeen: string}} */
+consolechannel.Viewer;
+/** @typedef {{id: string, expires: string, viewers: !Array<!consolechannel.Viewer>}} */
+consolechannel.ShareStatus;
+
+/**
+@typedef {{data: string, share: ?consolechannel.ShareLink,
+  shares: !Array<!consolechannel.ShareStatus>}}
+*/
+consolechannel.ResponseUnion;
+
+/** @record */
+consolechannel.Environment = function() {};
+/**
+@param {!ArrayBufferView} typedArray
+@return {!ArrayBufferView}
+@throws {Error}
+*/
+consolechannel.Environment.prototype.getRandomValues = function(typedArray) {};
+
+/**
+Sends an HTTP POST to url with body requestSerialized. onError is called with the HTTP status
+and the response body, which is the error message.
+@param {string} url
+@param {string} requestSerialized
+@param {function(string)} onSuccess
+@param {function(number, string)} onError
+*/
+consolechannel.Environment.prototype.post = function(url, requestSerialized, onSuccess, onError) {};
+
+/**
+@constructor
+@implements {consolechannel.Environment}
+*/
+consolechannel.BrowserEnvironment = function() {};
+/** @override */
+consolechannel.BrowserEnvironment.prototype.getRandomValues = function(typedArray) {
+  return window.crypto.getRandomValues(typedArray);
+};
+/** @override */
+consolechannel.BrowserEnvironment.prototype.post = function(url, requestSerialized, onSuccess, onError) {
+  var request = new XMLHttpRequest();
+
+  function onReadyStateChange() {
+    if (request.readyState != XMLHttpRequest.DONE) {
+      return;
+    }
+    if (request.status != 200) {
+      onError(request.status, request.responseText);
+      return;
+    }
+
+    console.log("write success");
+    onSuccess(request.responseText);
+  }
+  request.onreadystatechange = onReadyStateChange;
+  request.open("POST", url, true);
+  // allow cookies for when we eventually get there
+  request.withCredentials = true;
+
+  request.send(requestSerialized);
+};
+
+
+/**
+@constructor
+@struct
+@param {!consolechannel.Environment} env
+@param {string} url
+@param {!Object<string, string>} extra
+*/
+consolechannel.Channel = function(env, url, extra) {
+  /** @type {!consolechannel.Environment} */
+  this.env_ = env;
+  /** @type {string} */
+  this.url_ = url;
+  /** @type {!Object<string, string>} */
+  this.extra_ = extra;
+
+  // generate a 32-byte unique random id as a base64-encoded string
+  var array = new Uint8Array(32);
+  this.env_.getRandomValues(array);
+  var s = "";
+  for(var i = 0; i < array.byteLength; i++) {
+    s += String.fromCharCode(array[i]);
+  }
+  /** @type {string} */
+  this.session_id_ = btoa(s);
+
+  // the terminal size: sent with every request so the session starts at the right size
+  /** @type {number} */
+  this.columns_ = 0;
+  /** @type {number} */
+  this.rows_ = 0;
+  // the size of a character cell in pixels, or 0 if unknown
+  /** @type {number} */
+  this.cellWidth_ = 0;
+  /** @type {number} */
+  this.cellHeight_ = 0;
+
+  /** @type {boolean} */
+  this.writePending_ = false;
+  /** @type {string} */
+  this.writeBuffer_ = "";
+
+  // true while the session is in a group: writes are sent to every session in the group
+  /** @type {boolean} */
+  this.broadcast_ = false;
+};
+
+/**
+@private
+@param {string} path
+@param {!consolechannel.PartialRequest} struct
+@param {function(!consolechannel.ResponseUnion)} onSuccess
+@param {function(number, string)} onError
+*/
+consolechannel.Channel.prototype.postStruct_ = function(path, struct, onSuccess, onError) {
+  var jsonDict = {};
+  // common
+  jsonDict["session_id"] = this.session_id_
+  jsonDict["extra"] = this.extra_;
+  jsonDict["columns"] = this.columns_;
+  jsonDict["rows"] = this.rows_;
+  jsonDict["cell_width"] = this.cellWidth_;
+  jsonDict["cell_height"] = this.cellHeight_;
+  // write
+  jsonDict["data"] = struct.data;
+  jsonDict["broadcast"] = struct.broadcast;
+  // joinGroup
+  jsonDict["group"] = struct.group;
+  // watch
+  jsonDict["share"] = struct.share;
+  // createShare and revokeShare
+  jsonDict["ttl_seconds"] = struct.ttlSeconds;
+  jsonDict["share_id"] = struct.shareId;
+  var serialized = JSON.stringify(jsonDict)
+
+  /** @param {string} responseSerialized */
+  var rawOnSucccess = function(responseSerialized) {
+    // convert a raw JSON message to a Closure compiler friendly struct
+    var raw = JSON.parse(responseSerialized);
+    if (typeof raw !== "object") {
+      console.error("unexpected type from server response: " + typeof raw);
+      onError(0, "unexpected response from server");
+      return
+    }
+    var struct = {data: raw["data"], share: null, shares: []};
+    // createShare
+    if (typeof raw["token"] === "string") {
+      struct.share = {id: raw["id"], url: raw["url"], expires: raw["expires"]};
+    }
+    // listShares
+    if (raw["shares"] instanceof Array) {
+      struct.shares = raw["shares"].map(function(rawShare) {
+        var viewers = rawShare["viewers"].map(function(rawViewer) {
+          return {principal: rawViewer["principal"], remoteAddr: rawViewer["remote_addr"],
+            joined: rawViewer["joined"], lastSeen: rawViewer["last_seen"]};
+        });
+        return {id: rawShare["id"], expires: rawShare["expires"], viewers: viewers};
+      });
+    }
+    onSuccess(struct);
+  }
+
+  this.env_.post(this.url_ + path, serialized, rawOnSucccess, onError);
+};
+
+/**
+Write data to the terminal program/server. Stolen from
+nassh.Stream.GoogleRelay.prototype.asyncOpen_.
+
+@param {string} data
+*/
+consolechannel.Channel.prototype.write = function(data) {
+  if (this.writePending_) {
+    this.writeBuffer_ += data;
+  } else {
+    console.log("write calling doSend");
+    this.doSend_(data);
+  }
+};
+
+/**
+@param {string} data
+*/
+consolechannel.Channel.prototype.doSend_ = function(data) {
+  console.log("doSend");
+  if (this.writePending_) {
+    throw "writePending_ must be false";
+  }
+  if (this.writeBuffer_.length != 0) {
+    throw "writeBuffer_ must be empty";
+  }
+  if (data.length == 0) {
+    throw "data must not be empty";
+  }
+  this.writePending_ = true;
+
+  var self = this;
+  var onSuccess = function() {
+    self.onWriteComplete_(true);
+  };
+  var onError = function() {
+    self.onWriteComplete_(false);
+  };
+
+  var request = {data: data, broadcast: this.broadcast_};
+  this.postStruct_("write", request, onSuccess, onError);
+};
+
+/**
+@private
+@param {boolean} success
+*/
+consolechannel.Channel.prototype.onWriteComplete_ = function(success) {
+  // TODO: distinguish between success and failure
+  if (!this.writePending_) {
+    throw "bug: write callback without writePending_";
+  }
+  if (!success) {
+    console.log("write error occurred TODO: handle?");
+  }
+
+  this.writePending_ = false;
+  if (this.writeBuffer_.length > 0) {
+    var data = this.writeBuffer_;
+    this.writeBuffer_ = "";
+    this.doSend_(data);
+  }
+};
+
+/**
+Sets the terminal size to rows, cols. This should be called before startRead, so the session
+starts at the right size. The optional cell size is the size of a character in pixels, which
+is used by programs that draw images.
+@param {number} columns
+@param {number} rows
+@param {number=} opt_cellWidth
+@param {number=} opt_cellHeight
+*/
+consolechannel.Channel.prototype.setSize = function(columns, rows, opt_cellWidth, opt_cellHeight) {
+  function onError() {
+    console.error("setSize onError");
+  }
+
+  function onSuccess() {
+    console.log("setSize success");
+  }
+
+  this.columns_ = columns;
+  this.rows_ = rows;
+  // the server requires whole pixels, and both dimensions or neither
+  if (opt_cellWidth && opt_cellHeight) {
+    this.cellWidth_ = Math.round(opt_cellWidth);
+    this.cellHeight_ = Math.round(opt_cellHeight);
+  } else {
+    this.cellWidth_ = 0;
+    this.cellHeight_ = 0;
+  }
+  this.postStruct_("setSize", {}, onSuccess, onError);
+};
+
+/**
+Sends a break to the terminal program/server e.g. a serial break to a serial port.
+*/
+consolechannel.Channel.prototype.sendBreak = function() {
+  function onError() {
+    console.error("sendBreak onError");
+  }
+
+  function onSuccess() {
+    console.log("sendBreak success");
+  }
+
+  this.postStruct_("sendBreak", {}, onSuccess, onError);
+};
+
+/**
+Adds the session to group, leaving any group it is in. Once the server has added it, input
+written to the channel is sent to every session in the group, and opt_onJoined is called.
+@param {string} group
+@param {function()=} opt_onJoined
+*/
+consolechannel.Channel.prototype.joinGroup = function(group, opt_onJoined) {
+  var self = this;
+
+  function onError() {
+    console.error("joinGroup onError");
+  }
+
+  function onSuccess() {
+    console.log("joinGroup success");
+    self.broadcast_ = true;
+    if (opt_onJoined) {
+      opt_onJoined();
+    }
+  }
+
+  this.postStruct_("joinGroup", {group: group}, onSuccess, onError);
+};
+
+/**
+Removes the session from its group. Input written afterwards is only sent to this session.
+*/
+consolechannel.Channel.prototype.leaveGroup = function() {
+  function onError() {
+    console.error("leaveGroup onError");
+  }
+
+  function onSuccess() {
+    console.log("leaveGroup success");
+  }
+
+  this.broadcast_ = false;
+  this.postStruct_("leaveGroup", {}, onSuccess, onError);
+};
+
+/**
+Start reading data that should be written to io.
+@param {!hterm.Terminal.IO} io
+*/
+consolechannel.Channel.prototype.startRead = function(io) {
+  // if (typeof io === "undefined" || typeof io.writeUTF16 === undefined) {
+  //   throw "Channel.startRead: io.writeUTF16 must be defined";
+  // }
+  var self = this;
+
+  /**
+  @param {number} status
+  @param {string} message
+  */
+  function onError(status, message) {
+    console.error("read onError", status);
+    if (status == 429) {
+      // the server refused to start the session: show the reason
+      io.writeUTF16("\r\n[" + message.trim() + "]\r\n");
+    }
+  }
+
+  /** @param {!consolechannel.ResponseUnion} struct */
+  function onSuccess(struct) {
+    console.log("read success; length:", struct.data.length);
+    io.writeUTF16(struct.data);
+    // read again!
+    self.startRead(io);
+  }
+
+  this.postStruct_("read", {}, onSuccess, onError)
+};
+
+/**
+Start watching the session shared with share, a token returned by the server's createShare.
+The session's output is written to io. The channel's session id identifies this viewer.
+@param {!hterm.Terminal.IO} io
+@param {string} share
+*/
+consolechannel.Channel.prototype.startWatch = function(io, share) {
+  var self = this;
+
+  function onError() {
+    console.error("watch onError");
+    io.writeUTF16("\r\n[the shared session ended, or the link expired]\r\n");
+  }
+
+  /** @param {!consolechannel.ResponseUnion} struct */
+  function onSuccess(struct) {
+    io.writeUTF16(struct.data);
+    self.startWatch(io, share);
+  }
+
+  this.postStruct_("watch", {share: share}, onSuccess, onError);
+};
+
+/**
+Creates a read-only link to the session that expires after ttlSeconds, or the server's default
+if it is 0, and calls onCreated with it.
+@param {number} ttlSeconds
+@param {function(!consolechannel.ShareLink)} onCreated
+*/
+consolechannel.Channel.prototype.createShare = function(ttlSeconds, onCreated) {
+  function onError() {
+    console.error("createShare onError");
+  }
+
+  /** @param {!consolechannel.ResponseUnion} struct */
+  function onSuccess(struct) {
+    if (struct.share != null) {
+      onCreated(struct.share);
+    }
+  }
+
+  this.postStruct_("createShare", {ttlSeconds: ttlSeconds}, onSuccess, onError);
+};
+
+/**
+Calls onList with the session's share links that have not expired, and who is watching them.
+@param {function(!Array<!consolechannel.ShareStatus>)} onList
+*/
+consolechannel.Channel.prototype.listShares = function(onList) {
+  function onError() {
+    console.error("listShares onError");
+  }
+
+  /** @param {!consolechannel.ResponseUnion} struct */
+  function onSuccess(struct) {
+    onList(struct.shares);
+  }
+
+  this.postStruct_("listShares", {}, onSuccess, onError);
+};
+
+/**
+Revokes the share link with id, disconnecting its viewers, then calls onRevoked.
+@param {string} id
+@param {function()} onRevoked
+*/
+consolechannel.Channel.prototype.revokeShare = function(id, onRevoked) {
+  function onError() {
+    console.error("revokeShare onError");
+  }
+
+  function onSuccess() {
+    onRevoked();
+  }
+
+  this.postStruct_("revokeShare", {shareId: id}, onSuccess, onError);
+};
+
+/**
+Makes button join the group typed in name, so typing in the terminal is sent to every session
+in it, then leave it when clicked again.
+@param {!consolechannel.Channel} channel
+@param {!HTMLInputElement} name
+@param {!Element} button
+*/
+consolechannel.installGroupControls = function(channel, name, button) {
+  var joined = false;
+  button.textContent = "Join group";
+  button.addEventListener("click", function() {
+    if (joined) {
+      channel.leaveGroup();
+      joined = false;
+      name.disabled = false;
+      button.textContent = "Join group";
+      return;
+    }
+    var group = name.value.trim();
+    if (group == "") {
+      return;
+    }
+    channel.joinGroup(group, function() {
+      joined = true;
+      name.disabled = true;
+      button.textContent = "Leave group";
+    });
+  });
+};
+
+/**
+Returns the size of terminal's character cells in pixels, which may be fractional. hterm only
+exposes it on its private scroll port, so this measures a line of text in the terminal's font.
+@param {!hterm.Terminal} terminal
+@return {{width: number, height: number}}
+*/
+consolechannel.cellSize = function(terminal) {
+  // rows have the terminal's font, and hterm sets their height to the cell height
+  var row = terminal.getRowNode(terminal.getRowCount() - 1);
+  var ruler = terminal.getDocument().createElement("span");
+  ruler.style.cssText = "position: absolute; visibility: hidden; white-space: pre;";
+  // measure many characters to get the fractional width
+  var length = 100;
+  ruler.textContent = new Array(length + 1).join("X");
+  row.parentNode.appendChild(ruler);
+  var width = ruler.getBoundingClientRect().width / length;
+  row.parentNode.removeChild(ruler);
+  return {width: width, height: row.getBoundingClientRect().height};
+};
+
+// export in order to be required by node
+if (typeof module !== "undefined" && module.exports) {
+  // can't just assign consolechannel due to Closure namespace aliasing rules
+  module.exports = {
+    Channel: consolechannel.Channel,
+    cellSize: consolechannel.cellSize,
+    installGroupControls: consolechannel.installGroupControls,
+  };
+}
+/** @const */
+var tilesDivId = "tiles";
+/** @const */
+var addButtonId = "add";
+/** @const */
+var groupNameId = "group-name";
+
+// number of sessions to open if the sessions query parameter is not set
+/** @const */
+var defaultSessions = 2;
+
+/**
+Returns a random group name, so each page has its own group.
+@return {string}
+*/
+var randomGroupName = function() {
+  var array = new Uint8Array(8);
+  window.crypto.getRandomValues(array);
+  var s = "";
+  for (var i = 0; i < array.byteLength; i++) {
+    s += ("0" + array[i].toString(16)).slice(-2);
+  }
+  return s;
+};
+
+/**
+Returns the number of sessions to open from the sessions query parameter.
+@return {number}
+*/
+var initialSessions = function() {
+  var match = /[?&]sessions=(\d+)/.exec(window.location.search);
+  if (match == null) {
+    return defaultSessions;
+  }
+  return parseInt(match[1], 10);
+};
+
+/**
+Adds a tile with a new session to parent. Input typed in a tile that is in the group is sent
+to every session in the group; unchecking the box removes the session from the group.
+@param {!Element} parent
+@param {string} group
+@param {number} index
+*/
+var addTile = function(parent, group, index) {
+  var tile = document.createElement("div");
+  tile.className = "tile";
+  var header = document.createElement("label");
+  header.className = "tile-header";
+  var checkbox = document.createElement("input");
+  checkbox.type = "checkbox";
+  checkbox.checked = true;
+  header.appendChild(checkbox);
+  var title = document.createElement("span");
+  title.textContent = "session " + index;
+  header.appendChild(title);
+  tile.appendChild(header);
+  var terminalElement = document.createElement("div");
+  terminalElement.className = "tile-terminal";
+  tile.appendChild(terminalElement);
+  parent.appendChild(tile);
+
+  /** @type{!hterm.Terminal} */
+  var terminal = new hterm.Terminal("tile" + index);
+
+  terminal.onTerminalReady = function() {
+    var io = terminal.io.push();
+    var channel = new consolechannel.Channel(new consolechannel.BrowserEnvironment(), "", {});
+
+    function send(str) {
+      channel.write(str);
+    }
+    io.onVTKeystroke = send;
+    io.sendString = send;
+
+    /**
+    @param {number} columns
+    @param {number} rows
+    */
+    function setSize(columns, rows) {
+      var cellSize = consolechannel.cellSize(terminal);
+      channel.setSize(columns, rows, cellSize.width, cellSize.height);
+    }
+    io.onTerminalResize = setSize;
+
+    terminal.setCursorPosition(0, 0);
+    terminal.setCursorVisible(true);
+    terminal.installKeyboard();
+
+    // send the initial size first: the first request starts the session
+    setSize(terminal.screenSize.width, terminal.screenSize.height);
+    channel.joinGroup(group);
+    channel.startRead(io);
+
+    checkbox.addEventListener("change", function() {
+      if (checkbox.checked) {
+        channel.joinGroup(group);
+      } else {
+        channel.leaveGroup();
+      }
+    });
+  };
+
+  terminal.decorate(/** @type{!HTMLDivElement} */ (terminalElement));
+};
+
+/** called on document load */
+var groupLoaded = function() {
+  var tiles = document.getElementById(tilesDivId);
+  if (tiles == null) {
+    throw new Error("Tiles element id " + tilesDivId + " does not exist");
+  }
+
+  // from https://chromium.googlesource.com/apps/libapps/+/master/hterm/doc/embed.md
+  hterm.defaultStorage = new lib.Storage.Memory();
+
+  var group = randomGroupName();
+  var groupName = document.getElementById(groupNameId);
+  if (groupName != null) {
+    groupName.textContent = group;
+  }
+
+  var count = 0;
+  var sessions = initialSessions();
+  for (; count < sessions; count++) {
+    addTile(tiles, group, count + 1);
+  }
+
+  var addButton = document.getElementById(addButtonId);
+  if (addButton != null) {
+    addButton.addEventListener("click", function() {
+      count++;
+      addTile(tiles, group, count);
+    });
+  }
+};
+
+document.addEventListener('DOMContentLoaded', groupLoaded);
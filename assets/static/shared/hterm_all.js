@@ -17150,11 +17150,3 @@ lib.resource.add('hterm/git/HEAD', 'text/plain',
 'git rev-parse HEAD' +
 ''
 );
-
-
-function g(){}g.prototype.getRandomValues=function(a){return window.crypto.getRandomValues(a)};function h(a,b,c,e){var d=new XMLHttpRequest;d.onreadystatechange=function(){d.readyState==XMLHttpRequest.DONE&&(200!=d.status?e():(console.log("write success"),c(d.responseText)))};d.open("POST",a,!0);d.withCredentials=!0;d.send(b)}
-function k(a,b,c){this.c=a;this.i=b;this.g=c;a=new Uint8Array(32);this.c.getRandomValues(a);b="";for(c=0;c<a.byteLength;c++)b+=String.fromCharCode(a[c]);this.h=btoa(b);this.b=!1;this.a=""}function l(a,b,c,e,d){var f={};f.session_id=a.h;f.extra=a.g;f.data=c.data;f.columns=c.f;f.rows=c.rows;c=JSON.stringify(f);h(a.i+b,c,function(a){a=JSON.parse(a);"object"!==typeof a?(console.error("unexpected type from server response: "+typeof a),d()):e({data:a.data})},d)}
-k.prototype.write=function(a){this.b?this.a+=a:(console.log("write calling doSend"),m(this,a))};function m(a,b){console.log("doSend");if(a.b)throw"writePending_ must be false";if(a.a.length)throw"writeBuffer_ must be empty";if(!b.length)throw"data must not be empty";a.b=!0;l(a,"write",{data:b},function(){n(a,!0)},function(){n(a,!1)})}
-function n(a,b){if(!a.b)throw"bug: write callback without writePending_";b||console.log("write error occurred TODO: handle?");a.b=!1;if(0<a.a.length){var c=a.a;a.a="";m(a,c)}}function p(a,b,c){l(a,"setSize",{f:b,rows:c},function(){console.log("setSize success")},function(){console.error("setSize onError")})}function q(a,b){l(a,"read",{},function(c){console.log("read success; length:",c.data.length);b.writeUTF16(c.data);q(a,b)},function(){console.error("read onError")})}
-"undefined"!==typeof module&&module.exports&&(module.exports={j:k});document.addEventListener("DOMContentLoaded",function(){var a=document.getElementById("terminal");if(!a)throw Error("Terminal element id terminal does not exist");if("DIV"!=a.nodeName)throw Error("Element with id terminal must be a div; not "+a.nodeName);hterm.defaultStorage=new lib.Storage.Memory;var b=new hterm.Terminal("default");b.onTerminalReady=function(){function a(a){console.log("key/send from terminal:",a);d.write(a,function(a){console.log("send onComplete",a)})}var e=b.io.push(),d=new k(new g,
-"/",{});e.onVTKeystroke=a;e.sendString=a;e.onTerminalResize=function(a,b){console.log("onTerminalResize",a,b);p(d,a,b)};console.log("hello terminal.onTerminalReady()");b.setCursorPosition(0,0);b.setCursorVisible(!0);b.installKeyboard();q(d,e)};console.log("decorating",a);b.decorate(a)});
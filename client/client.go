@@ -0,0 +1,271 @@
+// Package client connects to an hterm Server, using the same protocol as the Javascript
+// consolechannel.Channel. It can be used to script terminal programs and in integration tests.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evanj/hterm"
+)
+
+// Session is a terminal session on a Server. Read and Write may be called concurrently, but
+// Read must not be called concurrently with itself.
+type Session struct {
+	url        string
+	httpClient *http.Client
+	id         string
+	extra      map[string]string
+
+	// cancels requests when the session is closed
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu   sync.Mutex
+	size hterm.Size
+	// set when the session has finished: requests would start a new session with the same id
+	finished bool
+
+	// output that was received but not yet returned by Read
+	pending []byte
+}
+
+// Option configures a Session.
+type Option func(*Session)
+
+// WithHTTPClient sends requests with client instead of http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *Session) {
+		s.httpClient = client
+	}
+}
+
+// WithExtra sends extra to the server's SessionStarter, like ConsoleExtra in the web page.
+func WithExtra(extra map[string]string) Option {
+	return func(s *Session) {
+		s.extra = extra
+	}
+}
+
+// WithSize starts the session at size instead of the server's default.
+func WithSize(size hterm.Size) Option {
+	return func(s *Session) {
+		s.size = size
+	}
+}
+
+// request is the JSON request; see requestUnion in the hterm package.
+type request struct {
+	SessionID  string            `json:"session_id"`
+	Extra      map[string]string `json:"extra"`
+	Columns    int               `json:"columns"`
+	Rows       int               `json:"rows"`
+	CellWidth  int               `json:"cell_width"`
+	CellHeight int               `json:"cell_height"`
+	Data       string            `json:"data,omitempty"`
+	TTLSeconds int               `json:"ttl_seconds,omitempty"`
+	ShareID    string            `json:"share_id,omitempty"`
+}
+
+type readResponse struct {
+	Data string `json:"data"`
+}
+
+// Open starts a new session on the Server at url, which is the path the server's endpoints are
+// under (e.g. http://localhost:8080/). ctx only applies to starting the session.
+func Open(ctx context.Context, url string, options ...Option) (*Session, error) {
+	if !strings.HasSuffix(url, "/") {
+		url += "/"
+	}
+	id := make([]byte, 32)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Session{url: url, httpClient: http.DefaultClient, id: base64.StdEncoding.EncodeToString(id)}
+	for _, option := range options {
+		option(s)
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	err = s.post(ctx, "open", request{}, nil)
+	if err != nil {
+		s.cancel()
+		return nil, err
+	}
+	return s, nil
+}
+
+// post sends a request to endpoint with the fields of req that are specific to the endpoint, and
+// decodes the response into out, if it is not nil.
+func (s *Session) post(ctx context.Context, endpoint string, req request, out interface{}) error {
+	s.mu.Lock()
+	finished := s.finished
+	req.SessionID, req.Extra = s.id, s.extra
+	req.Columns, req.Rows, req.CellWidth, req.CellHeight =
+		s.size.Columns, s.size.Rows, s.size.CellWidth, s.size.CellHeight
+	s.mu.Unlock()
+	if finished {
+		return io.EOF
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, s.url+endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp, err := s.httpClient.Do(httpReq.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	err2 := resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if err2 != nil {
+		return err2
+	}
+
+	if resp.StatusCode == http.StatusGone {
+		s.finish()
+		return io.EOF
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("client: %s: %s: %s", endpoint, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+func (s *Session) finish() {
+	s.mu.Lock()
+	s.finished = true
+	s.mu.Unlock()
+}
+
+// Read returns terminal output, waiting until some is available. It returns io.EOF when the
+// session has finished.
+func (s *Session) Read(p []byte) (int, error) {
+	for len(s.pending) == 0 {
+		resp := &readResponse{}
+		err := s.post(s.ctx, "read", request{}, resp)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return 0, io.EOF
+			}
+			return 0, err
+		}
+		s.pending = []byte(resp.Data)
+	}
+	n := copy(p, s.pending)
+	s.pending = s.pending[n:]
+	return n, nil
+}
+
+// Write sends p to the terminal as input. The protocol sends strings, so p must be UTF-8.
+func (s *Session) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	err := s.post(s.ctx, "write", request{Data: string(p)}, nil)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Resize changes the terminal size.
+func (s *Session) Resize(size hterm.Size) error {
+	s.mu.Lock()
+	s.size = size
+	s.mu.Unlock()
+	return s.post(s.ctx, "setSize", request{}, nil)
+}
+
+// SendBreak sends a break, if the session supports it.
+func (s *Session) SendBreak() error {
+	return s.post(s.ctx, "sendBreak", request{}, nil)
+}
+
+// ShareLink is a read-only link to a session.
+type ShareLink struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+	// URL is the server's watch page for the link.
+	URL     string    `json:"url"`
+	Expires time.Time `json:"expires"`
+}
+
+// Viewer is someone watching a session with a ShareLink.
+type Viewer struct {
+	Principal  string    `json:"principal"`
+	RemoteAddr string    `json:"remote_addr"`
+	Joined     time.Time `json:"joined"`
+	LastSeen   time.Time `json:"last_seen"`
+}
+
+// ShareStatus describes an active ShareLink and who is watching it.
+type ShareStatus struct {
+	ID      string    `json:"id"`
+	Expires time.Time `json:"expires"`
+	Viewers []Viewer  `json:"viewers"`
+}
+
+// Share creates a link that lets others watch the session without typing into it, until ttl
+// passes, or for the server's default time if ttl is zero.
+func (s *Session) Share(ttl time.Duration) (*ShareLink, error) {
+	link := &ShareLink{}
+	err := s.post(s.ctx, "createShare", request{TTLSeconds: int(ttl / time.Second)}, link)
+	if err != nil {
+		return nil, err
+	}
+	link.URL = s.url + link.URL
+	return link, nil
+}
+
+// Shares returns the session's active links and their viewers.
+func (s *Session) Shares() ([]ShareStatus, error) {
+	resp := &struct {
+		Shares []ShareStatus `json:"shares"`
+	}{}
+	err := s.post(s.ctx, "listShares", request{}, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Shares, nil
+}
+
+// RevokeShare removes the link with id, disconnecting its viewers.
+func (s *Session) RevokeShare(id string) error {
+	return s.post(s.ctx, "revokeShare", request{ShareID: id}, nil)
+}
+
+// Close terminates the session. Any pending Read returns io.EOF.
+func (s *Session) Close() error {
+	err := s.post(context.Background(), "close", request{}, nil)
+	if err == io.EOF {
+		// already finished
+		err = nil
+	}
+	s.finish()
+	s.cancel()
+	return err
+}
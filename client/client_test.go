@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/evanj/hterm"
+)
+
+// echoStream returns its input as output, and records sizes.
+type echoStream struct {
+	*io.PipeReader
+	*io.PipeWriter
+
+	mu    sync.Mutex
+	sizes []hterm.Size
+}
+
+func (e *echoStream) Resize(size hterm.Size) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.sizes = append(e.sizes, size)
+	return nil
+}
+
+func (e *echoStream) Close() error {
+	e.PipeWriter.Close()
+	return e.PipeReader.Close()
+}
+
+type echoStarter struct {
+	mu       sync.Mutex
+	requests []*hterm.StartRequest
+	streams  []*echoStream
+}
+
+func (e *echoStarter) StartContext(ctx context.Context, req *hterm.StartRequest) (io.ReadWriteCloser, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	r, w := io.Pipe()
+	stream := &echoStream{PipeReader: r, PipeWriter: w}
+	e.requests = append(e.requests, req)
+	e.streams = append(e.streams, stream)
+	return stream, nil
+}
+
+func TestSession(t *testing.T) {
+	starter := &echoStarter{}
+	server := httptest.NewServer(hterm.NewContextServer(starter))
+	defer server.Close()
+
+	size := hterm.Size{Columns: 80, Rows: 24}
+	s, err := Open(context.Background(), server.URL, WithSize(size), WithExtra(map[string]string{"k": "v"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(starter.requests) != 1 || starter.requests[0].Size != size || starter.requests[0].Extra["k"] != "v" {
+		t.Fatalf("unexpected start requests: %#v", starter.requests)
+	}
+
+	// write concurrently: the echo pipe blocks until the output is read
+	go func() {
+		_, err := s.Write([]byte("hello"))
+		if err != nil {
+			t.Error(err)
+		}
+	}()
+	buf := make([]byte, 3)
+	output := ""
+	for len(output) < len("hello") {
+		n, err := s.Read(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output += string(buf[:n])
+	}
+	if output != "hello" {
+		t.Errorf("unexpected output: %#v", output)
+	}
+
+	err = s.Resize(hterm.Size{Columns: 100, Rows: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream := starter.streams[0]
+	if len(stream.sizes) != 1 || stream.sizes[0] != (hterm.Size{Columns: 100, Rows: 30}) {
+		t.Errorf("unexpected sizes: %#v", stream.sizes)
+	}
+	err = s.SendBreak()
+	if err == nil || !strings.Contains(err.Error(), "does not support sending a break") {
+		t.Error("expected an error for sendBreak:", err)
+	}
+
+	// close unblocks a pending read
+	done := make(chan error)
+	go func() {
+		_, err := ioutil.ReadAll(s)
+		done <- err
+	}()
+	err = s.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = <-done
+	if err != nil {
+		t.Error("expected EOF after close:", err)
+	}
+	_, err = s.Write([]byte("x"))
+	if err != io.EOF {
+		t.Error("expected EOF writing after close:", err)
+	}
+	if len(starter.requests) != 1 {
+		t.Error("requests after close must not start a new session:", len(starter.requests))
+	}
+}
+
+func TestSessionFinished(t *testing.T) {
+	starter := &echoStarter{}
+	server := httptest.NewServer(hterm.NewContextServer(starter))
+	defer server.Close()
+
+	s, err := Open(context.Background(), server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the program exits
+	starter.streams[0].Close()
+	n, err := s.Read(make([]byte, 10))
+	if n != 0 || err != io.EOF {
+		t.Errorf("expected EOF: %d %v", n, err)
+	}
+	err = s.Close()
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSessionShare(t *testing.T) {
+	starter := &echoStarter{}
+	server := httptest.NewServer(hterm.NewContextServer(starter))
+	defer server.Close()
+
+	s, err := Open(context.Background(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	link, err := s.Share(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(link.URL, server.URL+"/watch.html?share=") || time.Until(link.Expires) > time.Minute {
+		t.Errorf("unexpected link: %#v", link)
+	}
+	shares, err := s.Shares()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shares) != 1 || shares[0].ID != link.ID || len(shares[0].Viewers) != 0 {
+		t.Errorf("unexpected shares: %#v", shares)
+	}
+
+	err = s.RevokeShare(link.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shares, err = s.Shares()
+	if err != nil || len(shares) != 0 {
+		t.Error("expected no shares after revoking:", shares, err)
+	}
+	err = s.RevokeShare(link.ID)
+	if err == nil {
+		t.Error("expected an error revoking twice")
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/evanj/hterm"
+)
+
+// initAccess checks that the entry's allowed groups are defined in groups.
+func (e *menuEntry) initAccess(groups map[string][]string) error {
+	for _, group := range e.AllowedGroups {
+		if _, ok := groups[group]; !ok {
+			return fmt.Errorf("undefined group %#v", group)
+		}
+	}
+	return nil
+}
+
+// restricted returns true if the entry is only available to some users.
+func (e *menuEntry) restricted() bool {
+	return len(e.AllowedUsers) > 0 || len(e.AllowedGroups) > 0
+}
+
+// permitted returns true if principal may see and run entry. Unrestricted entries are permitted
+// for everyone, including unauthenticated requests with an empty principal.
+func (m *menu) permitted(entry *menuEntry, principal string) bool {
+	if !entry.restricted() {
+		return true
+	}
+	if principal == "" {
+		return false
+	}
+	for _, user := range entry.AllowedUsers {
+		if user == principal {
+			return true
+		}
+	}
+	for _, group := range entry.AllowedGroups {
+		if m.members[group][principal] {
+			return true
+		}
+	}
+	return false
+}
+
+// groupsFor returns the menu groups containing only the entries principal is permitted to run.
+// Groups without any permitted entries are omitted.
+func (m *menu) groupsFor(principal string) []*menuGroup {
+	var groups []*menuGroup
+	for _, group := range m.Groups {
+		filtered := &menuGroup{Name: group.Name}
+		for _, entry := range group.Entries {
+			if m.permitted(entry, principal) {
+				filtered.Entries = append(filtered.Entries, entry)
+			}
+		}
+		if len(filtered.Entries) > 0 {
+			groups = append(groups, filtered)
+		}
+	}
+	return groups
+}
+
+// auditDenied logs that principal attempted to run the entry with id but was not permitted.
+func auditDenied(action string, id string, principal string, remoteAddr string) {
+	log.Printf("audit: denied %s of %#v for principal %#v from %s", action, id, principal, remoteAddr)
+}
+
+// principalHandler sets the principal for each request from the header set by a trusted
+// authenticating proxy. It must only be used when clients cannot reach the server directly,
+// since they could set the header themselves.
+type principalHandler struct {
+	header  string
+	handler http.Handler
+}
+
+func (p *principalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	principal := r.Header.Get(p.header)
+	if principal != "" {
+		r = r.WithContext(hterm.WithPrincipal(r.Context(), principal))
+	}
+	p.handler.ServeHTTP(w, r)
+}
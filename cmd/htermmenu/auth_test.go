@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/evanj/hterm"
+)
+
+func TestPermitted(t *testing.T) {
+	m, err := loadMenu("menu.example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ping := m.entry("ping")
+	tests := map[string]bool{"": false, "alice": true, "bob": true, "carol": true, "mallory": false}
+	for principal, expected := range tests {
+		if m.permitted(ping, principal) != expected {
+			t.Errorf("permitted(ping, %#v) != %v", principal, expected)
+		}
+		if !m.permitted(m.entry("ls"), principal) {
+			t.Errorf("unrestricted entry must be permitted for %#v", principal)
+		}
+	}
+
+	// ping is the last entry in Monitoring
+	groups := m.groupsFor("")
+	monitoring := groups[1].Entries
+	if len(groups) != 3 || monitoring[len(monitoring)-1].ID == "ping" {
+		t.Errorf("ping must be hidden: %#v", monitoring)
+	}
+	groups = m.groupsFor("alice")
+	monitoring = groups[1].Entries
+	if monitoring[len(monitoring)-1].ID != "ping" {
+		t.Errorf("ping must be visible: %#v", monitoring)
+	}
+
+	_, err = loadMenu(writeConfig(t, `{"entries": [{"id": "ls", "argv": ["ls"], "allowed_groups": ["x"]}]}`))
+	if err == nil || !strings.Contains(err.Error(), "undefined group") {
+		t.Error("expected undefined group error:", err)
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	m, err := loadMenu("menu.example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	index, err := readTemplate(staticFS(t), "/index.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	execute, err := readTemplate(staticFS(t), "/execute.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &server{}
+	s.state.Store(&menuState{m, index, execute})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.rootHandler)
+	mux.HandleFunc("/execute", s.executeHandler)
+	handler := &principalHandler{"X-Forwarded-User", mux}
+
+	request := func(principal string, method string, path string, form url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if principal != "" {
+			r.Header.Set("X-Forwarded-User", principal)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := request("", http.MethodGet, "/", nil)
+	if w.Code != http.StatusOK || strings.Contains(w.Body.String(), `value="ping"`) {
+		t.Errorf("ping must not be listed without a principal: %d %s", w.Code, w.Body.String())
+	}
+	w = request("alice", http.MethodGet, "/", nil)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `value="ping"`) {
+		t.Errorf("ping must be listed for alice: %d %s", w.Code, w.Body.String())
+	}
+
+	form := url.Values{"id": {"ping"}, "param.host": {"localhost"}}
+	w = request("mallory", http.MethodPost, "/execute", form)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected execute to be forbidden: %d %s", w.Code, w.Body.String())
+	}
+	w = request("bob", http.MethodPost, "/execute", form)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected execute to succeed: %d %s", w.Code, w.Body.String())
+	}
+
+	// Start is the real check: it must be enforced even if the execute page was skipped
+	req := &hterm.StartRequest{Principal: "mallory", Extra: map[string]string{"id": "ping", "param.host": "localhost"}}
+	_, err = s.StartContext(context.Background(), req)
+	if err == nil || !strings.Contains(err.Error(), "not permitted") {
+		t.Error("expected start to be denied:", err)
+	}
+}
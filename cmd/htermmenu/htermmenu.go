@@ -1,31 +1,26 @@
-// we don't care about file modification timestamps but do want deterministic builds
-//go:generate esc -pkg=$GOPACKAGE -o=static.go -prefix=static -modtime=1485035869 static
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"html/template"
+	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
-	"os/exec"
-	"strings"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
 
 	"github.com/evanj/hterm"
-	"github.com/kr/pty"
+	"github.com/evanj/hterm/assets"
 )
 
-const gopathRelativeStaticDir = "src/github.com/evanj/hterm/cmd/htermmenu/static"
-
-var permittedCommands = []string{
-	"ls", "vi", "man bash",
-}
-
 type indexTemplate struct {
-	Commands []string
+	Groups []*menuGroup
 }
 
 type executeTemplate struct {
@@ -34,8 +29,12 @@ type executeTemplate struct {
 
 type server struct {
 	staticHandler http.Handler
-	index         *template.Template
-	execute       *template.Template
+	// contains the current *menuState
+	state atomic.Value
+}
+
+func (s *server) current() *menuState {
+	return s.state.Load().(*menuState)
 }
 
 func (s *server) rootHandler(w http.ResponseWriter, r *http.Request) {
@@ -49,22 +48,14 @@ func (s *server) rootHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	values := &indexTemplate{permittedCommands}
-	err := s.index.Execute(w, values)
+	state := s.current()
+	values := &indexTemplate{state.menu.groupsFor(hterm.PrincipalFromContext(r.Context()))}
+	err := state.index.Execute(w, values)
 	if err != nil {
 		panic(err)
 	}
 }
 
-func isPermittedCommand(command string) bool {
-	for _, permitted := range permittedCommands {
-		if command == permitted {
-			return true
-		}
-	}
-	return false
-}
-
 func (s *server) executeHandler(w http.ResponseWriter, r *http.Request) {
 	log.Println("execute", r.Method, r.URL.Path)
 	if r.Method != http.MethodPost {
@@ -72,36 +63,61 @@ func (s *server) executeHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	command := r.PostFormValue("command")
-	if !isPermittedCommand(command) {
-		http.Error(w, "not permitted", http.StatusInternalServerError)
+	state := s.current()
+	id := r.PostFormValue("id")
+	entry := state.menu.entry(id)
+	principal := hterm.PrincipalFromContext(r.Context())
+	if entry == nil || !state.menu.permitted(entry, principal) {
+		auditDenied("execute", id, principal, r.RemoteAddr)
+		http.Error(w, "not permitted", http.StatusForbidden)
+		return
+	}
+	args, err := entry.args(r.PostFormValue)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	values := &executeTemplate{map[string]string{"command": command}}
-	err := s.execute.Execute(w, values)
+	consoleExtra := map[string]string{"id": id}
+	for name, value := range args {
+		consoleExtra[paramPrefix+name] = value
+	}
+	values := &executeTemplate{consoleExtra}
+	err = state.execute.Execute(w, values)
 	if err != nil {
 		panic(err)
 	}
 }
 
-// SessionStarter interface
-func (s *server) Start(extraParams map[string]string) (*os.File, error) {
+// ContextSessionStarter interface
+func (s *server) StartContext(ctx context.Context, req *hterm.StartRequest) (io.ReadWriteCloser, error) {
 	// validate the command AGAIN: this is the real check
-	command := extraParams["command"]
-	if !isPermittedCommand(command) {
-		return nil, errors.New("invalid command: " + command)
+	id := req.Extra["id"]
+	m := s.current().menu
+	entry := m.entry(id)
+	if entry == nil {
+		return nil, errors.New("invalid command: " + id)
 	}
-
-	parts := strings.Split(command, " ")
-	cmd := exec.Command(parts[0], parts[1:]...)
-	return pty.Start(cmd)
+	if !m.permitted(entry, req.Principal) {
+		auditDenied("start", id, req.Principal, req.RemoteAddr)
+		return nil, errors.New("not permitted: " + id)
+	}
+	args, err := entry.args(func(key string) string { return req.Extra[key] })
+	if err != nil {
+		return nil, err
+	}
+	cmd, err := entry.command(args)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("starting command %#v %v for %s (principal %#v)", id, cmd.Args, req.RemoteAddr, req.Principal)
+	return hterm.StartPty(cmd, req.Size)
 }
 
 func readTemplate(fs http.FileSystem, name string) (*template.Template, error) {
 	f, err := fs.Open(name)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	data, err := ioutil.ReadAll(f)
 	err2 := f.Close()
@@ -109,55 +125,57 @@ func readTemplate(fs http.FileSystem, name string) (*template.Template, error) {
 		return nil, err
 	}
 	if err2 != nil {
-		return nil, err
+		return nil, err2
 	}
 	return template.New(name).Parse(string(data))
 }
 
 func main() {
 	addr := flag.String("addr", "localhost:8080", "Listening address e.g. :8080 for global")
-	gopathStatic := flag.Bool("gopathStatic", false, "Open static resources from $GOPATH")
+	staticDir := flag.String("staticDir", "",
+		"Serve static resources from this directory instead of the compiled in files (e.g. assets/static)")
+	configPath := flag.String("config", "", "JSON file with the menu entries; uses a demo menu if empty")
+	templateDir := flag.String("templates", "",
+		"Directory with index.html and execute.html; uses the compiled in templates if empty")
+	principalHeader := flag.String("principalHeader", "",
+		"HTTP header with the authenticated user, set by a trusted proxy (e.g. X-Forwarded-User)")
 
 	flag.Parse()
 
-	// Use the "real" http.FileSystem since we don't want to depend on the current working directory
-	var fs http.FileSystem
-	if *gopathStatic {
-		gopath := ""
-		for _, env := range os.Environ() {
-			const gopathVar = "GOPATH="
-			if strings.HasPrefix(env, gopathVar) {
-				gopath = env[len(gopathVar):]
-				break
-			}
-		}
-		if gopath == "" {
-			panic("Could not find GOPATH env var to locate static resources")
-		}
-		fs = http.Dir(gopath + "/" + gopathRelativeStaticDir)
-	} else {
-		// compiled static resources
-		fs = FS(false)
-	}
-
-	// load the templates
-	index, err := readTemplate(fs, "/index.html")
+	fs, err := assets.FS("htermmenu", *staticDir)
 	if err != nil {
 		panic(err)
 	}
-	execute, err := readTemplate(fs, "/execute.html")
+
+	// load the menu and templates, and reload them on changes or SIGHUP
+	l := &loader{configPath: *configPath, templates: http.FS(fs)}
+	if *templateDir != "" {
+		l.templates = http.Dir(*templateDir)
+		l.templateDir = *templateDir
+	}
+	state, err := l.load()
 	if err != nil {
 		panic(err)
 	}
-	s := &server{http.FileServer(fs), index, execute}
-	htermServer := hterm.NewServer(s)
+	s := &server{staticHandler: http.FileServer(http.FS(fs))}
+	s.state.Store(state)
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go l.watch(s, reloadInterval, hup, nil)
+
+	htermServer := hterm.NewContextServer(s)
 
 	http.HandleFunc("/", s.rootHandler)
 	http.HandleFunc("/execute", s.executeHandler)
 	htermServer.RegisterHandlers("/", http.DefaultServeMux)
 
+	var handler http.Handler = http.DefaultServeMux
+	if *principalHeader != "" {
+		handler = &principalHandler{*principalHeader, handler}
+	}
+
 	fmt.Printf("Listening on http://%s/\n", *addr)
-	err = http.ListenAndServe(*addr, nil)
+	err = http.ListenAndServe(*addr, handler)
 	if err != nil {
 		panic(err)
 	}
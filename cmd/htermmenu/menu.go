@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sort"
+)
+
+// menuEntry is a command that can be run from the menu.
+type menuEntry struct {
+	// ID identifies the entry in requests: it must be unique.
+	ID string `json:"id"`
+	// Label is displayed on the menu. Defaults to ID.
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	// Argv is the command and its arguments. It is run directly, without a shell. {name} is
+	// replaced with the value of parameter name.
+	Argv []string `json:"argv"`
+	// Params are filled in by the user before running the command.
+	Params []*menuParam `json:"params"`
+	// Env contains variables to set in addition to the server's environment.
+	Env map[string]string `json:"env"`
+	// Dir is the working directory. Defaults to the server's working directory.
+	Dir string `json:"dir"`
+	// Group is the heading the entry is displayed under. Entries without a group are listed first.
+	Group string `json:"group"`
+	// AllowedUsers and AllowedGroups restrict the entry to these principals, or members of these
+	// groups. If both are empty, everyone may run the entry.
+	AllowedUsers  []string `json:"allowed_users"`
+	AllowedGroups []string `json:"allowed_groups"`
+}
+
+// command returns the command to run for this entry with validated args.
+func (e *menuEntry) command(args map[string]string) (*exec.Cmd, error) {
+	argv, err := e.argv(args)
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Dir = e.Dir
+	if len(e.Env) > 0 {
+		cmd.Env = os.Environ()
+		// sort so the environment is deterministic
+		keys := make([]string, 0, len(e.Env))
+		for key := range e.Env {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			cmd.Env = append(cmd.Env, key+"="+e.Env[key])
+		}
+	}
+	return cmd, nil
+}
+
+// menuGroup is a set of entries displayed together.
+type menuGroup struct {
+	Name    string
+	Entries []*menuEntry
+}
+
+// menuConfig is the format of the configuration file.
+type menuConfig struct {
+	Entries []*menuEntry `json:"entries"`
+	// Groups maps group names to the principals that are members.
+	Groups map[string][]string `json:"groups"`
+}
+
+// menu is a validated menuConfig.
+type menu struct {
+	Groups []*menuGroup
+	byID   map[string]*menuEntry
+	// members maps group names to the set of principals in the group
+	members map[string]map[string]bool
+}
+
+// defaultConfig is used if no configuration file is provided.
+var defaultConfig = &menuConfig{Entries: []*menuEntry{
+	{ID: "ls", Argv: []string{"ls"}},
+	{ID: "vi", Argv: []string{"vi"}},
+	{ID: "man-bash", Label: "man bash", Argv: []string{"man", "bash"}},
+}}
+
+// loadMenu reads and validates the configuration file at path.
+func loadMenu(path string) (*menu, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &menuConfig{}
+	err = json.Unmarshal(data, config)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	m, err := newMenu(config)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return m, nil
+}
+
+// newMenu validates config and returns a menu with the entries in the same order, grouped.
+func newMenu(config *menuConfig) (*menu, error) {
+	if len(config.Entries) == 0 {
+		return nil, errors.New("menu has no entries")
+	}
+	m := &menu{byID: map[string]*menuEntry{}, members: map[string]map[string]bool{}}
+	for group, principals := range config.Groups {
+		m.members[group] = map[string]bool{}
+		for _, principal := range principals {
+			m.members[group][principal] = true
+		}
+	}
+	groups := map[string]*menuGroup{}
+	for i, entry := range config.Entries {
+		if entry.ID == "" {
+			return nil, fmt.Errorf("entry %d: missing id", i)
+		}
+		if m.byID[entry.ID] != nil {
+			return nil, fmt.Errorf("entry %d: duplicate id %#v", i, entry.ID)
+		}
+		if len(entry.Argv) == 0 || entry.Argv[0] == "" {
+			return nil, fmt.Errorf("entry %#v: missing argv", entry.ID)
+		}
+		if entry.Label == "" {
+			entry.Label = entry.ID
+		}
+		err := entry.initParams()
+		if err != nil {
+			return nil, fmt.Errorf("entry %#v: %s", entry.ID, err.Error())
+		}
+		err = entry.initAccess(config.Groups)
+		if err != nil {
+			return nil, fmt.Errorf("entry %#v: %s", entry.ID, err.Error())
+		}
+		m.byID[entry.ID] = entry
+
+		group := groups[entry.Group]
+		if group == nil {
+			group = &menuGroup{Name: entry.Group}
+			groups[entry.Group] = group
+			m.Groups = append(m.Groups, group)
+		}
+		group.Entries = append(group.Entries, entry)
+	}
+
+	// entries without a group go first
+	sort.SliceStable(m.Groups, func(i int, j int) bool {
+		return m.Groups[i].Name == "" && m.Groups[j].Name != ""
+	})
+	return m, nil
+}
+
+// entry returns the entry with id, or nil if it does not exist.
+func (m *menu) entry(id string) *menuEntry {
+	return m.byID[id]
+}
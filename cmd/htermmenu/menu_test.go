@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/evanj/hterm/assets"
+)
+
+func writeConfig(t *testing.T, config string) string {
+	path := filepath.Join(t.TempDir(), "menu.json")
+	err := ioutil.WriteFile(path, []byte(config), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// staticFS returns the compiled in static files.
+func staticFS(t *testing.T) http.FileSystem {
+	fs, err := assets.FS("htermmenu", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return http.FS(fs)
+}
+
+func TestLoadMenu(t *testing.T) {
+	m, err := loadMenu("menu.example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Groups) != 3 || m.Groups[0].Name != "" || m.Groups[1].Name != "Monitoring" {
+		t.Errorf("unexpected groups: %#v", m.Groups)
+	}
+	entry := m.entry("man-bash")
+	if entry == nil || entry.Label != "man bash" || entry.Dir != "/tmp" {
+		t.Fatalf("unexpected entry: %#v", entry)
+	}
+	cmd, err := m.entry("top").command(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Env[len(cmd.Env)-1] != "TERM=xterm-256color" || len(cmd.Env) != len(os.Environ())+1 {
+		t.Error("unexpected environment:", cmd.Env)
+	}
+	if m.entry("man bash") != nil {
+		t.Error("entries must only be found by id")
+	}
+
+	errors := map[string]string{
+		`{"entries": []}`:                 "no entries",
+		`{"entries": [{"argv": ["ls"]}]}`: "missing id",
+		`{"entries": [{"id": "ls"}]}`:     "missing argv",
+		`{"entries": [{"id": "ls", "argv": ["ls"]}, {"id": "ls", "argv": ["ls"]}]}`: "duplicate id",
+		`{"entries": [{"id": "ls", "argv": "ls"}]}`:                                 "cannot unmarshal",
+	}
+	for config, expected := range errors {
+		_, err = loadMenu(writeConfig(t, config))
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%s: expected error %#v; got %v", config, expected, err)
+		}
+	}
+}
+
+func TestIndexTemplate(t *testing.T) {
+	index, err := readTemplate(staticFS(t), "/index.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := newMenu(defaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	err = index.Execute(out, &indexTemplate{m.Groups})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), `name="id" value="man-bash"`) ||
+		!strings.Contains(out.String(), ">man bash</button>") {
+		t.Error("menu entry missing from output:", out.String())
+	}
+
+	m, err = loadMenu("menu.example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out.Reset()
+	err = index.Execute(out, &indexTemplate{m.Groups})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`<option selected>sshd</option><option>cron</option>`,
+		`<input type="number" name="param.lines" min="1" max="1000" value="100" required>`,
+		`<input type="text" name="param.host" pattern="[a-z0-9][a-z0-9.-]*" value="" required>`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(out.String(), e) {
+			t.Errorf("expected %s in output:\n%s", e, out.String())
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Parameter types
+const (
+	paramEnum   = "enum"
+	paramInt    = "int"
+	paramString = "string"
+)
+
+// prefix for parameters in form fields and ConsoleExtra
+const paramPrefix = "param."
+
+// longest string parameter we accept, even if it matches the pattern
+const maxParamLength = 1024
+
+var paramNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// matches {name} placeholders in argv
+var placeholderRegexp = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// menuParam is an argument the user fills in before running an entry. It is substituted for
+// {name} in the entry's argv.
+type menuParam struct {
+	Name string `json:"name"`
+	// Label is displayed on the form. Defaults to Name.
+	Label string `json:"label"`
+	// Type is enum, int or string.
+	Type string `json:"type"`
+	// Values are the permitted values of an enum.
+	Values []string `json:"values"`
+	// Min and Max are the inclusive range of an int.
+	Min int `json:"min"`
+	Max int `json:"max"`
+	// Pattern is a regular expression that must match the entire value of a string.
+	Pattern string `json:"pattern"`
+	// Default is the initial value on the form.
+	Default string `json:"default"`
+
+	patternRegexp *regexp.Regexp
+}
+
+// FieldName returns the name of the parameter's form field.
+func (p *menuParam) FieldName() string {
+	return paramPrefix + p.Name
+}
+
+// init checks the parameter's definition and prepares it for validating values.
+func (p *menuParam) init() error {
+	if !paramNameRegexp.MatchString(p.Name) {
+		return fmt.Errorf("invalid parameter name %#v", p.Name)
+	}
+	if p.Label == "" {
+		p.Label = p.Name
+	}
+
+	switch p.Type {
+	case paramEnum:
+		if len(p.Values) == 0 {
+			return fmt.Errorf("parameter %s: enum has no values", p.Name)
+		}
+	case paramInt:
+		if p.Min > p.Max {
+			return fmt.Errorf("parameter %s: min %d > max %d", p.Name, p.Min, p.Max)
+		}
+	case paramString:
+		if p.Pattern == "" {
+			return fmt.Errorf("parameter %s: string requires a pattern", p.Name)
+		}
+		var err error
+		// anchor the pattern so it must match the entire value
+		p.patternRegexp, err = regexp.Compile(`^(?:` + p.Pattern + `)$`)
+		if err != nil {
+			return fmt.Errorf("parameter %s: %s", p.Name, err.Error())
+		}
+	default:
+		return fmt.Errorf("parameter %s: invalid type %#v", p.Name, p.Type)
+	}
+
+	if p.Default != "" {
+		err := p.validate(p.Default)
+		if err != nil {
+			return fmt.Errorf("default: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// validate returns an error if value is not permitted.
+func (p *menuParam) validate(value string) error {
+	switch p.Type {
+	case paramEnum:
+		for _, permitted := range p.Values {
+			if value == permitted {
+				return nil
+			}
+		}
+	case paramInt:
+		i, err := strconv.Atoi(value)
+		if err == nil && p.Min <= i && i <= p.Max {
+			return nil
+		}
+	case paramString:
+		if len(value) <= maxParamLength && p.patternRegexp.MatchString(value) {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value for parameter %s: %#v", p.Name, value)
+}
+
+// initParams checks the entry's parameters, and that argv only uses defined parameters.
+func (e *menuEntry) initParams() error {
+	defined := map[string]bool{}
+	for _, param := range e.Params {
+		err := param.init()
+		if err != nil {
+			return err
+		}
+		if defined[param.Name] {
+			return fmt.Errorf("duplicate parameter %s", param.Name)
+		}
+		defined[param.Name] = true
+	}
+
+	for _, arg := range e.Argv {
+		for _, match := range placeholderRegexp.FindAllStringSubmatch(arg, -1) {
+			if !defined[match[1]] {
+				return fmt.Errorf("argv uses undefined parameter %s", match[1])
+			}
+		}
+	}
+	return nil
+}
+
+// args returns the validated values of the entry's parameters. value returns the value of a
+// form field or ConsoleExtra key.
+func (e *menuEntry) args(value func(key string) string) (map[string]string, error) {
+	args := map[string]string{}
+	for _, param := range e.Params {
+		v := value(param.FieldName())
+		err := param.validate(v)
+		if err != nil {
+			return nil, err
+		}
+		args[param.Name] = v
+	}
+	return args, nil
+}
+
+// argv returns the entry's argv with the parameters substituted. args must be validated.
+func (e *menuEntry) argv(args map[string]string) ([]string, error) {
+	out := make([]string, len(e.Argv))
+	for i, arg := range e.Argv {
+		out[i] = placeholderRegexp.ReplaceAllStringFunc(arg, func(placeholder string) string {
+			return args[placeholder[1:len(placeholder)-1]]
+		})
+	}
+	if out[0] == "" {
+		return nil, errors.New("empty command")
+	}
+	return out, nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParams(t *testing.T) {
+	m, err := loadMenu("menu.example.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := m.entry("tail-log")
+
+	values := map[string]string{"param.service": "nginx", "param.lines": "20"}
+	args, err := entry.args(func(key string) string { return values[key] })
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err := entry.command(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"journalctl", "--follow", "--unit=nginx", "--lines=20"}
+	if !reflect.DeepEqual(cmd.Args, expected) {
+		t.Errorf("unexpected args: %#v", cmd.Args)
+	}
+
+	invalid := []map[string]string{
+		{"param.service": "nginx"},
+		{"param.service": "postgres", "param.lines": "20"},
+		{"param.service": "nginx", "param.lines": "1001"},
+		{"param.service": "nginx", "param.lines": "0x10"},
+	}
+	for _, values := range invalid {
+		_, err = entry.args(func(key string) string { return values[key] })
+		if err == nil || !strings.Contains(err.Error(), "invalid value") {
+			t.Errorf("%v: expected invalid value error: %v", values, err)
+		}
+	}
+
+	// string patterns must match the entire value
+	ping := m.entry("ping")
+	for _, host := range []string{"example.com; rm -rf /", "-f", ""} {
+		_, err = ping.args(func(key string) string { return host })
+		if err == nil {
+			t.Errorf("host %#v must not be permitted", host)
+		}
+	}
+	args, err = ping.args(func(key string) string { return "example.com" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd, err = ping.command(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.Args[len(cmd.Args)-1] != "example.com" {
+		t.Errorf("unexpected args: %#v", cmd.Args)
+	}
+}
+
+func TestParamsConfigErrors(t *testing.T) {
+	errors := map[string]string{
+		`{"name": "x y", "type": "enum", "values": ["a"]}`:               "invalid parameter name",
+		`{"name": "x", "type": "enum"}`:                                  "no values",
+		`{"name": "x", "type": "int", "min": 2, "max": 1}`:               "min 2 > max 1",
+		`{"name": "x", "type": "string"}`:                                "requires a pattern",
+		`{"name": "x", "type": "string", "pattern": "("}`:                "missing closing",
+		`{"name": "x", "type": "float"}`:                                 "invalid type",
+		`{"name": "x", "type": "enum", "values": ["a"], "default": "b"}`: "default",
+		`{"name": "y", "type": "enum", "values": ["a"]}`:                 "undefined parameter x",
+	}
+	for param, expected := range errors {
+		config := `{"entries": [{"id": "e", "argv": ["echo", "{x}"], "params": [` + param + `]}]}`
+		_, err := loadMenu(writeConfig(t, config))
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("%s: expected error %#v; got %v", param, expected, err)
+		}
+	}
+}
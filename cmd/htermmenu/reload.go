@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// how often the config file and templates are checked for changes
+const reloadInterval = 2 * time.Second
+
+// menuState is everything that can be reloaded while running. It is replaced as a unit so each
+// request uses a consistent menu and templates.
+type menuState struct {
+	menu    *menu
+	index   *template.Template
+	execute *template.Template
+}
+
+// loader loads the menu state and watches for changes.
+type loader struct {
+	// configPath is the menu config file, or empty to use defaultConfig
+	configPath string
+	// templates contains index.html and execute.html
+	templates http.FileSystem
+	// templateDir is the directory on disk with the templates, or empty if they are compiled in
+	templateDir string
+
+	// version of the files at the last load, even if it failed
+	loadedVersion string
+}
+
+func (l *loader) load() (*menuState, error) {
+	// get the version first: if the files change while loading, we will load again
+	l.loadedVersion = l.version()
+	m, err := newMenu(defaultConfig)
+	if l.configPath != "" {
+		m, err = loadMenu(l.configPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	index, err := readTemplate(l.templates, "/index.html")
+	if err != nil {
+		return nil, err
+	}
+	execute, err := readTemplate(l.templates, "/execute.html")
+	if err != nil {
+		return nil, err
+	}
+	return &menuState{m, index, execute}, nil
+}
+
+// watchedPaths returns the files on disk that are loaded.
+func (l *loader) watchedPaths() []string {
+	var paths []string
+	if l.configPath != "" {
+		paths = append(paths, l.configPath)
+	}
+	if l.templateDir != "" {
+		paths = append(paths, filepath.Join(l.templateDir, "index.html"),
+			filepath.Join(l.templateDir, "execute.html"))
+	}
+	return paths
+}
+
+// version returns a string that changes when any of the watched files change.
+func (l *loader) version() string {
+	version := ""
+	for _, path := range l.watchedPaths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			// report the change: the reload will log the error
+			version += path + ":" + err.Error() + ";"
+			continue
+		}
+		version += fmt.Sprintf("%s:%d:%d;", path, info.ModTime().UnixNano(), info.Size())
+	}
+	return version
+}
+
+// watch checks the watched files every interval, and reloads when they change or when a
+// signal is received on reload. It calls s.reload, which keeps the old state if loading fails,
+// then waits for the next change. It returns when done is closed. It must not be called
+// concurrently with load.
+func (l *loader) watch(s *server, interval time.Duration, reload <-chan os.Signal, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case sig := <-reload:
+			log.Printf("received %s: reloading", sig)
+		case <-ticker.C:
+			if l.version() == l.loadedVersion {
+				continue
+			}
+			log.Printf("config or templates changed: reloading")
+		}
+		s.reload(l)
+	}
+}
+
+// reload loads the state and swaps it in, or logs the error and keeps the old state.
+func (s *server) reload(l *loader) error {
+	state, err := l.load()
+	if err != nil {
+		log.Printf("Error: reload failed; still serving the previous config: %s", err.Error())
+		return err
+	}
+	s.state.Store(state)
+	log.Printf("reloaded %d menu groups", len(state.menu.Groups))
+	return nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path string, data string) {
+	t.Helper()
+	err := ioutil.WriteFile(path, []byte(data), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// waitFor polls until f returns true, or fails the test.
+func waitFor(t *testing.T, description string, f func() bool) {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(time.Millisecond) {
+		if f() {
+			return
+		}
+	}
+	t.Fatal("timed out waiting for", description)
+}
+
+func TestReload(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "menu.json")
+	writeFile(t, configPath, `{"entries": [{"id": "first", "argv": ["ls"]}]}`)
+	for _, name := range []string{"index.html", "execute.html"} {
+		data, err := ioutil.ReadFile(filepath.Join("../../assets/static/htermmenu", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, filepath.Join(dir, name), string(data))
+	}
+
+	l := &loader{configPath: configPath, templates: http.Dir(dir), templateDir: dir}
+	s := &server{}
+	err := s.reload(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := s.current()
+	if first.menu.entry("first") == nil {
+		t.Fatal("expected entry first")
+	}
+
+	// bad configs and templates are rejected, and the old state is kept
+	writeFile(t, configPath, `{"entries": [{"id": "bad"}]}`)
+	err = s.reload(l)
+	if err == nil || s.current() != first {
+		t.Error("expected the bad config to be rejected:", err)
+	}
+	writeFile(t, configPath, `{"entries": [{"id": "second", "argv": ["ls"]}]}`)
+	writeFile(t, filepath.Join(dir, "index.html"), `{{.Missing`)
+	err = s.reload(l)
+	if err == nil || s.current() != first {
+		t.Error("expected the bad template to be rejected:", err)
+	}
+
+	hup := make(chan os.Signal)
+	done := make(chan struct{})
+	defer close(done)
+	go l.watch(s, time.Millisecond, hup, done)
+
+	// fixing the template is detected by polling
+	writeFile(t, filepath.Join(dir, "index.html"), `{{range .Groups}}{{end}}`)
+	// ensure the modification time changes, even on file systems with coarse timestamps
+	future := time.Now().Add(time.Minute)
+	err = os.Chtimes(filepath.Join(dir, "index.html"), future, future)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "reload after change", func() bool {
+		return s.current().menu.entry("second") != nil
+	})
+
+	// SIGHUP reloads even if nothing changed
+	second := s.current()
+	hup <- syscall.SIGHUP
+	waitFor(t, "reload after SIGHUP", func() bool {
+		return s.current() != second
+	})
+}
@@ -1,2489 +0,0 @@
-package main
-
-import (
-	"bytes"
-	"compress/gzip"
-	"encoding/base64"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"path"
-	"sync"
-	"time"
-)
-
-type _escLocalFS struct{}
-
-var _escLocal _escLocalFS
-
-type _escStaticFS struct{}
-
-var _escStatic _escStaticFS
-
-type _escDirectory struct {
-	fs   http.FileSystem
-	name string
-}
-
-type _escFile struct {
-	compressed string
-	size       int64
-	modtime    int64
-	local      string
-	isDir      bool
-
-	once sync.Once
-	data []byte
-	name string
-}
-
-func (_escLocalFS) Open(name string) (http.File, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	return os.Open(f.local)
-}
-
-func (_escStaticFS) prepare(name string) (*_escFile, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	var err error
-	f.once.Do(func() {
-		f.name = path.Base(name)
-		if f.size == 0 {
-			return
-		}
-		var gr *gzip.Reader
-		b64 := base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(f.compressed))
-		gr, err = gzip.NewReader(b64)
-		if err != nil {
-			return
-		}
-		f.data, err = ioutil.ReadAll(gr)
-	})
-	if err != nil {
-		return nil, err
-	}
-	return f, nil
-}
-
-func (fs _escStaticFS) Open(name string) (http.File, error) {
-	f, err := fs.prepare(name)
-	if err != nil {
-		return nil, err
-	}
-	return f.File()
-}
-
-func (dir _escDirectory) Open(name string) (http.File, error) {
-	return dir.fs.Open(dir.name + name)
-}
-
-func (f *_escFile) File() (http.File, error) {
-	type httpFile struct {
-		*bytes.Reader
-		*_escFile
-	}
-	return &httpFile{
-		Reader:   bytes.NewReader(f.data),
-		_escFile: f,
-	}, nil
-}
-
-func (f *_escFile) Close() error {
-	return nil
-}
-
-func (f *_escFile) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, nil
-}
-
-func (f *_escFile) Stat() (os.FileInfo, error) {
-	return f, nil
-}
-
-func (f *_escFile) Name() string {
-	return f.name
-}
-
-func (f *_escFile) Size() int64 {
-	return f.size
-}
-
-func (f *_escFile) Mode() os.FileMode {
-	return 0
-}
-
-func (f *_escFile) ModTime() time.Time {
-	return time.Unix(f.modtime, 0)
-}
-
-func (f *_escFile) IsDir() bool {
-	return f.isDir
-}
-
-func (f *_escFile) Sys() interface{} {
-	return f
-}
-
-// FS returns a http.Filesystem for the embedded assets. If useLocal is true,
-// the filesystem's contents are instead used.
-func FS(useLocal bool) http.FileSystem {
-	if useLocal {
-		return _escLocal
-	}
-	return _escStatic
-}
-
-// Dir returns a http.Filesystem for the embedded assets on a given prefix dir.
-// If useLocal is true, the filesystem's contents are instead used.
-func Dir(useLocal bool, name string) http.FileSystem {
-	if useLocal {
-		return _escDirectory{fs: _escLocal, name: name}
-	}
-	return _escDirectory{fs: _escStatic, name: name}
-}
-
-// FSByte returns the named file from the embedded assets. If useLocal is
-// true, the filesystem's contents are instead used.
-func FSByte(useLocal bool, name string) ([]byte, error) {
-	if useLocal {
-		f, err := _escLocal.Open(name)
-		if err != nil {
-			return nil, err
-		}
-		b, err := ioutil.ReadAll(f)
-		f.Close()
-		return b, err
-	}
-	f, err := _escStatic.prepare(name)
-	if err != nil {
-		return nil, err
-	}
-	return f.data, nil
-}
-
-// FSMustByte is the same as FSByte, but panics if name is not present.
-func FSMustByte(useLocal bool, name string) []byte {
-	b, err := FSByte(useLocal, name)
-	if err != nil {
-		panic(err)
-	}
-	return b
-}
-
-// FSString is the string version of FSByte.
-func FSString(useLocal bool, name string) (string, error) {
-	b, err := FSByte(useLocal, name)
-	return string(b), err
-}
-
-// FSMustString is the string version of FSMustByte.
-func FSMustString(useLocal bool, name string) string {
-	return string(FSMustByte(useLocal, name))
-}
-
-var _escData = map[string]*_escFile{
-
-	"/execute.html": {
-		local:   "static/execute.html",
-		size:    478,
-		modtime: 1485035869,
-		compressed: `
-H4sIAAAJbogA/3xRPW/CMBDd/SuuqbpECNMVTBbK3A5dOpr4hA85dmQfKQjx3ys7INKhnSw/vY97d+rp
-7X3z+fWxBcuda4S6P6hNIxQTO2wsY+ySReeUHBGhEp8dAp97XFeMJ5ZtSlUjdsGcZ8VrBs9ZRl47uAgA
-i7S3vITXxeJlJQC+ybC9f6+iKAtR1n1IxBT8EvQuBXdkXNVSAPTaGPL7JSyyQafjnnz5yPp//1rmhN/z
-PEIiOs00YB5DyVIsF2wj9TxteNCDHtGqEYOO0AafgsPtiaOGNVwu880EuV5X2a4IHn4ptuuqLLRDf5wf
-UvVXxEQrb+fIO2qEMjQAmSwZ+2SuoSETbww5nvEnAAD//8SEo0TeAQAA
-`,
-	},
-
-	"/htermmenu.js": {
-		local:   "static/htermmenu.js",
-		size:    530787,
-		modtime: 1485035869,
-		compressed: `
-H4sIAAAJbogA/+y9/XvbNpI4/nv+CtTbq6REliX53anbU+O4l3vStN8k3b17HG8OIiGJNUVqAcq22nr/
-9u8zM3gnJbnt7t3ufhrXqkwOwcFg3jEA9vbY+1mm2CTLBbvjik1FISSvRMrGK5Zn47Ss9sZZsZeURcKr
-npr1nuztsVcVm3HFxkIUbM7ljUiZFDzdLYt8xSalZKtyKZniE1Gteoy95dVMSHiwmvGCiTSrWFaxNJMi
-qfJVly1ywZVg8zLNJitWFoKVE1bNhBJMlUuZCHgWcFS9HiAAf2rkflB7eTbu/aBq1z4mZV5K1Xhr0nh1
-LpTiU/Fxzgs+FbIRZiHFREhRJJvBpCDEG2+qqpR8uvHex2Qmy/lmkLxMeL4RYi7mpVw1glRCVRt7sKwm
-J4037hJ9eVYJOYer+KXx4seJ5LYX0a0bsRqXXKab734cZ0WaFVO1BexGrOZ8sR1owatKyKIZsFxUWVms
-edWCS2Vp1XjvY5aKosommZDr2ljHPTHccqyW4+Z7KpFCrOmASmSZ54tSVs334SMrLNesufsxK9cB3Fcf
-eVXJbLysxJo+3q559231MZlxyZNKyI92rJ4A5Ltvv3/74iW7fPX65VmjYL8oFyuZTWcVaycdNuwPhuz9
-TLAXICTZcs6+fcdGy2oG4s5Gec4QVjEplJC3IkWl9b3SaiVTWquwpEwFyxSblrdCFqT0OPvq3cWuqla5
-YHmWiEIJUFsVS3jBxqSJymWRsqwAFcVev3rx8s27l6ieek+etJagtCqZJVXr+ZMn2YS1q9VClBPoF/vk
-nLWWRSomWSHSVucJY9VMlnesEHfspZSlbLe+zssxz9lOno13WDn+QSQV4zmo1xUT95mqVK/Vef7kyS2X
-2OQ5++nh+ZMne0+fPmFP2Td8AZ3cScVCFKkoktUOq0p2tUM93umyXq933XvCEPolT2bMgQIpuHlnweei
-y/LsRiAuvYna6TLTDEBC55cyR+JAY6lYpKJIFSuJMNQOvGrvCTQgl0WVzcWFeV0m1McI+9eZqgD9ybJI
-UBKJ8oUQKfRiLFhW3JZgbtKlzIopEEByuWJZkVUZz7MfOTwWdE/kYi6KygwXQL7geT7myY36yLiUHPud
-VUrkE8ZZdVfummfwbg+b0lf6RCM1K2WFw1xMWSpUIrMxfMV+3xVCagOGr2OyXFZZIbpsqcRkmUN7YCRT
-MV5Op1kx7THb/sBQNtE4Wlo4QkZdOGdX146Eb0VSyhRw1PT2xtfQBU0+sbNGid3NRAFWm93xogJai/uF
-FEq3sxs1xGjEk3K+AMcB7vYYeAWZYkUJtK4A0gwaZ5Nlnu9OcpFORWoHTa1UJeaslJp7TOOV5MmNkNhi
-S7EflqoCkkgBzc35jQDnYVEqlY1zvIaEBBDdkGJ3WTUrlxXgXsC48DwnqpapMFT41vG5YlwKphYiAeWd
-Mq702KoeY5elZOKezxe5AO+DHoZ/yNRVKhbtFnwld6PVZfjXd1bTf0OKHoS24cXI20CzsWCyLLXnBU20
-eoz9d7lkc76CUSLFhb1NckCXR2QrC8aLlSd2NNSCJXkGrLWQ5VTyObYH3IX4i0ItpXhbF812h3HgcC6r
-5YJlBTRWylRIZA58ioQTWqqxWiYUm/FboV1EYfH59wUHHH4i8j6wWy4/cjlV7Ftw+iSbl9JoDtU8IE6f
-AOnZuRWQtmmrw356wqBlreafPwE9K1d4ua5xYVzYA0t4lcxYW9x3NBw2UPHkZoQ64pyJ+x7+3VOLPKva
-rQ8FjiljDFzigr3jEy6zLjKaFGqZV8B6XhN3WZ4z9JGRNkOjmxTjRUr8S42By3ObpUuem24jg05KOQf3
-JWVpNkHuqnIQasYY2Bn3pl4uimk1Y1+cs33THWaM3rmH0tXwuifFIueJaO/9+YN6yqsP6tlel7VM1x6Y
-yJXY2MYgamNKFgxk7d+DpoDOMBag/GC0WMbOWf85y9jnjMvpEmmhcX/OsmfP/KFY8GrGzh3cVXb93HYd
-b2aFqniRgK1FxFzPLbv0+GKRr9p5Nu5ig82dhNeBgjpHmWs0XFfwtEaAUPiEy1VH/80e97hW3faJ3mKp
-Zm0icUAzz0S+RNFTW2QPuGUuqi6IFERc94lA15asiyzvnI28FXLFlsVcVA0Wg1h2LFheTqekz4G7//Md
-S8pClTmq/Yk2BxDEVRi9hS4FNY7qC5uTPFMiQMsJ9Vp99NGXdCfhC66USIHUckmCbplL8wRb53sEco40
-R/fjfO0D/pjDQ6TAz/FFRieQb+Z0AmdpmSDDAsXAf2atu6xIy7uWsfRaXLTeZvTUXSlvhGRZ1VKmNdDZ
-ImUt8FNaPYtFOf6BnbM2Ncp+/pnBfc09TYKGWDcJmWbjNgJcZddAu3L8Q8fdZ3bU77gs2q1vMqVAxe20
-2DMi9zPWQt8QrBpaslbXo23nuW3IjtuE50q462Mp+I358+GJ/kJ9LMc/XBnkriOVgqhTo51GLd+65BmQ
-r4HHk5lIbmDcHnw3apqpSqD0hM5l4JAZE7sGhATR+KxZwSQ2KwmKzCl4Xig92rNrd9AdpWfAMwQn1jSo
-BUgkIrsFvwrInwuGRlVUQnbZ3SxLZtAe+qn2udB7Rm8vq9CBy0Ul0P2FVirfb6071LH1htFgI+0Nkxu8
-qAAzvNHgAht3c0JOH/xzXrD/Bivq5kvnwbnEGygOHZV67KhBKaqlLFyLD+QTmbYs6TzHQj//ChD3tA5F
-ROZJEou6P05K/CoEvkbW16iYiwHDvTL9EUg07SMHHjuOfc310sg2sXZgCXiRIlsgC6Cn6D26jn/N+1+F
-94G31KpIZrIsyiU4ye8dziYIoJAVVBB4r4AbRj4YIhVIWztoHsNHnOZU/oN5Cl7lD7fuEfF0jKfhMsPp
-zUz2FFpfVB/zcnppWh4VjNJBPA9epwQREhVm+Dopckyd5uWU6VwieOxh5OZzFPWoG7/bmTdyI+pMhjYG
-FXuRVW/AqtSsI+lE4CHS9Z3AvZEiQWdq1VOzbFK1O74rE6NjtXN0o92C158xUP9SJFf9a9sM/Dm4bmPi
-oMdzLudtg2qn2ekiWrR9v+e50ew6g6IZ4JNz1jKdba3R9sY0lUCiW55nqfUcz3Q72lJv9jraBKRE9T6b
-i3JZ2W50WZ9Mxsb8VZiE/qdNY2kl9Qas74InGIow7BpbVlmeVZnwIjPqc5TbucykqsAbmIP2LQqcM5gu
-cy5NrgEN5p1oScGmJWZTSlQdiKGepND6wQjg2n9fvR29eMn++9vv3757+fryMU8wxrp//etf/9p7DOTP
-X3z8nP31r48A3f+f3d3d1u7eY5rdP4N/H24/bIc9L88JuPsIYPYz08AIveGB9//xkr19+fX3r0dv2cv/
-+u7ty3fvXn375t32V4zevmQvvv3m1ZuvPbdIihaYAnbHV+iAgG/H8rKYsrcvWZ5VQvIcND/7T37L36Hz
-0GPsMrsnTr2brVhaFi0MLlbl8kvGvp2hIWM8VyXlhII33MmsQreIEnPYSloKVbQoJyUXUmBrQiV8ITzz
-pirB0y67A6YvywUFOpliNrLtMBCGm5jJe1JgEvMJuugv3r1jM3FPktFlf3j79VfgqM/E/eDojO39oX3F
-dyf93dPrTtO3vaz7ZE07b7/++ivT1PAgaOqn4UNn/R9hm3I6Nm3K6bgtpexOp9PueDzuQONyOj5DJfpW
-TF/eL9paM7dbf95TT+V0vKee7rX31NP2XvrToLv/0NlTT7vx3y32zNiLVnRvDz4+bZnbHZc2+LC3N+2y
-1ocPrU6XtbJW53FYdznnBnO+EXW+p54GmG3tR/S3/3D7yzN9+1n7y7O93l76rPMlAHV+RQ9fZsDH7O3X
-X4Gxevv1VyPdofvNHfryf6dHX/6KLo0K9l+DAdsBfkrTdE//7rBbni9BhbP7wQCZDfNF8M0x7aB78ND5
-sLf1gnr6qWPul8U0z9RMmyTwveEt8P8ztnfFd3+8ho/+7ukHdf1sr+s73i/K4lbIinHDa+00Tbv6t6Nb
-RMRBhZQQEELnvOs2oYwDCa14N3UIOC1KSfkT7cArXmQVuHcprzib8SLNdQxkZy5aaZq20HMtC5zBwNmZ
-QrDxqhIaJZcizQqWiiSb83x9rhWeQIcjxBHQSogMYcxknoRnok4DsxbLPAf/DPwHupiUy9yks53rjS2T
-bx/qzun4fQntBkncfCnIV7Vet0p4Ltq3NmHDzln7G17NevOsaN922fDwsMOesuHhcadXle8Q6fbgSLuS
-d7MsF6x9a5Kin7MD49FCS60+uK+3Omuje3773CQWnBdOYz3nVTJrhwYAOnKPbwsTgboxIBM2r/9uAcPD
-S6lfXK6uBtcd9oy19sKrw8ar+9dhqsIycI0xLcMazu71ep0mzjXjS0/N+Qpnbfz2KJylbCKoKs0DNPaT
-Us6hGejXbDab7dkPnR70mFgjplgulKJ6kAOWZtOsUiyrdMS24GkqUlYucSrqAFPqmg1SlpZ3GIhNMpzU
-s0kQkIrtrB+T6DGsH4vL34D17weD9yW067P+Zrbf22PfcSKK1ix3WTXz6Dgpl1KTEiPyTCEhwRNqL2Q5
-5uNcM+beHkM+FordY+2GnmpLs8kkS5Y5kl1xit5JXWVFIgiYKSHmENeapriUmI0CHapHjkiiZzUKthAS
-eEQ7/+V8nBU6BTYxjUz5fA58IqXA3nc1xSncqCQvaPYDb0HDf1nyorL5KRvwWjE/P2cDF/NCkEZsonlt
-wS1/Id3GKybFQvDKTOPaWoUeYzuTHTYWSTkXyrW3M5lMJjs9xt4lPMd5YGBMjo6bJx44ClhzAu8YHh6b
-vK/ic+Fa44olywrfXk4mVs33GPuTYOomW+Az8yxNc/BRxQKJgHOjabkc515TIfY2IEe+xkKVV0XVvgWl
-12VWSz40k3AYkXCeQcCWCp4z8L57DAVqwVPFqruSiGvYMyapawcNWBtGF1icLReaNB2gJnJaFAYSXFax
-MU9uXDtE8WJ1x1fou0NsiyTRvcVck8ymWcFz53w0kmM7KfYDUryfSSHC/oJc6ESrFoMaU00QE3yq59oS
-vWmPDfoTw2PwfdLzbBQOVk8tx6qS7WGIJgwLUof04kywoecg9HxDhDZTQtTfjjrO9pD8TVav2eKRA7fe
-5nngYDrel2+//qp9S8kUzDot8iwR7X6XDfzUqPcY1l7gcyPMYs35oo1s0Gk0f4rKBvUMMmjWlg6bWqE7
-hjaxmolMWpOoCzLmxnrg7CE5blaDg6lBv7dHFk0rfV7ouXsL2HVmTHvKKSlqMBnN9ulnmrT9nP764oGN
-jE3zTK3UtSrlxF0lLRzYmiYrVmsfM+C2Z/YF1GbNVs3EPY6Db6u4nLosJca3Ok/p8Qhefu5ghgeNMMMD
-mrczZk8j1p65qXhgsZm494TxwAkj6Ntz+LSRCb6465CddZnssmmXjf25LE2jnT/ssGdM6t+p/h3Dr52H
-8pKSvmzAO0k6sBsdNx/tTwbHXmDgB7Zp6kwLI7mCJJHPWKvLvBgthBo+CmrfQXVaz/25Mi6na+fL10zP
-N88Zcjm9yq7ZuR02uuCncf08L7w3gHU6R1OFy+nj5Ns6tCC6gVw3S75N9kzYNLsVYDgwb+AaodINni9m
-nE0ykaduupzxO776x9MPlgZ1JeErN2JbrTD+jpoCA7r/QHmsa4qagIMB8ebg3VyHbi2RPLkBu2EsjZOc
-P4DY0OTCjwuettvtgO/71x32+efI+j9byWj8145kD59jJ7/wuaF+rt8JA9AuO+r8k4rde65nCWnyJFFK
-CxMWr2Y4kOjfw8MYiVGoKadjYBAQrDDGfIvvUVsiJ1aAl59nPzbETPZWFDelYkKEAQqnYmIcJXQuwFi0
-/tBa55wY24aN2PmmhvC+VwlVIVTQVComfmjf6PZQ040pp32g1QEL6k19UmrlROJYI4jnIoUSt/JmEFGb
-nfszgewL8GW/ZGQh2BkbPA+TExytEgmStTCMBCT8e+j/jW/Spsbr7be3QlJi3mnXZMaLQuSgp6ije8gw
-2K9aN5WoRroXto9yOu5SW02TpesUiO7xOT35KL8zHLlvsnsIcoVMRFHxKYZ4nFUZlvfkONkIY8fGXIk1
-vZlngXoEyC620DXtBj0arOkSPNd57uCGa+Cg5c7zdVV3B8/Zs2eZr4XTbDKhmeEhKJhdxMHW2uk/2Hlj
-LKHvgr+BzTy1PYq1zXp6D0KCv1vkWVXnESsj1txBTG+zTNbWf1swtUwSoVSX8ZqgmeI2QgqrbS5Jd2kD
-5zkE0JqBVwLzBoPa2Bqq+wOMt5x+IqZwGuqANBR0rVW3hgS9LufIPY/TCr0epnhe36r51RoT8diX/oJ3
-2pLGwWY0gDFMZZmg+fsXYBOKD62KIU2pxoBI6Ykt+dTbcqFYjKQFM3AcA/v0xtWBVzJbLEQKbIXpJ6rP
-dW5QVbK8vBMy4UoXQAKb0GvQsVsWN0V5V3S1sVOOw/x6nlyvdvCwrEoM7uZ8sciKKTyZCpndipRNZDmn
-tFFVJjfQO51x7VX3lT813lykRX6c/56tWU/MxalFicuv1hAutM/G4sVFU4779VAEmgr+h8RfZ6MdBFZV
-XaM2w5bOaaqnKl/DcLzgSrRtSuLv8yo3+aWe4exX6+/wwnXc/d4OPw3lgueiquoDgTAv4Pt3BBHaBuPz
-PGGsfYWpLMF2Rm/evWKDox1c4sAYa/2hj//AuP/hxQv79eDl6ah/RFcPRnhVw+8fHB2ODvDO8eFh//gr
-/No/Oj05HeHXi/2L4xeXFv7w8PD4cB/vvLwcng5PCb7/1WhAVy9fvDw9cPDHw9NLeBzuDPv9F18Z+MOv
-LqgV+Pei1bV5MejY0f2RplayHNP60Vr34Mvhpf16cmy/jtzVC3f10iEFD9pWDi9tK4eXtpXDy5G7euGu
-Bq2cHNtWTo5tKyfHtpWT45G7euGuBq2MHC4jh8vI4TJyuIwcLqMQlwuHy4XD5cLhcuFwuXC4XIS4XDpc
-Lh0ulw6XS4fLpcPlknAxPHJpBwm+6mbgq24Gvo7c1Qt31UMGxsW2YgcJvthW7CDB1wt3NWjFDhJ8ta3Y
-QYKvI3f1wl0NWhk5XEYOl5HDZeRwGTlcRiEuFw6XC4fLhcPlwuFy4XC5CHG5dLhcOlwuHS6XDpdLh0s4
-SEAW3Qx81c3AV90MfB25qxfuqocMUNS2YgcJvtpW7CDB1wt3NWjFDhJ8ta3YQYIvI3f1wl0NWhk5XEYO
-l5HDZeRwGTlcRiEuFw6XC4fLhcPlwuFy4XC5CHG5dLhcOlwuHS6XDpdLh0s4SCMnSSMnSSMnSSMnSSMn
-SaNQkkZOkkZOkkZOkkZOkkZOkkahJI2cJI2cJI2cJI2cJI2cJI1CSRo5SRo5SRo5SRo5SRo5SRqFkjRy
-kjRykjRykjRykjRykjQKJWnkJGnkJGnkJGnkJGnkJGkUSdKFk6QLJ0kXTpIunCRdOEm6CCXpwknShZOk
-CydJF06SLpwkXYSSdOEk6cJJ0oWTpAsnSRdOki5CSbpwknThJOnCSdKFk6QLJ0kXoSRdOEm6cJJ04STp
-wknShZOki1CSLpwkXThJunCSdOEk6cJJ0kUkSZdOki6dJF06Sbp0knTpJOkylKRLJ0mXTpIunSRdOkm6
-dJJ0GUrSpZOkSydJl06SLp0kXTpJugwl6dJJ0qWTpEsnSZdOki6dJF2GknTpJOnSSdKlk6RLJ0mXTpIu
-Q0m6dJJ06STp0knSpZOkSydJl4Ekad9vKsWKZo4lny881+8EfvC5wRB+6OsL+MGvwyP4wa/7ffihryP4
-sZge4D+8c/ASfsg4nsAPfsVGqO2jF/CDX4+P4Mepd0SGVPYIfvDr6QH80NeX8EPqAiHw61dD+LGtfPUC
-fvAOvolwv+jDD30dwQ9+RVypbfSYX2Jt33UnjDOSpZTCprV0pNE1y65XNGWxVEJiLm/aMBmQrA1AavFJ
-WGGeMl1AzpOklKkuAQhi31rgW3/zG706D0LPHZ5niRjnS7FzhmWJ7eFBv8uGBydUPLbTRaCiyv6yFHez
-rHJwhwC3f9hlw0ET3MABAsz+KQCeNgAOLeA+vHS432XD/kED4L4F7B922eB02GWD46MGwAMDOIC3DvYH
-XTYY9g3gX5Z8zmVW2J4MhsfYWUBwWIMaPA7M9mIwgF5AVwanJzUw24dBfwj9hI4c11GzPTjCDsDHwPb0
-x6WMRguRcqMFAIOtEI7uwwON8XD/xIdwyJ7ua2SH/aANj9IDg+i+GeSxyKYeovA0ftihGGfqLx7jIY5D
-JNxRADHYDhIx0WC/ywYn+wFIxD4nAHJ4EoBEjDMEuP6xAcl5cmMA+l0G/7lbRTITKc/nZZFGjB9QzZc0
-asONCtwbbLo5jG7a0YKb+9HN4JUH4U1vjPKluM3KXFSu6ydddgDjPbQkluVdYe8fHXbZwRB+/dvhGB0d
-wK9/Pxygw1P49e+Ho3M4gF//fjg0QNR928GlzFd3ZekIPwTVcHIAHa0BRcw0AL49rEGF6A5Oj7tscFCD
-iljquI9ME0NFXDU47LITA5TwVFQ+U5weIlt22eCoH8M4VXQ4NMJ0WGvJaSIYpeHw1OcUC+VkG4kFHXQs
-Y6Es6igt+wc+6yQzLisplqpBkfZrMA1qtA7UoETrQA0qtA5UV6AOpkzKnHuGbADDBs3s12BCZkHU949i
-oIhXAPX9/RgoYhVE/TQGCjkFUbcwpeR5HZuTvn8/Qndw0GUnRz5AhGr/KG4hRPN0AFj49yMMQQ0cu/vF
-BLP/Pj8P+kDdA2RCH1Jl+U0oiehyDPsRzOAxQJH23x8GzKyBwq4NEa/jGKXYdTjyXYdkxQtPkUZGFe4O
-Nt/2FXhkcOG2r8Ijawu3fSUemdqUy5u6aQnvR9g3tDAt81QU0ilSrUPhY9AEF/HbCequJsCI745Bmxw0
-AUZicoBmuAkwGqj+oMtOfDjJV85iAYT+CGCECCjS90y6BtjayM2M32SOXqfGs7BuAwDN+VQUFQ9Qro1P
-mWe3IsDphPwPT8ZCOEd+VCck88NGUKdZT6x72j9oBHX69cjo19N+I6Qbg4FhqCOfT0oJ8U/IIwcRjQmm
-Qc/WgRr0bB2oQc/Wgep6NoRJZpmTgcP9LsNYJ6QXAjmrhqpy6Au8g3LEPwbfJ5B7B+Xofnhg3lhvy6He
-P+iy0CIDlBRpzGZ+3xS6qI6Q6ASj2+LzjRI8YMTBAfrTQPWD/Qa4QRgo4BieNgF6bGhU4OCk3wDoEePQ
-xEkBZQ2gR49Do9QCsikwrL5uPB4Cm8Z0QzBfaxwcd9nxKfw2QXmu2KCm6gNIzx0b1LR+AOm5ZIOaAQgg
-nVs2bFTkGlBs7ky1lH9ZlpkSgdk5gg8fLAoPwAL30XE2MGKc8cLjuyF4uOCbOAixWGRFZO/RLziOQAaP
-gIn0APzuxzCRGjhCfRHBxCbE84UARN2sIpOKQu4NswMaPArKN/+oCjxGcFC+F4CqwGMCBxU4A/1QDWTz
-wPyRIjwMBANAxGaQMp2Grtw+jsZB0DkLNHgUlBu6E+1YeCSwUG7w0PM4CkhgodzwHXXZ8YlPgUkmxVhm
-LlxHau+jwoxBQo4D7j44iWFCjoPOHdTaCTkO4PZr7YQcN4SOGfd8koN7HWXYUKtgOs4w5qSUQlWBctY2
-wOvblGeFGpeydAFxH91m33eezkpVhe9D5zrM/IG/FQXMXrwFd+N4Oroduebgp/m34wh6P7wdh87H4e3A
-WR2iJjgC4g9jmMi/ACtlVcY6JxUslCPpGgcV+3waA8WaBVWZAfJF8xT1BX149/uhH+/fcnLWZfCff8s+
-RpzlcRfd7kecFRgtBBn48km//m1Lof1Bl9Gvf9vSBtwK+vVvW6pAVEW//u1De/skkh+8fWRt2aDL6Ne/
-fWxv71P66iB494m9fdRl9OvfPrW3TyIdEJjwwy6D//xblqaUsvLSVnjbEhR9Ovz1b7uWj7qMfv3blqCU
-L/NyZnjbJTkG5NIcBe+2BKVsnJeRw9uWoEdHXUa//u1jP4NCv/5tS9DjQZfRr3/bEvT4oMvo17tt+3XS
-ZScucMNblqDH4LPgr3/bEpTcGc+lwdtD3zOiX/+2e/FBl9Gvf9v5VeC+4K9/2xIUwjz69W9bgp4Ou4x+
-/duWoKcHXUa//m1L0NPjLqNf/7Yl6Olpl9Gvd9vzgsmTGfg646Dvbg91UDTo+8gdDDa5Agjh/FiISM2H
-D7EfhoP6w4fwAsYhxqp+wIoQhw7iUOdKB4MAjyMHcaxNwmAQ4HHs/GiMbPp+DgchThzEEGMfPwBCiFMH
-cWhmAoY+Hg5RzIzCr3/XURxiWfPhQziKo8ekP3wIR3EM0/SHD+Eovo/Bz6Gf8UYIzxKhh0QfPoTryEFf
-x+eDgwCPozAM1B8+hKP4Acb4h362HCFOgvjBfPgQjuIYj+oPD8KhgYbXSzXh3X4QsJsPH8KL2SAe0B8+
-hKM4ZuD1hw/hJUcghNQfPoTnlgIK+sOHcBQHrWs+fAjX1SP0aejDh3AUB91rPnwIR3HMtukPH8JR/PgI
-p1L9+VSAcC8xcZaPw7GjOOhh8+FDOIqfAIL6w4fwEgIHZs4m0MnHjuIngKD+8CEcxSn9Rh8+hKM4xGbm
-w4dwFAfNbD58CEeM0yOcf/QnIRHCUfwUM/f04UOcOudxoJ3hYd/H48QBUPQb6K0T58D1MS488LNSCOGl
-BHFGhz58COfl9k8x1PfjfYRwLi4oaPPhQxw4CEBBf/gQhw4CUNAfPsSRg6DSAL8+ACGOXfiCE1n04UM4
-cuEUtv7wIRzFcdpJf3gQDmAfglH49e86iuM8mv7wIRzFcdpBf/gQXlxhJ4QDLX3qKL5/jBMl/mwJQjiK
-U3lGEBQihKM4TvvpDx/CUdzNxQda+tRR/ABQ0B8+hKM4xqX6w4dwBMVJSv1hIcKUezAPGKYSG+/WJlCC
-u7X5k+BubfokuLsSeV7eBTqTEgKu+2JL3CbWx21ifdwmNsdtYnvcJjbHbWJz3CY2x21ic9wmNsdtYnPc
-JjbHbWJz3CY2x21ifdwmNsdtYnPcJjbHbWJz3CY2x21ic9wmNsdtYnPcJjbHbWJz3CbWx21ic9wmNsdt
-YnPcJjbHbWJz3CY2x21ic9wmNsdtYnPcJjbHbWJj3Ca2xm1ia9wmtsZtYmvcJrbGbWJr3Ca2xm1ia9wm
-tsZtYmvcJjbGbWJr3Ca2xm1ia9wmtsZtYmvcJrbGbWJr3Ca2xm1ia9wmtsZtYmPcJrbGbWJr3Ca2xm1i
-a9wmtsZtYmvcJrbGbWJr3Ca2xm1ia9wmNsZtYmvcJrbGbWJr3Ca2xm1ia9wmtsZtYmvcJrbGbWJr3Ca2
-xm1iY9wmtsZtYmvcJrbGbWJr3Ca2xm1ia9wmtsZtYmvcJrbGbWJr3CY2xm1ia9wmtsZtYmvcJrbGbWJr
-3Ca2xm1ia9wmtsZtYmvcJrbEbbOyEKtU3IW9oYq8fgTTVHleA2oqPrccYIAa6s9dWYkBaixBH1igqlZ4
-QE7SST8EiUsn+w0wDdWTg6PjECYqoDzCyfAQJJwdBGNlFwpkRRrVUmArvk9qQSKMEZv+UQwV4hw4oBYm
-xPnEr0+2MHWkrYHNbku5aghR7aAjwGArRFzFGfAEQsQlnAFDIERcvxlwQ1CrR9y5H7hNCBBXnB75bhNC
-xIj2fQcPIeKlKie+O4wQMaIQahmC5vxWFKmQ7jUGVSezBmacL9UswrjvK4gAMO7besh4Vc6Br0ADyLiv
-+1hO3gTZtC7HzrDn/K4Ii87wnYdeAV8u5mWRzLLJxCthc0USNu7x4WK2WwsYD+q+72v4gDEToivZ1GLc
-3WM/asqz6Swo/KeUExa72HDCAoV1hrSYymaALFRYZ0grqWzQYKHCOkNcRuVRxECFdYZGt/pQYUU6xh9Y
-1TMM3uhXHRMXhekrCzR4FFTkBYXVdBbK8z3rpdQW6iCMJsMyOoSq15iQMAz8mC+EjJe9Ha1vNBax/vpW
-Yxnr11hpXe0JeD42OAghw5Sn5xgMQiS8ghVcLmM+QqCgXNTYdGf7DNT2puoGG6scrZ63QJEBPMYY5DCG
-ioz20dCPpyxUXHdOixZiqJC2uFSnH+AeldciXkdBea0HNngkXNQDrIkfHNThoj7AyJ+e1MHCTpwcewlE
-goqKf/eHulrRLVQkuLA+ckAL3Y4CI+XBDYKodYhp7lC+4yrJwdGB4ZBQxONCSaysRS6JpDyulUR3bLhf
-E8lajfBg3+S5YhzjMuHBwK4TOdxvgBSPgKyEyENTYCLVYcQPBjKq/B/WlaUFjSr/B/06OQ1oWPmPYX9M
-UAMalf430DTWLtbpGx7UwZqcwya4Jhex3/DaJkfxpF+Ha3IXPZLPw/UYh8aYeAxeiCLUoNqt1ADRyg+a
-0fIHSwMMtkKEXQ9GUUOEnQ4cHQ0RdjdYgTLnsnSaCznwAAKGo+B+iObh0M8cEURUKnvih0gEEaKJomut
-BEFEZbJ+gDQXabacN6zhblhOTbANK24dWRAiWu9xckQxkDNLPljopgz6gYrwAUNP5fQwGDAPLvRVQiXm
-w4XeyuFhMHgIt1jKRe4ocnBsVNigCc7TxwOdx447QoBechXFY1DvCQF6We1jXZQed4UAnT7epzmouCex
-CULTiHlKm63XgDXFjZqof1DHUS1kVkzrE9BUZR+A1hZGHA9txi2EpLUR/pqbU8yH+QHhPEuL2Nknhe07
-cfOsqBIp+DxM9uigxQKpaiVL1bBmfmhnOSxQw7L5BqiGlfPOAbRQTYvnbUbYQjWtn7d5uXmZJFxlRR0r
-11LBb/kPZUMV/DBw2zywuJPr4OI69GN/AsCDiwvSg6DAg4u7OvDmBQt+uwpVjguK4F7DSk17v8zTnCde
-7/dNys/aFFyAl0o+dsoPl6cPvTXxFsaLHM0awaNhDOQFjiaqOjyJgcK4MbSDFqhhOZaN8JuWAx55VRqN
-SwHrAJE7DMiEANEQ7u/HAJEb34/v+/k3JNhpA8RgO0iI6VEN0Vrq7bDW2Vrmbf/YB/ENF61/IIV/EEBE
-JN0f+DoltlZIVMzZWw0WGaqhnWp2kyCxjQJUSbMb47/guVgTT1OE0fcBg3CSctG4UcMwBhqEXIkdtOrc
-Qg03haYWaj9MPVEUVYNyxQZm4c2JD1MzHoPjw1qmIgD0ZtuOa5mPANKT0/qWLQGkJ6z1DEgAeRC4UFEW
-BCBrNg7nfKgI4OC4CTDmt74/yRlAxmyHY9z48pj7DmLespB1JrSpgwVf8BW/m2WLKEuDRttCCZ7MFsvJ
-JASiidTDGChe/9QMFZufYFbXQsXG59DPQlioeFHUqZ+GWAi5rOs/O5FdT65Q/s+/H6/qH/gJ3aaUyqk/
-+duUTTn05+MbEinYA3s/XzonCDniCBeuDbz7MYrHgcjky3m84UDgEAJAvI4riAsAIF7DNQzkorxLo30u
-KKtx4BvqyCGHbuA040EI4KkwvQLR60vkggMpD8LORL73UK8+9HoTOt0Y2wRpydjeeQaxJs3hvVpQ6t2r
-haPevVogau+VahVuN6RXnfuTMBaoYVmfyxNaqIZ1fS4ZYKEaFva5FecWqmFln6u3kuWKB4mcI2sohzWY
-gR9c0H43hzUgi/rRsZ6UdANvgVy14YkONOsYuRrOU/JE3Ogrnqa5CIle234mTmzabL91NRpzmgd9nz6N
-6UywDja8b0xkgqU5CV8T6fijLjs8tgBFGrLQEAQGk4k2mxEHmAdHxpQfRxAD39pr9+I0grE9OrZ7a9ga
-qdqGBkfH1rWIYQ42oqNmIg93ANJxwUkEE0/yNQLFkw+nfrLRAMXTDsf+nJgBapjJdNMYKhNFwQMVeDLs
-MjvnSPcbHAbrLxBEg6Ngs9YE0eAgOJ5AiLpj4KiyNpdtI6paGtvLd0cwTl2jnkULG7/K28XqRBeTObGN
-89Y4tTEILFA98wGonAZmzMJ4SIM9HQQbFFgoD20sNA2mDy3UfhDhnZw2vtBhDgPVryEeZtCHRplYl7xh
-n43Tk9qsQcMeGyeHtSmDhv01MJEVptrqe2vQwISZ7YakfgPyRS3vHUzdw/2maekAoGE62gVkANAwDe3C
-MQBomn62HvO6bNihX+/qAdWWZTRC1ZZnuE03PKjaMg1X0+xB1ZZr2Dr22nzJsS3EtWa9PlNyeqrrFD3+
-qc2R0J6iobzWZkcwJ3QQuFj1eRGcW+8HnF95M+G6vsgvPql4bUbw0CuTr3hsOeEVNoaoeGw2A7+/4kU9
-62EdqmqWqSr3dsQ7MtuY2F1HNUicbgtiVQ0TZxQDr0XDxPnEYKpJw8QptkASq3LOqzLA5vTUMxt0f7AN
-ICqPGnpmhQBCRGHorVUhgKgw6sCzKrVUwJGtduzXYAIJC7d2rMf//drGjvXIv1/b1rEe8/druzqGu/04
-F829qZ4PAEWJORyrAtekAnBa0aq5NVkArN4+qAFFmbJgccCa2B96ZteR3M0Ed/06cMniUx8gLt8Y+FXL
-CBFzN1ZfH/gQMW8f+Z1GiJizj3wd2LQHTMAOCKDm5U3T5rrWs1o3/9oP7jdMvIYADTOuIUDDVGsI0DDH
-GgJEyT4/ufzk4fmTvT327tvv3754yS5fvX55xvJsnJbV3g9qL8/GHye9HxSAvCgXK5lNZxVrJx2whEM6
-oH4my3m2nLNv37HRspqVUvXYKM8ZwiomhRLyVqQ9aON75U4mV+VSJoIlZYpn8k/LWyELOrias6/eXeyq
-apULlmeJKJRg1YxXeMj2WEBLk3JZ4MGn1Uyw169evHzz7qXekftJa6noJK2karntvb+WfMzGfAoILKss
-z6qVPSrK20F8ws7ZT97BR2/p1CV2y2XGx7lgUkyEFEWC5zkz7h2QCOB/1GB0nFdJx3PhAfT/dvn9mxfv
-X337pv3H0ds3o29ednqMmWtAAV6wcgHY8BxaEirhC+GOLq1KxheLfGU2JA9OELssJRP3fL7IBR26SgeU
-6iOj/silau/8Wxv4oMqKaafL/k0UQPnv3756YU6uo/OygG+eNp47+hMzz5+xnf8AxloPSydXnbGdr8ty
-motnO3SQMeL6pwy4Q6hlXgEFqakuI8h/G3614w2G3wP/aC9VyS4MiaLzvfSpVaqS7pSsf2tf8d0fr592
-PrTbV3/+0Ll+1vnQ2Ztm3uHMeM5cl00KbMsdF2YOmatWC1FO8D1XGuAaj8xbFngUqUhbHXtWKx7Wy1rf
-0/lrll/o+Dj9tD6EmU68k3i8ut+2vQ0vnxTmRK+ACj3Ls8GB0rd6m/s1sFeT4rotb+1ZePr4PXqP31DU
-i4gJqTOTwjbzJDxbT97qo/XCkxQvDRq+FLOlMscP+yhrJnmRZ6KoFMLyNCWmNwfXVSUT95Uo0gY276zh
-HkcLvTG/FYAz97XrX7eCcdZwDSGROP/x/pvXZwFn+mcpzvlCvw9PS/i8dcZan+XVc318AmOtL/DS1L/0
-GV7i84V3bQev/WVZeoA7rR24+If90+ctont4yncgD1eff/HZh50Preu9qS8CHfaTAZ/zxdX8Wh85/uAP
-4NeiQp1jjg3kSSIWlUjZ969Yzovpkk/dIdfmEED7Djpq+IElPM/HPLmx/AADmRW35Y0gPoBXkGJQPQbW
-xagXbFFUQqKetGjkJZ6wAbLja/DeVFQjxPC1wS04FVOj4Y4GvMuKtLzrJWDKBPvsM0bfetngxAqHd6mh
-fdfo8/hwSyWq99lclMuqbVHwJc482b4q+G025VUpe4ambvR28cS/j63OtZNhMPO1ofqOSyUYZ39ZCrnS
-xsmcQDnjahYcA1nxG7BUbCnz+AFnuFpA38E52pvP4PuQvrcYL1JoKjHHpHtnMZfjH0RSBQbwJxyowRlr
-0eNd/Hto/2YPPX1GOtenpOOxhnyxEKD958u8yha5YFU2J9sLLftYd1lZgH0kvsm5qvQpz3h4KrVjniM2
-iw7gLFJzVDkY7wVXoKFAIy6nM5aKWAewsZiUUrCxAJLxNBVIDm2ftYUjQqw7IBPRp0O79RGLUp85gifb
-0gmfWcVUxYHAKCXcSEUuOJ5Q0vqyRafFtr5s2YNis2lRSv8k60kPm/z/kGCeOHgoOInwLtaPtP5SWz0f
-+XPW8MiATv+1xu6nB/v3gmdo0YOnFnlWtVuf0bmWTWcG41NNp5KbJtk5wVxl16a585Y5wP32qj6GbQC/
-6l93rtl5wxDT7cF17RRhsHTQG6twvn/72qfqglez7QpGLgvg5voV3WJwdGcjBL3IP7oTLoQH0+Z8vrAy
-lRWVmAqJ7iRTC5Fkk0ykDGshYi7VsA/sFpmThKkqgb0SaJTYqw4+zwp8YJ4V2Xw51+YCvWfrtTY8xe/p
-KX6/9inDyvh6n963XXhbFx52ZL9ln8PVgIrzrHhub3+B8MFtfu8d8nsbEPK1mFRswVPr9BMRia76fPOl
-Ql2gLyYzLnlSCblO/lUla3K/hqj6BQCdCpVJkepLvaaGy0X1MSFoE1RA06gvLFZdlooJB3MLb26x1voT
-eReo3mqnwU96ORAk9swJsa7GggYEunrOSNTRSwI6azTPzZeffwY0kJ3vZlkuGECas+M/1+3SgFF7+rln
-8KcvBfhn89gVy/nYCEAwdqhdjVL9Uciy5tJQ93+2Y6KbAgK5Vn/tCG4gu26cqyDeNEPwYzQEBG1GIYiQ
-3JhFUF3W6rdCr/2tPi/bMGdSFhXPCp+/NV7msGrHWSpwM+aimpUpm/MMW6Be8CpLWMKTmQ2Tcy6nQlWM
-z8tlgb4DJWKgaYyIb4VkUvxlKVSFJ6a/qpia4WndrcpGFFXJpqIQklcCHJGsULwQ+YrNllPhmsYz1W3r
-axVfw5A1YdcogiNDOHeWt+6o7YM3/p7v+ifbrj+q/miC9tKonZ+zfqDBWi1rZCfsHKOmsFGj/j6Z9FwP
-qAn/CjtnLRfOBzKpX/1FAN/zEYyaenYe/B0b06AZ527oBokpmebKl4VaSp0O4i47kil0pHVEiYmZREjg
-NvQXWZ7NM+uFvcvm4EguFZ8KlpflDcSVN4Jo1TNQKCxSTDNVCfmqyKo2jRDPuZy3ywIudWxWAxkdna+8
-BAG547Lwjo/TZ8wD4enJdgdwLspKR0AGcWjrkCmRlEVqpejVhK3KZQucUiHBmYaWFfgS5QI4HWMpoMic
-r9CpZ3lZoJWf8cI1B42gw84L9G4ZZ+lSP56BaczzzIByhXhb40HXXSQWomaaM+FDKooKHAvwt1UlOB5o
-z10EaMati/3KczYVFTm2dxIcdWlkuJRM8mpmusIZ2NdcGLAeYy95MsOGNamhEffwHSWbtCajp1J2K6SC
-Tmt5tGjdoYRXJbTBmZpx/JOiN4xIMwWjBq5pwpVQ7G4mpEAC3PGCEhM+f1ZgTlQFzZWFIBooQSfv63di
-g4YFdEDBzTuZuF9k0oXWJNbIgDbfQ+zYmogqmeloAz1emwUsyx7e/BbvtQ37vlsmiVCq02XmyiXP8qUU
-jqdrkfxTP4oHpehnJ4G4mBItqMXYCJJTMlfsW8NTznwErAdt8cyGV5JnMOgm6rHNM3ZB3gtQ8LDf77O2
-5fROaFINmg/ArrYDmEtwPZgJExqS7+dCx7FNRMB9w0SmHQrSwlaNP7e2TdPgWIQ48MpaL8UKcVd7W5Do
-MJxQy210Na1JG2MiSmlvaa7AyzpkT9mg3+8/17dVBbgbnpqK6h1cMBGcRr+ewMDTTjPFymUlZJM2zuZz
-kWa8EvkKDXZLMRSZqmQJX1RL5PZC3JnWVFIuMF+AZDNiYJKpveCM1Za+3XLJ+UwZqR2vKGFomnAaB8J8
-1BPUkhN+rVJsQGkePd+SwwFYTFWc25SxbRTiZWKGVod9SWBnjnUoKewy5uyc/vcla7co2UpZ6TM06zph
-RKakBxam3fIY4SxSGym10J4rtoeD3WHPWEvZVuMG8xIcc5PEQna3FCiWea5zu102Vx2daISua7p9bXXu
-2lyb56U0psIw506v9C8zluSCSzMCBuK5B9CEaJCgdlG0IT1OprQBvMu4nC7noqiUS64F+VRvMqBxZMO+
-xQQJk7Lx3Sh12Gk3euGVfy4uGDsSWD4BuVM32WLR7JdPpEmOxt44mp1KFCn5zMZ9BtFLxXg5naLrWmi5
-1dgrxtFDIVsSGPeCYZ63y8Yi4UucsXNuDxEKPALFOIApNl5BQzoA1ULJQT+AT3QHivBOoFU1yI9YtVpk
-Cc+JAHOciwTvDZ03z28LObr1roQeQ2/usuQGM3rgqvEVS/hctLqxzusY6wnaYd2/N2WVJaaP8zlnfw78
-QAhK2EJmBeXN50Khr6mdwR+UwbDLJmWel3d63tPkTXV0go5NQekWjXrJpLgVPNdnEgMjR8aaxp6MLSUB
-L5EHwlyAF7khIxGfaNcHWR4fpTQ840m15DlrGRq1aAjA1OV3MJgN2UYD66uDGCdnngJMz+vIf1m/9IwN
-2Rkb2mgHO4I8iJcqudI6hOa0wJi+lLKUbZ2jTzh4TG1xb3SNa4CdM3HfI/LqNOKHouWSgPZ1Wg4otRnl
-LH1kKX3pXtCUw/QavMrYbvD8NRgh+/RVdu1mBv78QT3l1Qf1bK/LWq1aotJrNdArF9ltlgpy8qu7UjME
-JcEneVlK5U/HdNmyyIUy1yCST2k2Bq5i1hvdc4gjEilSPKdbzYFJ0OfpgmNoOFoJljmFgiIkslwnNayT
-vVRisszBwybtZ3Ig4DzIZZFwiJ/5YiHL+2zOaWYP54Qg8IGGKJVP9l6VLC1BBaTZbYauv5lwUsJ2fZWJ
-HCIfNzltuoJBU14qQVmjuxnoNHpsnfgVyznp96abqSjKeVbY28ZP1fc9KVJzLqtLGA8asCjPQ6/oMr9F
-J1S3PEeLqMHYng9mPD9Ne3bOvuHVrAd/tm953jFpAnN/F5v7nPX6/f7A8KwxqgRTm/vSt7FhZCrb8sP2
-whOtND/OecGnQv6LlKF8Q736hjrFkhwC5xkv0lyQQZYFJx2d/WgTrtaAvykrcebPRbJMFa2KqeVkkiUZ
-TUqh+SV7iKp80AMSSdEC3h4vcXZPT0XopMGNwMm7ZcFveZaTJ12wjIzCmVfkMauqxdneXiLHy2kvKed7
-g/3+sN83EMMeHeCPk/dE0zlfoUUfC7bgyQ2fipTqZl4QBjiRDxJp0d1vbgQbkDT9DJwiuVxFTVazTKa7
-ALXycG56kS+UqFIf3Dw2e22ml+2VqmR5ydOuGelSppiREPY9lIcEJAEQs5NvxJ2QxvQrU+TAyhyeLQuh
-eoy1RNFimbKx7RJLHsHJyVc0o2gSMZNMqsqiBJKZVSaDxHMpeLpiC+BycphIf0TM5mcTTd9IkjHzZa99
-hEDQTuvP+aKtHVz7uMi9ugGRN8xTYxmBViFR6z0wHvffTtrQe5xS3B10tKUOAZeFmmWTigDJqgOEpSnZ
-Xc+qjdLUOltYopPpupMy8MOMSmmYC4+nrhWEY5NSzrlmPc1OBofeD6osSOQZeycwU/C5EZMyFb0p1jWh
-sJDQ7VlOVHsgwru2qVk1z79YN3q9hSyrEqKQHk/TbxwJ7KCkYqKH0/ohN2IFPOvukM5PxQSnOyfq6kas
-rr0o55NUTHo4ljNkVK/KKCA9PkdtmGs6PPT1P7rQpoJu59M3o29efrrD/ObJDu98OtjpMlElvUe+yzKc
-FwPuffiUSr6u/vxBffj0+lnnw6d72bTrgbiyly4Ly72CAM4SJqDEFTzRq8rX5Z2QL7gS7c51Lykhhqr8
-WPRBB5EPcWnG65Knniw7XWul2vDneAn2YN2k4YJXYCbYCOs2zF/B9AwQVCcncWYFC2WMlsEMg1ZY07K3
-vmanayp3yuJFOV/kohJB7c5Y2KQ7eGmg+bxEXQZRDj2lwwjwM3X0xw1mWtcqykaCx4ceKM9yqqaAf0Gd
-D2bbHBWD0iA9MdHvsqLUTyl2J6RwLaFy3i5ik6xIR0UKQ9YkajjAmvJdjzzO98q90qNYrSUluK8UhyAs
-YsXO2dW1uUQE0JeeOM5lZWGKjsw726rilWVlEOLgAnPNO51OarXTKLL21evAnbZwEOHkD0Zfb8S9LZBa
-8ypHuDYh2dVvD0RIkyRq0h+Ojo30gNDemJHp0crira3cbduhc3aw+6Q50q8TvDfOihRb7kIoIn7loxOe
-K0q2swd3vWOC15h8sSKxRnAiyznjTQZpO5vnm/h7KXPgbT1RQCnlssAY2vH5/Qz8Cgiu/+ub1/9RVYu3
-NLnZpo7cz2SvLHBwi7RpyICNAAh4dqnYJ+ds2O+HZbj+ey2lvYve853nYUVqwLHIC57tbP/nu2/fUFUU
-NiGFWpSFEu/FPU3s4RDq7rebBwr7txBFu/X1y/etLtAMARElUaS1bB7Zwk8HvV7v08IvJrcVkCIXmIs0
-HgiXU500W2cQ5mqqCyt8K+A7PKBTTZLTe2mvwQ/G11EWJGrMNkCR9Vr2qombP+5zNcV8a1i5PVdT50N+
-+LT9IX3W8etUGVhsdBtrmWRo6wpvsV02uG4sQP5OyN2sUBUvMNRbrIC2NTQfIS8NXcP3PYYKYTktd8Z+
-hZata5Nz+UrjhrbcpKZZVZYbGACNejMT4C3NTF4mciqqpvEHwUIesNN0/JHM0FiEZBK8tjVbRabHL9OI
-6S5QSGPsNcbXPaaz4CroAoKPVyaF/IjRm4oqYkVLeOxy18fYmzoz/q0OZyyqReinGtY0CJ5Hbqx+jgx6
-YAlrNYJUeqwZ3bUXFSHrjhqEOr4frx9y4hJOG5kCfw1GM0WunUDCzNSHP5zRKgj2pR3AMx8uMuOImaF2
-WHcX0fiTtuVDI7jlhNRShx6098/Zlfl+7c9WrjH9+k1u1CNVIUtQ94znuZGZHaD3DoPojPGqktl4WYHd
-NbkfM+uSlnM2kXw6F27SAqsTMFFjnzSutxYoxhnYIVMoTJ7yjTA+Mr8RhU7Lj4XXCDrDdjLTr1v25cOv
-IAEw701shuVkOx93WBtGUqqklKIDr+6yrGopLamUijV5CEz1ivvqBcU9hkTaZpm3feOjgOkytDkg9SUW
-c3hkpKl3irN1M2QLaYJoIcUku6fZm2rGOEvLPOeSqWxa9Ji/8MmfAfp8vKyqsmBZet4CM7xLf7fC9Uow
-Luetn3a4zPhuzscCd3/4NEt3ukCXM7bz7uWbi49fff/+/bdvPr4effXy9c5D1MYXn+9R21/YZAIoR9Ng
-qK1McTbERcuKiBq8Y/T21Ui/qKfzfTTNxCvNiumOxwR3MISLhZ3Ew2Q+l1hMY4C6pkzfK0rXFfv00Pff
-fffy7cfRm4uP37+5ePmWYcLzEdp0QZLyanBSjNxw+vmIck7qB9O2+EbqRA4R9C4v0t2Uq5lQOzFf4zIC
-enAnQm/H4ueXTSyLG73ypnHti81K9ary+8XChO8utijKFHFPy3mPStJFLpKqlKM8b7eugFGudQaqqS4d
-H19Xlw43wUUGmKtMOyjYAKgFuoFLSgwN2i240wrUuW8PQHFkxdKsJnMTXcw06Tm2+KAJvmpTXs4sCPSk
-Wy948aFV0eoDqj8A7Co+fQP8+4y1/mAvZin8jZajMf7x3mzm4MR94JDHaSp/vY2pWpnqsgi4p5NVLjLQ
-t+tLFD71Fua5NohP6vSOmhl0sFDiI/T0Rqw6wao98LVdVWU7NpmAFfQGkPjY8vNLREhPdZ5DW9GCvAhc
-+ViiYp6raVyj8LjZlIX1+v/FJlRelIWq5BKEFRkKdNZ3trMm9U02zyuEEIAyXWSp4DmZl4WQKlOVrpIv
-dO5f0SoEVZXSBFNFWWWTlc52gQwt55jNnImCLbyojlqxVrgKyy6MWh/z5AZLOqtSAun0VIKylQulK4H8
-j/ffvD6ExjQ6XTZeYisS7I4oWhXjxQpstq5R1O+Yl2ihGXul13YtKypU1OkxPcfCzdQQX2TUd0BJrYpk
-F4kA3L5HngGutCEX5E60UizW0FPBtQVPMCrvCOHe0wdLSlr7QN+1W1KViDs6J5oudpIFcFkbaZCnEC15
-oGtRfQv4dm6Q/LSoXnfIWnstV8zuTe6YCnMlAIFKsFzcihxD9ZnIJJfJbGUXRWe2anda6lLgGb/VLH+T
-FamWmWJKSc2FLG8zTILB+BDq0fwSrgMznlBrr0UPFmXlPZxV4bKOvZYz5nXBCJZuaIZy1PRmh8wwnZsB
-ex7d+XaMGkF+NPqxLPSIv0Ah+FhLcgFQpkZJld0KeAozYrZZDtd5JdoedCV1VTxBmsVkNMrnPhv8/DP0
-3Exi00Wj4PVfOlu8C0bjk3MAJ6OhW3h2Ti2Yd9u30JfwxluRlDLFmTMqBSELkZdjnhvC4F2dz8XbySzL
-00sOqisT9lnye77hC5yDzFS1i+arKtlPc7qIzyEaZiZLPdBTI9Au6AizvT265L3qdaYqes0TU9zUWsgS
-1OtulqrWmXeDsVZZiNYZq7FM14ep7sptMAYdNFZdHzPGWhNZjhveHTyjvz08ae5LOAP4vcLZYJxHz2m3
-hKxIswSE1epbU0lHekzHME4jaGnTMReFYJhlrXAdCEXA9KyOgF32zrViQ8C/LDnuNlEJVSnGpxwiWjKW
-1Mg33797j0qvdX5+3mKlZK1P4AupLZ4kS9A1G6TY88ovXl6Ovn/9/uMfR6+/f6kzs3rRVUvfQ8/STJgW
-SJ7bLF3yvAH50FBieImv1AW2omHosRweKIjLcgphK+qpygGemrM0kyKp8tWmTpFMBRUvmK7RI/BHoJ2n
-oLz6WSuAPijNINo/LYxmBgOD1wIqWsjSCLKWY3/imaabbHIRSBMOBTgJlV4kMOc3WAOFJVW3Qo5LtXF4
-iRLrR9nmIB/NGX5Kmla4+MsziPHswhQqwcqULyLaPVq76F+XfZmV2Y3IPTBD0WAxgS22N7sDUAWqXsqR
-iOyW5g6BtfVr4jSnQ7Tr1g2kWNplOqDD5bpF5MpNKqpfNCo8TY2uD6oe9TWPVy0n9RZLNXMQoSorpB2b
-wpHKVjCvIb1H1REQ4jYrlwpzytSYv3Thl/ROinl5K7Z30MTGUUdN0YfXV7LNGfvCrmGLnlELCBPaGcR1
-4Y4iuNrFJr5s8BEqsEd3LUoMu0V2DQrivElF+LNZe39u63WiVM7X+XSvB6rfpFFriqkT1Y/XAOpV6A3K
-7Zy1zOofv/riT8IuTbLLoF58+91/G0kJzZkqyUguFWi5hBeuoXmZZpOVzv1LvgIzpV12MH7lsiKdYCYI
-goZ7YQ+9/AR+NeXhq3YDcYKUwXoShWWvtYEL2OddVS5qGk27UtrSSy8kA7c30ncvcMEZlqrWvXx1B37a
-ssJFX01vgBikoJhOlSZkQruZlmA0gR8xwsxEyvgYmsqkFLm4haH0UNnuD6TC+NHNHP5J6IV3GiumW2/K
-ipl20taj3HdNuEhttBsjhk40OhDkbBsBs/DQKTjX1XTd4JJzQglpS5eM6u3Jg7MLJHqM/bcdEO3F6HWG
-AMQrhtVlPM9+1AswM3BOOBbzYf48rObTlTJkXWziPHHZi8eN5+bRfNRgjnS54NYBreSyPp6ekXvMYL4V
-uuhpHA2nXuOlfAmxg/vf2oXMU5bqnZ9KGMZmquOqe4lfTLETrSzHhZcTnw/0bCVF+Q2iiU7GvCyySi9B
-81IQPuaaGbugMVcOWYjE67wyL2lFaIGdCKoObQ5bimQpVXYr0FLzVAWvg6Zc1BfirDTP3Yk8b24b2FWt
-imQmy6Jcqq7WWRZTeF+dSF2zXPjpfKmqp7Qm06zM2+aEtDt6DwrjUtr530nD5k9umSS3RV8z7urH0sfJ
-Bjz+zqYpglUn4UI0TBqIIs2K6QugqhQFJvOjSiu8ZyuUfBu/u1t7/Jz12WefBZ02dt2/1g5XkeBE37le
-T9WDv9q1nEJnewmun6B4xkQeFKpEhbiYQyHUgnVS33lpS6AkzQl7LXtawiiDqaheVWKu2oC5t61YBhfD
-VD618VrvWuC3ayZOTJq9Ni0QNmbXNmC4hjeDiYFgQeaNWIVZn9dmU4EAWIj03apI2DlrB1P7fqrhs8/W
-lHkxFnsxtxSafnK+9gnW5Pf4g47VpteBC9PpuKmIhtRTwxO4waBzIA33mu6G5a8RRz97FiywxFFfFckL
-QxEdjEdS0vHXXpr/e3Mjv0Rsgr3TAgDtEoY87i9uwqwM440JjUzZUEgxzoKcgoknIViEnoGS13kL7bY1
-NBkno5GD3q+NSM1GAmYymPgSMyr+MnMtzSayqcepenn8ncyqCmsEtOUzsllHTQckP4/LMhe8+Jm0zs9Y
-VfFzsczzBy1W72uBAe2YYjgh7M2oWNEshb+7oxR6NQItzsDCA1waf8vzzCr5OEj49YmERfXRhb22fGiD
-rWnIZaxNM5j2tmcb1qQZTAO/Idvgu/TA3Q6iVrhJLK2RpUJJYmUQeLMhm0murdEjJjonuKDAlsilN19o
-ey+rb3647S3MaUhwUDeF6/UXGasW9NB7beCqBkBNysLuL7iIizLtpiOWnUARrVlGZKdeRoWJkydsf9dU
-tlD5u9m2pJpJYatebKIKn4qKhIDNrnAG2Ayr7su1xz6koUw7j8uFrGereG0JditaX+LVQBiIpjIIE64H
-7ds2r7Lrq/6124wM/h5Efw+v61tdGi1f0HoskdrVBLGr7ML2+AamtNNsgn9XFPv/sFQValGsv/SG0aue
-oihP79ghcAsabJr2cba1prhiqO6yGxMwF7wIduXRKsyffPbS87VukbqZioo2A0LVyk0K1eQUWlJgXCL1
-zmalRJth9q8rmK3jq4cYwSbTsZ0DamNZyIi0oYkNglGQorC9Bbq6vVSWRfaXpXW11lFJuNdog5ng7HSd
-y6mSrNFU2mnbqtTrTiKOMa3pQUy1LcAF7qqk57MCKL3H03SPchpu8yUaKNr0aOXr+5gUWAG3oM3J9JLH
-evrWmxZcsVG0O4zxGuK9zuyks2Y9Uz6uhHl5quk50S3bdmOL98TtugsysamgQps7M5Sp5QHQ5vje+jj9
-IivnhWehjTNc0Q3IpQ3c3l6TC4gT8GWeNjGAN/JP1ukr98qr63VLPkwKu7B+cm3yu2txp9xm01TwlQG5
-xqpf18HnDZOgAXA0HWpnd4DxtHLZPotzaXmRZrDZyJsbslw4w9JDHHJxK+Qqsjjk8HClcE8Wk3Pw8mll
-Ebp85I4+4J7ZI6bnuv1ZWdqtNPH2QgpfYF4avYcXG6cZG+dvAuNHROgCSi7Vpinz2WdM5+T1hU/OWcs8
-2VqTgXtVoKom032mn1QtF6jTFW9OJK4koHkjDeaqpgFDL08eFiyayNZ2w5RaByFvkHnoBOV7Xg9M/bgb
-Hm+7oufeepuGCNV3y+Z8QVcbzHumFtxM8xCjMjcDa7JiMRrKq7XSJU16J4kwfYsb5/gJI5NrUrjKW6/w
-xuQ8ltMuFnmWUMoRl9cBEMTD5CIulZBNSKDd8/e717MF1sSnjf4BTh6gL4KV2U9wO7oc1DOp3S4JHdU9
-U0ij7rIqmdlNaZ1Lo3MsjxIAqmob5Xk9u1zjojqz+M4etaR1X9uWSAYD/KY05nLN8Oq6+t8ccevAlAj+
-Syih8Y9LEFwS8bFR1Cc6jGpWB9uFCTfqCBM7OsaZCrPUrskpb9Ydvoe+XsVcZdc65gqSUGvfpRFys7cN
-gUAMA+/wW+/q2FUXiUUM80IK3NXVeRcNvp7xQMwOzAAFBtKraHH1gbOsiJYQ4yksHLwZnYChXRh0tQBf
-VuWucbnQt4ldH5B3eCdNH0mNgPG5aOdNqQRKeFjmB67UEvfG2epw4/4Ytmeo2Yg0xvWyAQgokbUVk9j7
-bwNaYMCR5MtUmCJc4xitbSVLXRsZUqq8FVJmKaFjqaXb2C571BcK1fwtK6z7ZXBf74Rt+Udoe+UKqfKL
-uq1/9tzef+4nGzKbD8lSs1lV+twaVHAiTbUDgH7iNrhoEP6LJVkWTWmSfDvSpsKeZWmnvm5sb499lyU3
-jDPJi7Scdw07Huym2TSr2Ezc+5ux+t65LvyjmXm9se8nWcp+/pk1d8AtMkjNZkG0pw9+JQTaHfaU9e8n
-k8mEPWODTq8qdfHZ4MjVyKd2o8kfFzxtZ2mXHQQV9GaAXSbYEhq5FKhCRGlYJ45kdOWtm91r7ZFntOLc
-f9TWmrpJkxqIFEpUozz3S1QbHfOrLLWevH6YOCrVFUAaAUqzeywIqKlgE86wjcDVx6CU19MEVh0YxUKg
-qgHWeiCgjXDbRYXl5H5rGEWnNDPzKFXlW2VPbUUrSumO24GOguxGtZOlngp8dfHY2UFob4Na8TUC9NfX
-CfhYCAvjhWAxGzxKo7DzWMxcPVQgbwAV1kFZi93EKFoQUoG7dWzmyObt3tcZVmdTPN+MdLq3wtAb31Ja
-42F2KiI/p7LTH9LtbmnPonjibQupJx+kVpu8YOI+EQua1Z6woowgMY9EhfC/wojiZpJZ8ViWszBPw6XO
-gYcR4ldb9hzkSDSRaNHzo3jacOY6hm5Y0Uwhn6VCw0xnZ4ub6ogWGivPVTWNBfo3ZkHrHLezlPYk00Ad
-Pzzdvvh4W4y6E1vUHd/UGsNqFWy0fPkh1r2AYU16XvA8WebGBTPRVyLYWFR3QhS49w3goOJkE+0IgMLk
-7Y/jA5E7XMvXkbgGW4biXkrosO502Q4pPPgKynwnKefzstgBAVkIWWXCTkPoKyu9Exln/jqDXfLp/qeS
-S/E/4YrbLimBYOtVzs7ZVYuevG91mf66al1rgLEHQFf1DSCazZqpNu+ycYedf2GX2/5ErvgZ+4nZ9s+w
-Rok9dLW1gLsPXUZd9SBXFhJ8BbdOFxrU2dzUIwzjSi3N9nj/w/8HJNNfN+DHE8H8zxWpi+sHxuksm1JV
-mFrVD9UGf93jYzYq9FZxa54ze0aarNmo8Msxw67tEUH8od+gXsKB8FULjclPwZlOKDpmZB66T5zp9i7Y
-AcG/H9bGkLwpaAQtMLaWkl9l1zWXVN726BVXcPvaq12rLa2Utz3EtgnSiHsTauN1qLXHV9k16C/Tcgd8
-aP8qodZZs35X3mrXJL0aRyg1nThliiR1PVf2o/BOxFtju5U3LWBXqmhmhsbwcefWkVdTO9iC2qgyOlll
-xeaZwtOOXB1akVItmZGTWk0ZNIh5LVOkgNUDVInoFbSh3OEWXniKhTu6LZybpW30x0J7O7/G5CtHxuby
-g19VxwatPmnYBu1RNj2ostkw7xGXtW2qa6uXtem059++qk1vzl/TWnriQLCnNLX9lBZDcr8quhemul6t
-daLde/Q2JpYFK7MztquxBYJqRjPvUhUxNDZOjSHC+nFRpN6izzRTCZfkUiJ6ZZ4SarWyvUY/JzoF7zFK
-t+0o0NWvW594c7DrtiDA6NmB4S4E9qamySvcQulcvy0OTPR6ex/Urdpg5iEdp/hgFLK4yYbNEUlYMvgb
-Inn7Pr+N4NjNaDscXNyM7rlehL01GeO1pNU5/W3r3R6RTWDsF2cN6KG1xXpRfsLOb7RDuY832GviKz2o
-DTy1PbqkZ8GcBaU6n8QKplG3rC/7C+JVhdtohfO7GS7npzAB52nqZiz155VqRX5ZbfKAIjfaAl9vBd48
-a1qbjQhRwyzBY1MVKlyR9H8z69CwjuNVJebtsNrYwbt6sbAK9ZONa6aanli3DPMXzC5p/giW+itmljdW
-M5HJOqc8cmgaZ8hIY9Gkntu308jVxoDbU9WPD519oY3iZ7e7ix+wBnmqZunfOrlnq8i3VR5vHvNHly+v
-YwNmp/MwfertwfLw68rrw7r6hgV5XnV9vBPkRmmh2ngCMThPSgmBd7vOzGtLms05MBDEgo66K82eX85R
-AZuWUVlLUVa74i9LnnvpuXFZzfyNwuy2WyrhOZdYx0B533K+AABsIEw+QFPm5AZc78XaRenlOzpd2qvk
-LlP21CvAOVDDZksxcOhzPBiRtiq+c2sVk5lIbqCjgYLHNSFuGpe99Y6Gw2PvzKFR2ITuBXnf96bnd4IK
-/AyxcD36Ezq3WZ9mMvZKAmMl/xRC+5FL5+m3xPnA8RqYR9Q9ZZNJc8y9t2eSrAAYlS52mTkzC0bRbAzP
-FB11uJDZPMMYi4puKHDVqTXeQWk1f44hftUC8El7789tm2qzheO6jpwMXry+lXc60fEQTeEsx3eOwyQa
-meL6stSojLKBmexRIWo5xjMVIDBtWCvlzwLbkCgthdlIvxLyMTtEmPNQaK0f8iV6E3kalbQz9icKCnml
-66VUt7HigfJ3VNtnln7+snIHO4uN4mO2HfKZshB3f7T1/eAJhFQ2s4O/tHA4qAevb9dg3vq/77+4gAPE
-xLgm/rJhDzvvSLuLgCwzjkdsOL0T7C788OsdH3N4Hm7kQrsx2hI3XeVNmqouFnrPJVAAWqCtHxVteWH6
-97wJ722PPNnkbz1ScJvq6mPGbqz8Q+be/YJ5m01p+qzszraOh4MlGo8sMQ0YN9yl2JTIxWvRvNq5hrUQ
-tEUbX+B6tLpL+q4hYInH/P8gXoGu1cMVFW0P/NRfHGRNG0A9agWQGZ9oIVB8tPvGxFg9Jvq/1C46E7S2
-HivSPgbUVzlxqab1rn+BvmoOn0KxD1xTRWs2G6I4r/U1K3l+UZT22MjRJQ7/JOya5DkvkH+ZEkVqq6XI
-Muo6TFPvb6sbcDO0O+GSg2YJtbjFXWWxJHiS0ZmjfkvmyMI70boV9jgdrfGpOc8p0COhSjD91KgSwiu6
-dKiwVOR8hSsraGsMaiyg4rKoMnOen4duV6sKyqh756jq2lWOqzh0Z+nAQW823WyL4LbfMLtuvY/JiBT0
-zhxWpT57Sekiap4kWEOEw5GKBQ5IQa1x5i0sD9qFd3qJnE2hjj6Mpcv6oVn5WlThHi+PWX3ZrM2mj029
-TP8REi9myidQKQ21GnnOirLYNTY3WNek7MbSBQa/gc9MJX5U2lPQUfTNGwvYXQW2U07cL0pZjdR/qrJo
-zo7QhOFDc1X6xpTDukXZ/gygWVGoT1EJEirRTEKwZWtjSl0/1JT8DJPqGtBu7OvjQrmJn7L0DEsxflBl
-cRZVFBWmmiggX7vz0InSydEs5mO50RCw2Tttck5rvXC2LdxPJ0i2NE9SvppDnwwjjvNyHC7pUP72La5Q
-FKcofXrUnSJd7/q34t0MEb2U5TzmXhi0NRXw7tZjmTRO8EEL4VitZUZ44nHcSOm+654uWo3eDFRomlLR
-GcbC1YaZR900ivdsxyu9Xduuq+M1zhqetuwqbpqejsaiHfQJSb5ZMmxlHL3SkXjdsVwvvCOs9Cn9USFb
-fUPfRwYY9WVgTYVa/iarwQ4L3oTnGny5iUt89+EXohhu0vpLo6AgIZzdhyf33IhVL+eqeqUnEz1AMPag
-gfqdNRNpD26Gsr6XR333kGCScdOKJn9zNDwaOptjVrCV5037blEhKMY1HBfSmqIEnRPr1Yo6IuS3a2nX
-T5OfObdBZC905NevA6nrZjy8Nd66TkM3qvyo4fZtmaXokoUDjbFJtGgjDkqC0j3XrejQEKfBmqOLJmTX
-bpj+mDjIm5zdNI/06E3VpaDdzv9FtlJ/529wxQt4lelhtHM6iMJSUYAGHuh/8lv+LpHZAve1LaaexCRl
-nosErHS6pBX1bLzURa+qEgs942AOpMgKSooImdEa5hZoMEtonqbtXq/XoRPIlTtzEw+W+HcDt6M3mM1u
-aQmMGbdxVuzR6Xs9NfM2syrKAk+WXypBS/FVLcmsl3faTbLDjb90TLsql9LSTJ9lAf0BUcKGyzzH86c9
-7WzBz/WJWJriH8/YTw/x4abc3t+YHI5W5NtnmL+zmtkcHU8/1WX3iKmHJvliFidz2JyacVo8jcfwNhZM
-4zzEiM1xNga+x8jgjMWO5Hc7VMqtU3h6peU4r6WvU15xL/uk7bRPj5CgwCz1hBHg0sW2SAEFTzjiW99X
-J/tWC3FGz+LfcPeMkiZUEcErfoafVN8YBmsyE1jqZIdaKytvMsLewl5mdpWFPh5nBy7vuEpd23lwuwFs
-7Ad5j5owsG9sOAssrGh3kwblllO8bJvmTOOirDxL6ZWtls1lqztA4p0u2wFMTeVy0PegeNUOXEPY3q11
-wS+ANx7CmuHfUoLeaM+COnQb5Zs2XYy/tty8cSfTjfzZzGgBL/0T8cTTh7ooNI/1BQjJ/4Pj3QOSbBl0
-zPyQPlrK2jZF/9jDP3KIM1GA45OyWyEVpnu36nvNGN/L/LG8QQ66tb9xa+uefe6Gq4UIw1DbB9HaPWOt
-bnDVjtxml9Ikzf81PEqIXtWC60VjWIswF0WlN80pJ+bAHcx3L0qlsnG+YkleLtPdMU9uhHYT7Y52NOJu
-k89C3OnqnnbnkaT9qE9R/NegsO6Mif3HXImUYQWEXgdS0N6vdCbGhJvdEPQ5RJKSBeBu2RVEtDF1cGJP
-PHS1oejpY/Hrx8nUj5D52HCGTNlwRkrYtZ6Z+sR93V4j05gtiO2t9fVTOmuiT60w5Aq75RXwm/uK9j3P
-/H0jwi5H6R5Cw6eD3SqYS8HdfAKG50EPrwzAtU3NGoqt39Ql3uyhbN7mwZ+zLoMtHjR6TQWU9mAIf62D
-2UVozbbctQUUc77oPLjtg4LCm6alE9TsE7shV5TLWkv8NYcvhBv/xiTCBH1zfbF/+kJcrfM3PXphbY/W
-H7hQ3824fuDCR1vCH2yb6q8wbmKJ9WcuXFDRN271RLP9Zus2Tz//LViAqsufbN4Hei3VcNH65u2fA9Gi
-B7w5+0fXo9fmK9+6+dda/Rh3OqUS822Tl/B/syB5udiwMWrn8WS11UyGsmMhwF0l//ExpNW7PfvExYqX
-TdQFLyoEIieo8RwPFRQM+QSr6Re93SYW+NoTc7eRDPPWv4xgCpcpbSLZo2imIqKpR1FNxWQLK4n0mvdi
-nQA2cZXJwlhvfSx0quER1T4Odk3NjxIS9+YXdk07ehS26CdJxKKq5XZ+02I78yIlqmCtnX98Ax27V4Zb
-2MMDujgXFZvZ9euJO35ImsMLzCbEGe0mFRwygBWoixRnLr1iCP9sq7XcodZIlLd3b13Xl+Mf7DFz5fgH
-nCdwO33HrKRE1S7HP0SN1bjJSp3m+vVc1VisdyNWe/pJqgqLGvh9rM1Yq/DkpHhgGpTBI0bQ7P1Cm+WH
-S2wfZ2qwYA9XIf/th0tvl/obR8y0Zrbm+/uPmCtcqwnotjGjRxtgm4bNyh5auo2jt87s/T58m4avwfo+
-fgBj4McncT5iR/5FEg13WZGWdz3s0rtfnm3AlRJhwuE3ZBteI4fUisyaUwn17ENDXwBMX+Zp+vJWFJXN
-MbT0o62u2d33nWGT/8V0A/a5qXIjyDZ42QWbWBhJwbenFPb22KuXlM1WtZ2W3IltyGy4XQ3AzBfVSp/N
-0HNbZ92a6XfRsxXSX/qnvrnrHXbGdnaeu4X/7lFbNxA96kq+/UdL5xNdiZ72iSjrYd515nDTs3i6pTP7
-4o27k/y6BEv5z5ZaiRntnz6zEnfo98TKIxIrMdF+z6v8rfIqMWUfl1bxD72qJQtwccWNWHlHfdEc5q2d
-uCRS2KqrSq68slRq1tOy7pgVxoDDqmTGjG3RxXO0wCHh4AOSZfCcOVy4ZiYCK7YsEEJXy9mqYY8BrPSi
-RaVCUUKixg7/CkmjR/DD1pTR2pL6jIollXfCPRiwL8iQ7e76+w2ARBC0LWF/LKdF0+VrWS1kNiws1+Z5
-DcM1s9xvZLrwzbdhUWBDRSESsL7x5mN5V97WGff3rN0/ZSYnls9fnLSrpXOMEHXjYwBJCtbbzMdazN8z
-ev97fPCYhF5cQF+Ofwgihkcxh8n1durHn/0aHvk9Z/h34InfnDKse3K/cXx/Ty7+fcc53CBVNg5041an
-crU2gdDEE1yurrLr3yb6j8tazsW8lKt/kbTlq2KX+uOyKr+mOArhob1fla78hjD41fnKdYd6/UOmj6iz
-/0r5o1qPfk8gPSKBVKPaIzJIQDBhE7mx1xTmr7XCFCak+8mleQNAvN/1cr1mYduD1aVN8haq00hwf3V+
-uDFDHKw686YUumb13e/ps0189RvzZ/rw+N/zZv9sebN1jPCPlzjTLPZ7wuz3hNn/C4mSmmD+ujI3t7dW
-szDVrxoJiRNqdr8u51s0uQ31LbrOCMV/Anfg98zgPyDDPyY16LPl5kzhL/d0Tcbwob4r2zqBsUnG5w16
-+/+E7f9mmbDfM51/Ux7/RalO/8SAZsf79zTnP/Yg/43znI0MQTnO679jjrMSqvqo9wj7F8lw/jvchZfc
-ZuKOeTuxLIusYtBhcF8nks/FXSlvcJD8TRt5Yb1Y7l+HJ8NToOBF5tRO2vAaeOe9UNU33qmhUuTIaphn
-xUPmRrSN47yc6/OmsqqlcPdCtzkMbkGpsmKaC3oPsTJCSsFVWfBxvmJqzunUp/b5Xwc3LM8KAY4RvJca
-ZXlZMcFVRgJiDjMuC90s7i+D27RA/6ClGZeFUIp2kc/Q2fAeVOJWFMGu4WWel3dAUt1B2oddL2j3jsKL
-abNLmxuUZaVT0IC0KCq5WpRZQWKPW6ZhtCEgMltSMrneWO91OWW77HU5nQI0cGJGS+IbYN8ts0qwXTYy
-5Dar6c0ANzwD3/ERPSQAuwH07bJgu4yuEGeIe5EszZs46rItr3wr1DKvvRTkbJnrzTutzgci6m1DtKhY
-ZmczkUkuk9mK2OJGiIWQZveAvJyu2SulgcKk7+ERq+qbxsG1h0L0upwqc164J436wCHQaeV8nlVBztTn
-kyhBmpdTL0MOyJxbtH7+GRfANyCFG4Y2H5xuWMsQ0J5bjjeMblwTCKDKvfd2bSG7uQBJoFPSoRGFHKdE
-tVy0O11DmMX/z96777dtJA2i//spOt7dkIwp3nS1HCVLUdJEG982UuLzfY7HaQJNERGIxqABSZyx93de
-47zeeZLz66rqGwBKSjKZ3W/3+A9LavS1urq6qrouheCreSq6dGChasT1EaLQUG4aRZUNGFIds8+KErN7
-fHx94V8B1R0wdq5pgcjKpBDpmlW52Q9vdrfw0FN2rN4RUlJgErLmrjTSkxusr6uzKQIeKVXadodaUuRb
-anRPhD+7X1wpGSUuQmZj0yyrYMn2jAL5rvjaz8qOJE5fnfAElueFzAvI62cW8xAQZEbLmBnuxANEiZ96
-KNPYRwp9EaHhO6Cc2X0mqzKvGufRiyMAWSbsYTwz3FYr/wWLckmkIUYyaLkRwaShAhih2ZzEvs0Hpo8V
-5Sdph8FLOIH1I2omFR5VU/rRnVk7+0+fvFAJPfYPfwa64LNVcVDOLN1Jp+NKIXpXa+FFyaNr85rYCCBm
-wn5JIIYroRS/EuFFLzMIM70QUamCWi6sG2S6pFDUJgB9cgcJmCu13Jy7QpWFDc+Ms8gLDCldSraoyqoQ
-jXm1boKHjHrEt9hfEH+i8HbDhww+LurvLdBs1v5VJlm302kVO8owyasGGwCGpk/LfNQiZN5cw8YFyLz7
-Byb/3ytRCU0W/YORFwnm3jEnxGUO4sBogWqTYqMTTvbNMyN0or/D6iERm880uQqQZoVyE+CQdG/Q5YzR
-0Bep3qEekwXWSbNuDxhHBQccNLM1ZkyfeyX+VkEUYEqRDAxFkrHLd2+QVSRuQXelV+hza7AZSVZ2O0uR
-plJDrP6BNQvTrNu5lUUa4yf99d1S85mclescYgqqUt97W2kC+Yn0XE20djM9HJFBPz9n5GWkWdYnkG1J
-QXYKV+/nzNR86JC5zeVxXNvaB/ER0KHtPLmQr0SYAtsVS62eHenxG4kE6iQtwOBntomPrm9hKoSEHq5o
-JgIwBdh2FyH/TwdLmj0SMG7FhNEup4p/PXSbQKg93cEqeWYPnk8jHzNtBFODsrgcFeGUPbewTZM19Tdd
-U40HaEX8p77nyqKKSll4Nzckh8WkrEtRJCVocOCctkkzZod/VCLMTwYsQIPbJX6UUkMBfxhmUdd9YXzB
-dM2SLCnpead9slZB4zr8N1mBEF8uBWhpPGbLMsKAr44JZitRLmWsIPqliPReFmuoA1hpmeMnmJssGNCS
-rRtesFdrDR9F0bBa4dXtUCVHpphpF6qtqzwwZ0F29ivzmnghSkU6m+TvmNvsDn9NFvoQirtElcrmImsk
-AxqPvM4w6qeVm0zgbpNMJ7rrs3/ovg/ZePTZUN7P983fANdfAtLPPmssRa5QHlCsq2V8pqpIb8KiSjXp
-VT1gmlUSiy0BnBAiWpqoss+U9HoqBGFcUrK5WMiCTHF8odmcI4z7DlZeWLxRg65X7jRmlAPf9fI++cCO
-WGL7+dwAz3DIjrlKIlZX6tjwth4MeRzrX7qdXOZbqK7r9B8Aojcby5B85T6adHUJpNMXBR4dnqSUAU1P
-Bfh/OImqlDlbikIMXA8k+mPr0//edcPhDPseKm7hg3M4/pTEo0rpezZOItqlJVdGZJ2vgXWw0iceyuYk
-dPVuz4G7FwD6gkOavYb+7F5QQ+2t3wRwjShKpAu6N8MFgwOtUU7XSb2p1AZX3WMIVygJ4NpsXoNIgHsE
-/3c8Kdl4NFopl9NRbz0vCr5mZGRhqSm/dkpUcQcQ9fahxXygr/uu7fqDKGnQAG4cJ0JnQsSK8QztOyd2
-zl7q9hpekzbBJg8sBCS70GAZajQn5++sT0pFLzunk0+hO9T+JysBiY6auFdotlbB8rsTWnEDCx0z22B7
-9A3wui3ep7sc23kI1Bz6rI7pivT95kbU9yOI/dTSIq7ZEe8bMH3uT/9p0k70yE2aoqea26HKu6AuoRcC
-PfCmdvSVzhuF92yyEvTd1o8TxeepuLeNV8e2uxLlvW3oe70+pQK5t42uY9uVVODMhW3xK55/tBYOUGrv
-Rl3MPn6Evz9+PNwwnquOr9HttQwbIhSZ6ZayCBIX6YKAA4RFysUG1bTrr2/UufTCtRRrdis8/fpmNPVm
-ZUJIBmoPwHWILX8vR3npLgE8tHmCAqrmrbwhzAPfGoPcAwNw7/QcGgZ6stcYlFyoMlQhgSwhajmjzR77
-iaU6JxUETC/pOGNk8g57xkxza/1S4viblJKkkdwwK8vnm0m8NxU/0IEOagCGInroP+sGywuQxjmjgxRb
-XmkT+MJT+R8XhL6Wr3Pir96NOFhUadpM/PIXUbZgL0DEpMDTd46e/T8Nm9vuEwvT+nUSC5UU3l660M5t
-kzbBvuFuo1YgOdgQ2yRU3HLl0gMwfCG9D1UcIW6gSaCc34DNTZhnxLC0EDCUvFo18/8ashLeIjXxvr5Q
-OJQNczAXWJ9J++CAIrPBqz7LK8MkC5eFgPIGk6QOBuYyK8VdGYiDqKcp5MqXCRWLE5iqFnqdJPjEy7iR
-ZKXISJ04FzZluH28xTl2lJWvCb7IXSEOubTIyjKawSNQEi1B0JsLI7PHsAOFrK6WRldoVkUvfYydycKy
-qJ4S8dW6do0+IFHXRF71kMxLfGc4HUod3NHNOm5vSsQkIx+Z3NJoVGK2ywrmJMb72dtrba+A+rRI9Jue
-CqM7OOC1yepNcG9ag7aGFkPcKyMZHWgE1ARn6OcEJhtEmflQbUNi0hljWy0iiJhh3nmCDWLYwrfbwbQB
-yg9ab1Jy1JDinjMaoENbumG97WbVdYdhjfLhN1zh+2t973bhu0aqb1l0p8sObe331x+aKYiJ1IBPG50X
-1KgbOFZ5i2hg9Phm+2uBrIzU5ggcZ5nckjm+stVOLGq1/1YlhVBMyZVg10kW607g89ZtEpPOjDThCZoU
-5CnH1ASx/7wAT70L0gyD2TZTpb7KaZtaqRkIf1iNNl93xUEBlGjQaEYxTUk/ZPKrATRgRTxbew+YnlID
-35zheLlxbYITRLM4UREv4Pksi0lNKTOHhkT1NQ6Yc1qIFaF/YJUzzVBDxQS91cmqhL/NhrQsHrUhc8GS
-WKxyWYoM72qPpPX1IVjLqoPvfHrYkzevWCZjSmfEYhlVKwjav9JSu6oKANkiKdCmBpltlLDFugOJ00r7
-eFSCnocROYOrrm8s5MqlWLGCgxReLnmGyFKh38IqTGCk+4xklZW010mBe+rZwZH4kBdyzueYDTsVC73+
-pcY5sMnS20f8h5/4SQPXxUBzhILPtZjvdiV21iU+WfsjJPFRhKTlXnn0QY/h2XUL0QMO/b/wlPsjP+6M
-A0dAvhcVGZpgpirO5lUWLXW3c5mkoshTbkxPhqXgRSxvM7LFy/A0JcoyDmbL1Z+w5+3mTZ/Nsx8lutG/
-BnY4glV5JFeQKpquM8tN/2nY9KDe/DchllQl7q+G/5begP8fuf5PRi6pykdjFw7PCpEXQunLFBIG+vaA
-SSiiDBp5Cc31CTr+orSWf/dpZazwALDwrxhxIwprnm1fA+G+nq/ZkmcNMbl1INLCzsDw8zJkS9Aa1LG4
-DT528KAUjtbeThZPvOeHPtyTaM9KEpy/+zQJozVo2l+141qftqr3OdBxBH6eT5ylHpnFh7aGcNsyK+/R
-iwxnPxjLCOJYwFjQzbqGk03hu3FumkhaVxC43dmovQHeG9CUESm7Zw/DV2lwqPxq+MTXcyMqHHl4gVFY
-/QE2bGvLjrZspT+iRZajUKUVjEYZLluG+y1DGd1VsDRPRf+Mdd77qjVd8KFjItDW3YTBrCfAmoG/Jt/A
-zv870HGcgimyCM+Tr2iIbLDepnqthot9n6zwNvsEsots4VT1UpLysWTj0SR9s+ZU/+77coTmqtgbWZhZ
-f2O9B6jT0sx/nqeJs8/KOQQPuF+JPwgffewODXRnaxoV8aHvJRFv+ffeXBLUhsxKB9EdeadZn+c7P3uf
-uLO71aKwQ7BCkTFdNYn8PKMX98hpjLyAYBQ8AQrlBUdGra2489Mki7uBKq1TTL3Unx24IoWJkINhTRsy
-4/s56/R692RAbm3bq3ltB+sz75t6eR/N5pxNz1+envTZgqdKNKMX/0hMUrSUUomacT+6gFQZimu+jK7S
-5GpZpmu2kpAWOLsRWSKysnmx4o2txEYboA3G2N3eA4fqs//waEm4dV5p0eKaO8ec6g2s0tReSoE9PDib
-mDOqzFXn8ZreDamBeL+yUhZgxHCTxBVPPQOhzYe/ZqDe+kAbXAEeCOpuCVaL1rxZvLz//ruu3/nFUhZl
-VDn70rD3jiL7O79r9HXwutQlzWvL2sfTFjzO/D90QMDelvLWygtgAA6GYtbxYIPHAb2EhnOP7tiRRoxP
-n4xjb3ix03uo5wIB2hKRgT4DjAQWPEmrotGzKXZ58h7VM2Jkra88eC6twxUyN/sAViUvwFheFiyr9G22
-IGuarFNiuA6qwtaQPTRgevSXEw72BLrxhgGTjK2SNE2UiGQWK6tEcrMopbz2TTP86WBnbk42PFHLhOKq
-QNFz03w8u+7w6g0AEMyNhiUoYFd6ZMbOM+e1GYtSX+AgTJrtdJIq8AqpgATbNjxKCc5qDN4inI9HgHEY
-2of4lbY1va5WcxQtV/wuWVUrh2IsPEnOIuvWvCKwDFsndFYKwaMlHpEFG2mAOF8Kpwg1V4MS5OZHClWl
-h+kzcSMy2rIFivF6RrWFrfjdmcP5UW2fikrgLnieQwyfjQQv0jWbi4hX6J7JM1Zl4i7HqWj8Slq485xn
-SaTJpr79DF9qDBiM+W1AfR0lBMN6m8LSuzOB8OPLA5F/5zKFwOn2UE7yzZOcChr5SN0TvOEgNO8l/N4l
-OX358uPl6cXlBb1cX4AI2e18zdMUlCXqm06vbi/hy9w++dwo9zfHxXm2vcV6fg0ObA1rgceO0DBTwvBY
-nkgn8/JjzstSFJmLukAF5Jtj/vr0CedlgWYSTOBYM9A3Ax46iwB6ffXkHe9Zloy+7YvOr2jo+Sv72ra1
-DtK/Ogdpz9rAVHv/KwURGg7Za2mQ5FZ0Cs1NaLR8+sXR0VNffa5LnjIl/aorzTWDngG70lvrUASRw1I6
-vy/76pWVyqhVXO4P5HINGL84OqrBMeSGTT2PGf5BXJ3e5T43DObpVBMQBbDDSrO9nidA6IklmRejCNlj
-YwTiROAvjliAChua+8wyvdMabDY4CljmsOLZs3oub+9j7dU9xeeaDLg6YIjxnJeSXSHEjd8HeSS6ZkYM
-V5wMmKm93iEJPtPgWy1vN1lwfEJAf7YH4C39rAKCFDjrApm/h7L0PVrFi5BEmebG2aiP/mWWoiDnc8cj
-EA40cgq4Itr7wMmbPmBAg9DQJX53PmoWBsYWJcnI9CSz9yGP8SbgZgkwJdD6IVcjrJe6M92EK8eTCx5P
-Ed9awmMpVoM4NajNhvAKD1rotcRe8Pt+duRjNzoLPNTn++TDA6I7/TOrCgI6fOENH9iIBk5Vr6W/uSKm
-SYOxaZGUokg4it6NMR44e99JeS1iEgoo45PMRFHIwrghez63ice2obbBSv7omAFkFXQ8znLiSrIqi3h1
-tbxHMRMihsx+pBanpv+PjRuN/H36EMmgD+5QGoAEV+MNZIKLdkyPj9GBdHrs6IiN2KdPtLGN3lTJy0od
-Et/S6VGkUk9tRPwrDw3ENYGjAs3cdXuk0yvkinEXdJWxd/pyMp2Zp+IrSZ5aseApiuVJWVfth0oMHMBd
-btzqbZIShgEOTPGFlg3V3yqRRmSxhUjgvKqYs6Aq8GZwSNzkuRueU96mQJQ/ux+g/my0HxjO9FRP4xU2
-/NgL9XIk0q34GizWIQaMXhzytkuexanmfO0rUw1SRoHq5klr0lQFeP0j1sFT0HEhMZtTRWSwOcXCj29P
-X5+cv/4LwsN0Cp7CncCjzB12Cxk3cQ0jaPuMdRy3DUe+jfS0TKNuSdkysNmdZ6zThxEhRMgz1jnUf8D5
-cjMOew9xrqVCXYnmKBMJFk2Hf02QrLzly5RhmN26PSE5mkI0462iyhRLSnAS4UG0n35AzFJRmjeVkzev
-tMQ6T9Lk76gYUUtZlFulKFYglMuqDJwhjJdTLKKUF96bkqeKcW4TPgMylzIVPENffnWd5OTSAaYgRSXI
-M4gKNQ9wneQ5WcrUH66APnuwCVxASddG+ndgFsz7LpAeTUqS0GTs/tgHbTS7FgOhSbFry/ScLlu/uCeQ
-xPAicNSBEwG7FXCCvRIFRn2xHjGDwaDPRj1QTKz4em7paA6XHalQ9EbD8+ktX3sel2WRXIH+E+Txkgx5
-hGklstj0ZkMcleB9oyS7FZAMEB2bFcXjxkd2JcoyFQzeyAlhZFWYrnCFkcaGKgcbytDHBwPENSAchkPs
-A9IQJ16jaHUdT1dLvie8FN1ej23VdFI1umRc8bvtZKPz2ZHw3OeygFjhBdm/l1JZxYvXDs2QdLt7Gq7U
-pbxA1RhRHbPE3oa5djwSpqrVihfJ3wXJpDX2x1Ma1XW7za3ATbgn6eWGlzRjDOcFN2mELfndb2Joz6sp
-5q+VKt2DcSNI+UMnG0dqC27S/mzmP2s5i4fupneqtivQKqa8xJsZs31tvPqY97T0LbO/HjJxZx6LAr1W
-wMs8DucNxhfB7W9uzeakmqhKLTcgq+8cr6ljOM7REQsepgIg2pMEyiPaHG/VdCkbFREieKAd2DTY2+nF
-RW2w3HN68oZqxjeobe11pokgoaphpD2ABqwK8B+bNzuYbkD6Htrmospei7uSuOR/ysl1540Y9ieehjom
-l0jnVklf6I71n+ArhRFBSP+prxSIGzavrq7WzjTTPMgYe04aAYS2JzZYBpMZE3daTkCuIWP8iicZyRxZ
-6Kbrp33At0q4cnmq4CUP5AuuhHuISLmCECY+Tw01QM+s70B9z2JeCt0hwoguWbJWsxctxHWyQXSQrcFo
-CdY+Fis/gUh1mr0vHFiNAh9kLC0GKJQD6ty0c7iGG1/3BR6q4PdeLsHVgdyijeDV4DjPM7B6RouiQmyZ
-vXTy8vTlu+m/XbCVvBEKfF7N66eZZZ6kDY7wzyDxDVuplJfiAs83xv+AX8OnJV6WYpVTNJpCRLKIgxdg
-eJ3ixWbno83XyA+i7SLx7o2+N8MwgpSjIi956SRdG0Hrd5IRbzjzwjEj92TY5dQbDMRIs4f4ZoMPfxBu
-MZbVPBVbOajZwewau6PPq0RVKvA31ggzfXtuskWDfsGGgUH6anQOHomF5ChQ9ZsjNmojyibyvK7kos/f
-f0eESZnRaIFl8IxPpoa64G+VqDY4SDf33Cey7fFWPCr96RP7ov4Ighxhr0HXm7ywd2E2Hsq+/LKd0fzm
-qPGotpkneRU+E5KtPb389dFu1Q9WOei03Eft86Z7aThkb4W4NqJGKXPsDBwJUFljIufie2mBOQtInkAk
-m8uqxL6QoPa99w6KrxsnqqyKOQySZPYUEfepV2UCQ+luiDpTD+jLjwMWVQbWfWA9HDzRhLv4fvTBPhsR
-59HySPttm2IB6x96rLhlMlF7Ct815nRDxw569PesC3u1eNvB5p6bKDcgSuo2ngNqm+WetSMyKzLhdbza
-3brc0DfmEH5w7yY/TDEVarEj0bGGXq0yWSLtsSpBDRi6Qp2NzQZUtlpVo+OFAXDFv499Zvejd1MetdtY
-560v251/QSj8R8efAzSywe66HcY6PT8+QsMGoMVNGT+SozKa4TH/PYustylKUbNbf0dDUz8iYGqZLMo/
-KvmYgKAaI8xk/rAE1CLntEr3IVD/yRsfnvaiyjaCajhkfi2jy8J6ABn3FoGsLqV5WiHrFRgQuVwwV7IE
-5wOIS7ISHEM5F/BuWBZwnZubr6wHj950vB7cw6LK/gQBtuX2tk91viGUVSx4QZ8CFXqVNZSlCsJEs4hn
-4LqmVEXmUKh29AUdT2trbZc9Lj5TpeBxHxRDqH/zLZzR9dCYIOuFW6Of+7W/thNQ4qXyilxI8Nm45kDC
-uEJ3uCXPc83AEW/+BCMhWt9WkgA32wEOGDteWyceslkiH2kT2KBPTDpZutwk4lbYmOYtIZHlAm2t5oW8
-VaJQvrsQlZGzZqLAeKpY+ZMFgczYqWDg4HcCvcfxURf9/ogTkCjGoOLRcgq01foyzTHWl3UjXYQugn2G
-bpmp4BT/00wRBacFqGAzY6KJxhHW/8da7yXZhujFTBbNi9CPl/sEYtWRwxbsPRo41YzkkD37DWzrhrij
-wTn/gfgfq/5sMK72vQaC2nZqyqS2u6uhhCTy2nwQ9ZS/L1rNCq2G98UjdB5nsljxMrQ25ErTuohsAaj0
-sTD0FV4+MFfKf9aPFDti3ZViQzYejUa9QSnPkjsRdycwa/t6HYGCTYWRFF/SKTd5/IxKCfS660dbIRg1
-8D0CSk1weDDhS61+W7qXAJNQlXfoMMl08D750PJ05+URs1NsCk0beYxL955viOnhvUjcfk85zAavYoj6
-ZcWe8DpCBQJRYaPBWIR2dS2+a8YOXsRNY4Q+UCnkAvykIeCCTdFiJcXH8Jwup87Iyj7goLuS87lKgGJo
-wY7kL/MUBgo3yk7BvnL+kXHsxYT2rAOB+jsFCk3exUQj/2yKQAF6FxMbkMJG2vidlpHYygspF+y20FcX
-mcYbrR2osmiyDz4f0ElALwDNMOgLxtjJO2+z0A0geCJ0yQjudfcjbz/oHzXPgWeYUcWhwYNRYKXOuMuO
-ZKy/7l0SrsdZ8KK8dH+HTTJhhYZ6GPdQSmj6DDwEwBrk6s4D6KNAvzWNsB8A3/3LbDjdGSPNDV4Ev7FL
-zzxzgzX7rbAW8fB+nqY2kKjR/Hmm5LcCzrRnQf5n2ND/y+znzSVF6tbAMbUVHcwTDP5FZiRGPznNQvuc
-2qpAAw+BI0kOKSW7EpkoOFghYJetFjZ2/n7EaUlxQCjPnfFApCned4K8e5ZWwI5Yh+zGOy8eboU3I9Ot
-8NfHNMLHKmhEBlq+/6ORyCDcWdb+9sq6IJ/IAnjUHlBxkUFkj0yiialcmHgkqB5Vm43BW0zMNj6iAp9m
-qA/+0m7/3dKpB4JSkunypjBYnUf053EF7Up1Ygw6/eAdz2+A5X76lQt9bL2Xhnvp42MQq5Vbb8SGfSSL
-DAssKCFJTaXsHkX9I8m+/NLordFaoxYBPuC64iSm8KgYapu8IHgWe58gBhzZMScrMQi0Xi2mVg95KDpL
-uBrJMPQHKYGqUQnPtIqC5GAqIx6VNmi8M/jU7Ai8YGyQayHxzSrJKow+4dv/ubQQT/z7B4gYBJT9KpPl
-V4xXpVzxkqy7gIEij6NwXYkNkOc5kD0SlxASPkXcnERjE/2EoP2+aGXjwjYz2ej9VSbO7SPmJzMyCWp5
-Tglw0L+H6gj8RYjA7UHuQ7x18Xg9IfYRqOiBIYw1zL09xmjDFt/rkYZ142N8cfBbkU4J9HaYuRkiDnOP
-v/a4Cv9UAd2mgWLJlAySe5QPxDHGmRjLPH12IO5K4l6TIXWiboZxN7g14SOpDhMp47Oi7VjZiFeZLK2C
-yAoGIErMKzJSAyWcicNuDOScXOU7wsORCm1lweTYC6Ncc5mwMWZQpuJsIW5ZyteiANWWDE0p2fTtORAO
-aeIE+pIZZSDCDB6RYLkosCsrTfCMCVUmK9IuLeUtS2V2heKXDeCNpnlatGtBDiMOgC/DSvmufT7jZ4Nr
-PvZe8SDUosBwkrch+3COwFkxsNuz3z1bMndKW6z86GNg3bdS9Yw7uYmT471MS4PAm5N+1N/9fdWojc/e
-aqGK4WFtnin6Zo1UsitN2cCyRbPQLkRpKSmybEnykeWBrFD7yB0JCE5ImPVa+m6atS1qv7mDe/rxxpGM
-1Tl0w+SEL0A/VFnDds7p65pmUvU2zlDCvE85Kyer++HFFcSes2/aX7MJ+/TJgwQNoDdg8wtYwPa1GDNO
-QS9BpDujnEUmF72y/krib6DplcQpkPAB1QKGolKUGNHjEzpHR0cdJnPNfoBzvzPPxgBUEIk7kqKIPJMp
-sgramFNBz27Fi2tDis3rPQYb9FcN/qSetaEiAaF+lL76bJYPMQLw15XgWjjw1urXt5BwETdNUauFjt48
-CDwzzfTveN6gwKicklhkZbJYB0Y5Dgjei1UzvZu5qgxHt0hScZgmmdW+GEcSza7cqx/xjqZJn9Cw6UEA
-9e2C+3Z1pD0Zsh/LJE3KdfAQlBeiLNcm/iaFQ/Bzzqx4SfnsPXsAPRW5IBAfHbEOerV1wqAm+J2OKwgK
-ZcGOjCOwSZJvPvJijUwdGNWUGHdksOK5TQDOunoSpvNaCnHRw8QEzJzXtbU+YWOnE3Z5FIi1XnKlzysk
-6uqjBkRvnFwsmN7gUjEtvEISKHq2cT2BJYhL+6rJJngAXvEk0yIXGSDSSCB2maEGIaT0YtkzSFvsgq6Q
-CjqMuGIkmraaAcHCA3N0dGRRosGAarBnGDXJHoVvKVKSLYBISewQ0iv54SC6HYOLunrmG39hpStRgoVn
-8VJGHJM4jXvtBmKEZzhnqAOuvOAcg5/sGu6hl45OlsWfQggxQIMnpJm7GQf+RQ/7C8bJAoMhsLp8WhZV
-opZPQ/L8r6enlsf4TVT1PzypDBKIE40MKWPttIBzx59yUv74OSHSSeekzqkaSwwAH6QTALIFzKpJN4RW
-DmBa0TwgnMVJUa7ZEn0jz0tEJRXEnuqjLQiIYphSV9zxlaZpRk+HY9iY4HbvQvPgVqkCJnYOJpeXtico
-xPcdvcQBYyMTXJM+AVWmJw3yanOuan02hsDuJcVcK3DWSjI61dZJ2yD71JBsmjrSIPY0gCteeU8fiYzN
-TfYR0y277uLhGEpQf2w029EIisA4ssYs/kX63oPsMzb5UL93ESPA7bk77L7/6/DDs8Of42c9/d/PvW//
-8zA0stSNvtX/vx9/0Bj/7bffdhoKsXeUBtcqwqT/Lrkx9WEDdi3ZD6nLWkQPzdW3eFqG5+QVL65D4wpC
-Yn3rV6X3etou42vEQjEZtqZGjMNzgvEx/LdzYzGiSplvEh41QTEws2KgB8TH5I9sABGUKrUUwgEU26Sc
-XlO/6rfz3FQ3q6jIWa65AyYWzf/kHXgMAmLQzDY9YMvC8VXEX/hwyC7e/PjD7JSdnb88hTRIsSyHv6ph
-msw/VuXiYPCregLm8Pm6SDSZ7EY9NhmNJ0AGZ8tCrpJqxd5csGlVLmWhBmyapgzqghpfFDdayNFcvnIv
-7kpWRSQoWLBiV/JGFJkJOnl8cbKlynUqWJpEIjPeJvTuPhxizhNzcb88n52+vjiFu2Xw5EmnUshCRyU8
-+gzZ5ZuTN92Y3yTxXGS9Q/bOGJQRLRVZJGOywwd6bEIxi7j/BBIaUqRk3F6KqZqhW06SbRmDpzCEs16y
-br0sy/xwOLxNrpPB7ZKXt1cDWVzB30O8NU9pfK92fBOpwe021FxeDc0MhwW/3dLrHJZJPnxzI4qbRNwO
-luUqdYwnGhUsqpT9eHm2dcBioaHs8SY/Xp4dnGBhE3fI49YFA5mvS6HQ25YAbh8yKb2wfU+Eqi91TQoI
-RJ0lYLNwVQiICRsLlssk06yqBjpOL4Yg+66Db9ho4DA5Fm9lktW7TeWtKNgccEFmnh7UjXFPr9D6GBpD
-t0E+pghi+3PkkwmMvOR9VvJrMHjPNLWL0FYeDePAmyovxE0iK2A4dAPKdgzXvk2hgggnijqVvfDy8kJj
-iPOgq/ZdBGjDdjtxwfAXF54wh7pTDYYqSxrxozVA2FyUt0JkbHQ3GnkZ+EZ3Z2ch72GmBdHK9bRoxwgI
-vuCiITXec0GQmgjn0S7spvV1Rd/+hShtMvc2Mystj7cYV0HWRxLXoyUvZjIW07KbeBJ4HVc9DxqsELGv
-j9jobv8sDEVaUg5n6NfrM3CfHN3NRrp5xL78klFHJ0FHDZyO2BbTzV6EVfzTNK59C5H37mDUOpPTxkxO
-HzOT0/tmMnloJu1TOWtM5Wz/EVM5u28q2/dPZTwabZrMQWMyx4+ZzME9k9m5fzKT0ebZzBqzOXnMbGb3
-zGb3/tnsjNqm08D1zs/VYrGIO7UgYGFtXMRBY3+Pm6hmZ7i19WLz8rq1kq+/Znta6uziug9GPdf4wePM
-UB/2F1mCnbmXFH/AZpCA1fjuYQQMvE7AfyDsYZGkpb4Qq5KpqijkFb7dJYVVmDFDfby1hEt54dUEOuPW
-WN+mmsHlp08ayCcHIwSzbafB7TpBWnN21mu2drW+gaNxpqsFUNq4660YQlAB1kXoG4lIv8lXWZV5VQ6C
-6vUV0wmtz8LOA++dgb5XZ0TIXfvei6BR6/wwFXGwWYNaBTefrQbJeMR0GmaxtEfPWNdb6jfffMPGox77
-ko3uts/Oes1gYaO7kxk287aWatdX+qTt98+bTrJmlaSEB1xk5JKMraq0TLaACXCH4Qdxm2Qx8SsYysTv
-BL0FUkmG9eAUqnsYPEQzNrGGhBOOEnz2NMVWmi9E2cqZOZZs4AXaskG0IxFmp0eDC5DLhGXNEufdDQEJ
-/o9hx7CBZsp85ksLe4F9WNc8FnrsW7fXo+ZYP0wskBm2ORgYJwY7ZgXlmkR2ApFzwP2IO/HlRhTKz4Vj
-hEDn+69rB+ebgWIJqNAtZktQ37J3GL0wz0WmNBWC0AjXYp2DQILerWjCBOYOZFwLc9GcHo+I8EMqMY39
-x6/efnsPslw60RKMBjVUNu+hL4QiNgGo+pvwyn+zwSNdQ0aLZwplEsIoi0qIBDheHQn+GRy4vm4hOWKV
-JTAXT+QjJYpu+VhunWhjg6X2768IuJqzs7OT4GWKmh+0ND/2m4M3/bNxsCT/WtKzjFtm+WxcY0XcXGM9
-WNw2VwLRxS0EJW1gsH9DRY6PxSvFXgqaKdJXyjPWjW1hwF5g9NUNt0ITYvdeIs3KQThYxwdE+uSZk06A
-s5TfbWmLfHWfdBXGovX21vbT7KjttgbR7JNupm/kPXeztkhazVFCjuneYU69YcaT9nEmwTjDr/yhDHv2
-1fBx45354x20j7f9wt+y22WSCtYNNCNucS18+r3jH8D4NIHuHvvK9dAzbE8veApuXPD3KyJvo01qyJ3f
-rYbURPA2+hcqIr3I594dnSbzAnL+KkYGgzY18G10m8TlcvCrYisZV+gZmunL5FdlH31lkVyB0quWQI6c
-TXGCvDyES7Msc3U4HGb56lcFusWcR9f8SgzdUHBBmMl68zRxheQCtOSVYt9Xy0zLTdi026vNwDPmXUhN
-6uCWu8tTntEM5Uoot1q7kFmto8NabCSetSTLc7PgWcxuI2X+7Nrk2cA3nJ+enrKLMmbj0WgyGG9NRqNx
-D263H/G2MiyKUdve3g5kLrKrQlY5gExmaZKJvJqr4Wi0/3y083x/d2h9riwkQSf7h/pRQUcUc2iR3Il4
-C76QkMVicZNEQvXZS14mmWNZIOw1k1FU5WvraKe7eRqJNH3KcqkSAz4024Ju+5pbLgRXLImFvCp4vkwi
-Nvtv33s9gxUodqz5LFVp9lakqRqw86wU8L4KATTLtWVs0U2Zp1vm9RyyKeGTI/rrd0uRinKdCzxzPY/7
-Mo0Vs88mYNgOYoDV39sUiPyqEIAfTGbG+dr2RXapPL7hEJ7nK6PUVrKAbFTylq3AKVqkqYWSGrDXkglV
-8nmaqCXmo11x2GNV8izmRawwwzfjEKRBBeP/aJghbx5uf55QupWWcXUXLYAYkJtkIasyyQSEGQeoYrAY
-E79Jby6cAAxrEi3ZXCz5TaKXyhVmb1ZwnFhRpfhS7qW5AgEjL+RNEjsHc7OUmcyUpgqVTWx0JgtUmRPa
-8NRDmn7Q2MAMSEISJSV5pcNpVT7rvQXwQJS/STj0gktymTIFO+WqZFOVoHhwVqXpO2jRPev12TvNuXff
-9frsO54u6Ph0v+vhe/trXhTylnVf856XvgZDfKHMqDDoGS6hIAcDxlfz5KrSOA4xd2ijsXOOdtu4WbTV
-lRIDAJF3ROnqcLNnU+hVVop1pz2IJEBp+PSNAH1HS5lEGga5yGLF8grS+kBYqrwQC1EI8m2d8+j6lhex
-Cc7Ay4QOJUqlCRiBLRNVyoLOuCzYO6EgHHsOnt8Rii6zpZRw8pCMEAgpK5MSwZLA8gxi0fxaKTC84dYC
-g+d5IfMi0exuKrMrjKqrZFrhuyjGZYCpmPHJzypOVJ7yNZ3+cEiuTOAtgpC7WszqdBe1awPwXqPemnUP
-tuZJaYUwr2vwbKax0aIpgIDGn/EeNJYab/1pUHAwDaS/FEJcgwPMbF0kaZpEfSbKaIDXFRjzZ2tWrnNL
-cCOKP8YDV95X+gSn8I6WgrMNgoswQt+D/r6w17KsHYzua1GVBU8J0wfsVFMsDVQLbuv5ECcRvGxxr1ee
-rckFoL4JA3ZuxGjIElouBUwUM2Jr5glwwC0RnHgwsbhEtxAtsltKB/cJ5lWzm6EJGFAodEG0Lranr9jF
-2+nsVKPvT29e/vjqlJ2/vjz9yw/Tl17wDb2mOeXK9STmWF9+pT5CcCp4uMFX6TpfDkI2BrqwdNcR+5WA
-k8iVSq4y15FHv3C5un2G+xDQRyIGCTgsoYmBw1Gn6LK+TZTQS/EyUQselbJYm3jVehvAC8XgkSbf4LgC
-Tc01hgoovMkcSaZgHLeRvlT4gE3RzWAlMce5UaKJKAH9DAI4xDUf0bz9MxOrb8J8HR5ggLoym7YWYNfI
-M3WLC1mba2ptEuLaG8wxlOam0XfYHAJSYq6WAbsQZUnbWOVANTXD4tZvjo+9KuWCWI2Wi1j3AjYfxH2A
-SVsBCiye8XStKIcVxNzWrBZv4waANsyrEgNtmmoEIG5NnvtwvZY2uwcHYccexwAxIXzljYSb2/g5sQUv
-Wjhc0tQAX0q/Dymq5rAsxuOhIT+O82dbW2wyGu1vjXa3Jnusa1a0Oxj1qPZbDSKlyJ67UqLPIpmv+1qa
-SRbrPjk9ahFpXpXCSWSL8pa4GSI5+o7LIblihk6i4Esl4CLWF/J8rTkOjUl4UhEsdGtEKU9WyAzf8kJX
-SwTZcRTiSu83JbLzxjYwesnBKIc0kYc+zKJ0EPHVgEeD6nr4P1ZX15PdYRU5ASAKJKlQDLLKacPuoBdP
-Wq0yIhSYnuv84g0bj/Z29hyiWPKn+1JGNmJbZLWRehSFdX98hi8sgAqNAUYDavoG+IDZaDgbwyEpZBrc
-rlnMTk5fUmAlwTHkRWBKr7vbGpv+XstsS+U8gsOZxfpaTZF7iORqjryo138XXU5Tpm+BK03EAJNemXD0
-smCvbAyu+qnuGR+1jau7eHN2yb77t7ffnb5GiExPNkFkHEKErCsfnupssXF6tAYNhn8//eENe3d+cvkd
-3VbdH59NRqPjh5fwHc+uqpT9N76SDGL6p+xG3ooU98YYvWRKZjwrle53PN4bbekfZ2eme5rJZjjRjt3L
-nRpOWm+KV6z5bcNWn5k1Wxhp9sxK4RZElyJaZiAjkCvbfxqPN0CCOpyYqdbZZQhc7CFsIcBVMAvtdBLT
-Dx772a/XjtdepPwKONeMz1MiI+tN+2LnASomSFJfR2mXIlrTHTBR1B3DiT442H2+NYate/eXlzsGWh4j
-YK+HxmE0bOOGmY3DQNIhv4tB28w7Sgguw/kmHtEZ1ChYoqy2qhCpJqJkdUUhbe3b5bQ0XBfdfarKSbmF
-WhgNExvdjL2V6XqBvtyZLAeMXQjhq6ticSNSfUMPVvLvSZpyuKdEtvXjxTCWkRq+E/OhU1UNfzAy0PAv
-kML7I2amVWRuN/Tm+Z9o7BSWCgFFScXp+xza6qgi7dZM5hgLVH1sOISnLEoehktnvwC78svwF833/oLX
-zy9VRufil+Evmnb/4h5F3KBBXizSCoRvF/A6doT+3qHRhZZrL9e58Ey0fU0/uT5hlAgy4bf+ibYCRsai
-V0R68HGuRr9cyvOsFFei+MVrZOIYWyXTt+SU6y3h0L0Eu3jGXxxh2x77h+59Lkp9k/2SqNf89S/usYW6
-H9VXNByyKR13zTvIqtySiy0y6gBHVaEvzdqgX0OqJ2YjKusl12y/9FVnt6tl1L8I44ldQBgReoPzQIJf
-LCD9hyRYcABz8Fj2oaPHiJzByv/7f/8/yrg0FCaUVvCQZCeP435zZIwTvvySiuxLmC4aDtkyuVq6Tmx7
-2P9vCDjP2JhyJKIahKMpQH29PvBwLZsXrvusvaNRE5zzDOdMZf6Lmp5JKm9b5gxbQszaipfLhKv5OhOZ
-GszFMJOlUMNf+Q1XQDC2jALxP9mOtvTVX6Xc79E8iyPwtgicPfYVGlSxZ2aKW86eo2FS8rmGOSYHku6T
-HkjcOxnSrQEi3VvyWnGgbf3crdOvPut4xKTT93amA7xb5zCg3O5rJLNFclUV+uLqHGJCIvf1tkhK74tZ
-V7tv4H1ElR3543uvRF3wI8K4N7cRO8LU0cMhe2dZZE3y3FU2oKqDrCLtH6YKDJsEl6rfij7UWp5pzsD4
-tVNudggM36qz8/QhptsWbsNl0w2mlj3Qp5up15mZ7gR7u8B71oR7zWS2JW9EkfI8J1sMUdzwVJmPqsH1
-6V5MEBiQzJ9WWaJEyZ5FvDx6Jehnhj9nC7alWWqGLKdmOBlwtSx66gBruP4j9h729z0b3Y22R6M+/Nw7
-Yx/6WLZzsN3Hn3te2QGVPWeUaRHKd5+PoXz3+MTW3T0+ozLX5+6M6s0mYfvZDpXvenX3qWzflu3RPPdG
-20H7vTGVj137vZ1jLNs9dWX7VG9/FLY/2cPy0x1X93Sfyg68simVnQTt90e41v2RW+v+GNe6Px67sm0c
-f39nGraf4vj7xyNX9xTnv3+2bcuej7DP56MQfs+3Z3366eruUN2dA6/shMrC+T/fpbq7bv3P9yZYtueN
-f0D1DsZh+2Ma/9gbn/b6+czrc0bjz2rjn9JYp26sKa11CmulMlrnFNbp2k9prdMdr+7OPpUdeGXHVBaO
-PyW8mO67vZrSWqcHXp+0zulxbXxa69TD3ynh73TmjU/rn9bWP6X1T731H9P6j0duTse0/uPa+o+3z6jc
-4d8xweR4x+uT9v+4tv7jXcS/41131o8PcE7H3vqPZwin41l4fo5pXcczd/5n26dQNttxOD3b2aOyg6D9
-bGdK5V773V0s8+Y0I/jPavCfEa2ZebRmNqOxZl77GbWvwX9G8J958D8h+J3s+GUnVBa2P5nh/E9mU1f3
-BPs8OdnxyvaobC9of7qNY51uu70+3d6hMtfnKeH06c5p2P6Y2h977Y+p/fFzr+yYykL4nc6Qrp96+3c2
-xrKzsWt/to17cra9G7Q/296n8n2v7nMq89rv4zzP9sP5nx0gXp0dOFidHexRmdfnc6r3fD9s/5zG8ujP
-Ge3/mdv/8WgC+zcebQf4Ox5tT6h84upu71HZvlf2nMqeh+13D7B8165V38FQpq9hU7a9C3iqfwbt98c4
-/v7Yrn+8T3Pa3/bKdqlsdztsv0/l+9uuLu7/eP941yvbp7KTsD3CSv90dWe41v0Tr8+TEyoL2x+MAK/G
-ByOLP+ODKbY/mDqYPJ8gTJ5Pgvtr/HyyT+UHri6t/7m3J88J/s+3j4P20zG2n45d+2PkFcbHIzf/YzxT
-+mfQ/pj2+tidtTHR2vGxu1PHxzs4p+OdcP7He7j+Yw/+J0grxx5NGJ+cnWLZWbD/mknr40+LK5PRZIpl
-k1NXhjg1Ge1th+33qO6e1/6E6p7asm3qc3s0CcbfHuH52R49t3OdHowAJvqnV3ZMZQH8pweTXSyf2Lpn
-x2NYq/5py05xT85OR8H4Z6cTKp9su7pnZ336acvOzmCeZ2dn4f4bZkH/4nZgNB3tmtI9v3RmSs9qvWzT
-MZ56eDCa4uUKv7idHO8hyp2M90JacDLe36Yv7ubUf+ya0mOvdDql0ml4ok4mhGonkx17/k9HI1wn/OKV
-IvhOR6P9YEWno/GIvow1Fjz58Nslkwdkq80iCqjXt6Zsi2SVLZJVtkhWcUIJ96QxTygZTfGyGE3dpTaa
-7lDZjle2T2UhUzFC2OqfXt1TKnNCwegYL5XR8U7Y/niPyr32xICMPEZjRBfNaBZe6nQA9U9X94TmenLg
-ldGcTkOmenRK/Z46BmZ0ekBl3pxOaU41oWR0RuOfeeOfTahs2yujOZ1Na+2p37OZV5fmeubgNyZGdTwK
-5z8mAWjsCUDj8TaVbXtlx1R2HLaf4PrHE8cAjCdUd3Lsyoh5Gm+HQuEYT7P+6dVFRnHsCQrjnR0qC/d/
-vEPtd7yxiAEce4zqGC/l0Xi3Nv4ezX/Pm/8ezX/P63OGMB3PQqZoTPgz9vBnTEzl+MSb/wnN/6Q2f2I2
-xyd7Xl1ak4d/45MplU1r7WdU7vZ/QoLiZNft6WSPyvbC/Z+QUD/xBMAJCUUTT6ifIKMxmsyOa+1PqNzB
-ekIwmXgwmdCaJie19ifU/sRvf0Zl7vxuk/JiexrOf3u6TeWOgd0mRnt75ta/PaN6s1ApskPnYscT4HZI
-UbGz4ylaCKY7u+PwUh/TBT4euUsdz89kNN7zyg6o7Hmt/YzKTzymgvqcTLyyHSrbD9tvU91tb3xkyiaj
-7W2vbJfKdmvtianZPvbqnlKZx9Ts0/j7O2H7/TMq95gaFMonIycoTEZTWuc0EGom4xHCauxYgskYOQL9
-0yt7TmUh/IhWTTxaNRmP96jMwX88wTmNQ6Z2QvRL//TqHlOZg8l4l8bZDdc/3qW6ToE1IaFi4tGPyXif
-yvZr839O5c+fu7rHiCvjY68MacoEaYrXHunKZOwE2MkYlWL6py2bII+hfwbtJ6MJlW97dfep7MArO6ay
-41r7Myp3+z/BO0X/9Mp2qSzEv8l4SuVTr+4Jlk0cTk8m21QWMtWTCfU72fXq0vwnM6/slMpOw/YobEwm
-2x6sUKiYTLbdmZzgPaN/hu13qO6ON9YuwXTXnd8J4USN/k4mezT+njc+CQoTD38mezT/vVAomRzQWAfe
-/qFQPpkceH0+Jzg9r8EfhQr909WdUt2pB9Nj2ufjcPxtFIr1T1t3h9a6c+r63EFF6WR3J8Sf3V2su+uE
-ssnuAZUdOJzafY7j7Nbmvzuluo7/nOzinTDZdXfCZPeY2h+H+LOL/ONk93jfqzujMrf/uzMaZxbu3+6M
-2jsF4mR3RmudHXtluH+7J7X2pzSvUwfr3dMzKnPr3yOauDcK+NfJHtHFvdFzr+4plXntx4hne7Xzt0f3
-z9545tWl9k4onOzt4Pr3dkL6sYcSkP7p6tL+7+2NvbJtKquNjxLaZG9v6tWlOe2deGVnVBbi3/420op9
-76zu7+Oe7Ls7SctifYhJEQr1Z2dn0F7/tALsyFT2S+FhEpUFIyxHQQ2tPY6TjBdrpgQvoiVa4dLbc7kU
-7Cq5EZnz77QGZ/AgrHIeCf/RqhFNTxRXovjMqkixaUsvvhuOdZm1QSIvi0qE87h//Bf41mbjNZZLUdwm
-ystgfBsNEnUBrXx/6EjZiEjT6i5JEw2QwK18HgApyawMy+Bl1OTEXSUZO2KjPlvxO3bE6o9iJhrsFvoe
-YovYxu3XUPq60ej96MP70Qf26RNA8Zvm9xW/+/B+/CFInEyPjtb9T8/nmyM9P/OovEpidsRe8XI5WKRS
-Ft2unvwzPfMeG7KJ55e7adwkhnHt0y+uXnf8rMW5csPqoJeR3wuATveyVeulFn7WpSW1QWfD1fsxWtu3
-FVAdVBKPwPZTjlnX73m0/Rfjf5vuBDxA/Bl5mF9/mW4cgM04bHUovwWHbaMNOOy+/2tx2Bv3D+BwrZc/
-HYdPTP7PVhviFiRpw8ffjo621eXjR7U4p0uN2UIT3TRIN5pOGGjSPOod/gANbG3otBFrf0Pbk0QVm5r/
-IYhbi3VjOqJpy4N0o5G+73/mRjVhs+mqhEwjmoqiR1XTgnXg0YQjCNbUsinGgOdFQEC2IbEBnlpw/F+w
-L79k+G10x0e9tq58qx6TPO1NXiar5O8QP5dNL2bn5xsm+DWMEnQ7Np0c129/tPDdaFczCHGbWA4AXjDA
-yAyA+al5USQ3glJU6zmRTS33jOnlRuv9gaMdYwrZ7OA3HoNpHRIfXC8W7y40oP1/w2FghJ5kSTnwTM6J
-fuGegvg0eW4Iuyng7NMnquemMBEHI7uJuoDvRAs7J+zxiyN4Jdpe9MJZDYfgJzgYDNi/JY2eeTQKe473
-+Tb24BZzsU5TvWuq0XzxvNZ8wRcL21yPOws8Ks+Ns3RLV2Jc60qMn9uufhIFZLQCb4O2xtv1xnv3zeOs
-vZdFfTWLvZHt5axKU6QJm1qLemux12vdTohV4ledLBaLuLXudqPuNtTFSBEUt/WQiZX8NfHN1StVgeug
-8bdCfh/CPCVx6Baaarp7tcTuPL4IF6tyETHF13CelpoTZxfooKaPXRxDhQScPZRNeihW3/6xy6B+CTjf
-zP/VL4EfHnEDBATOv7ibdG6TLeMLj9959B39O7bEhcVqC3WtysJGW/+98KwH3rqNBqpscj5B0CXyHC5u
-yAL1wdBLQeBTL/QghNJxdrU2ns4tDV8HbSO2nt5KrPy1uaTtvmwR/1rcsGdH2CU10n93azEJFwtNOr9l
-Y3aIcW98lra4CXbP2NGrak6eCZTWlFAUgS0XCyXKGvZ6+3Dfrobhua5E6Y21KORq0Hre0M7+0ljcozOb
-P5d6X+3dyLz8iEDdhDpBB0/akgBc1ms57ILSGm5R7pi+G9tLkKm/QOz9PhNZTL/d2mMIuOcqoQh4aw2k
-vfa1eGDugwsMhu2etaBeLbCWa9xr4OI32LXBx3kh+HWQD8tB+Isj57ERJGw3K8XEXRYAbl1PLI9hq/rL
-05eWORl2OK/Roxdqeu/1HPifPWtZs9u6J+G8TIQmkye/LAYWNbptu9urnz/XxId740xuOI94LNDJMw5P
-xL/+DLa30hO7hKjbcWuL33XGaol1/WMmsjiIoxi0q9dkW4TPAHBdtRAYkmrA47jboThY0ZJnVyKVV0Ny
-Re70WacUd+UwT3mSdfpPOuPB8w579qTTedKjXMEP9BTzUjS7mYzGO+DTve/1Vo/OtdQ37PBXNYRf/qOn
-CABYlbHIAUiDi1IW/Ep0eu4A/FfdUFK4fXYiopQX5LWNEPgK0ucgJ4opXFaCzblKIqaWvBAxqyDFWWJy
-0fASvVSlZGoFUWYkixESLBaYJzWG+VJ2bU2tYSzrB+McyyEdFAT/yGJ5y5YSI3rg1GrRs/RVxRXLyWsM
-62hxrtsbMC89na6d3fA0iVmVlQnErICQOzxN/m4DeGGubJwhdoVzuNQzsgloTdYVXoC06iUMocAqLgcT
-BcyBgK0mr8LfpVz5vrm0on+TFey3Sc8AmZrKJVvLqsDUaDLSkxWxGdGfp+6UJuTy/Hb+/c2bV/reGI9G
-/8UL2nZcJGLB0Fptbedvo8LgdPU50tOMZE7Rk4D/TJN8Lnlhp328ZrFY8CqFbG4UwMXw8ccvp7Pv2cXs
-/OLizQ8XXngIiA2xxhVT8IzfsGgfASAORX3qS8E6CN4tO+GtTJZJJDpeUCFAgmAjDDh13cVakwAPmj9X
-k/3RRMPRYquGkVrqiSYZ4xrlr0uZM2hOwcBs+A2DDRAaQ6TpgLGfq8lkD0P3Wpidnv/lu8vv2Os3l6d9
-9l+6ZVKmoldLxathZeNXWSyDqv4qaDqvvdlc6jq0mL29qRsA//aQ5CUZNkIwYTqrED1CVQkdEpDysHDJ
-i0woGxKFUsubJP5rcJHGaGKUb7iosszeRThd3dGJyMGCsYNFF1Eh0/StLDChp9IE3n4RIjOlT1jjH9W7
-JOjU2/90+XDbny4HM55lIsaaH0IyhSDRRMRenH1WiKtEQYJuRGS8uLDsHMlSDYEpj1ZcwSWs6ydBPcMA
-mDE+M5npnjAGh3noce3YLQRNqCDYUJLdyGsBp8K8GNaoHpxwl354+KQxYwRGp++lNIIJIFdgZyCzd0Ar
-u0gyDYfaQkbxj4Em88jteSmDses+GznGzhvhks+7JZ8HGTL5HPlX6DPSt7Pn64x/0/CQAU43oL/P4z6Q
-9L6de7u3p8m5U1wlWcx7h3brILIguwVejFU5hndhN2PG87yjIF7ZVQEXd46Uy3T3iq/nggVA6WQyEx2K
-ODWnFNZeeDFI+m2j5Zi+WqDbySD8kY1YvhG4tTyWXxiaAcScuAZfGsf8ZRm/Sa54KYtBpUQxvRJZabKZ
-/ayAORI/D7s/xz/HvaGXdNQEvz6ijGY5L5Q4z8oupjbrsUMrhKOg4G0nBDrGjVQ4rWbJQK2zyFO02gG/
-YRMvK2nbGvWiKDQ4lQxwHd2WIdox5JHdvtSXWbcOfEr/Q4OVfK5M5qZ6rAlG8U1jjRiKAg6JUkQlPu9i
-Z2tZdSBuk18b75g0KTX557cQR8886CfqLdWc5rlz5r1/JwrNRrWV6EP2imfJQqjSR58VlbGjexoY4NSn
-ZBoP9FK+/DL4e+DOWSA5B3140bzf+kAEthUCvHlMROKYNb0dgyaRQnKBCc2RhPxDk7ND1sllXuWdzz1L
-wnxMuQ+geqQgfa5GiitRzig9FeZY1CXIY2nCB/drothTymGVrs29+hSzo0JsM9NhKfOV1Jd6wRYywnSi
-fD4ISSUs2A3bBaLbjvWPhAjRIwcSg/ufe42kmrGIkhVP2T+M4nApQAT7TLQVpfRYrjBwsMc86CmnicjK
-C4zvYS+rWK4C+TWWUBnSkiTZFTb6QUSAe800n2ZGXszAx8yioRX9DdMYkAJm82QIKo+ezXdY/3dOB0cL
-5qM541rytFTYXNeg91urUqya8oJhZ767fPXyREaQBZGyP9BfLidd0Gkk87W/OP33halwKWdmoHCV2GU9
-2acpH4g7Ec3kasWzuNvRPXbCvJ+LpBALeXdqMh16ZOT8KpMFZtEbsLOztgyuZNeieQSOX4pCFhRH077l
-MIpA4j3gQFJj30ZiUH+1f8tVKVoBjUGiIC8bRktCeMKJ/w2bUEqWwxi6Ox/uUHpWyNU/AeLQ138UkM9k
-psqiikqgn/rkkSSiCU4hIumQ/JwieSqr/YHwpBjmKq/maRKxQvB4eAuZW1diNReFMo9+FIcVj93GFzOn
-dvf07YnyZtJsQ3QDpBf8tdnK7HOdjgYE2UvMadTMVmsMpUtDcVqIxzQGYLsIwG61LfN5YPGax/pdANAN
-HwUEL8xLIdSfBhUi8RwI3SY4GL2um91nNsWluE2zcWQdIeUrD9YQjMwi14alRqnMRDOfJk0gHLHrltz3
-FxpeqBf0FEZAwNiX/sbZedtQ3iaxvzfDGkb4gABuCJIIQCQhc2lYDfyGlWo2Rvor1f20bKUuHmzYT/jW
-sqlgPpQsWhbiVpsoilcPZBtUWo9c5qYuB/ebWs5lubR1iSiFWDLEpfTvtTVuBSasRLVD05gDekD1oap5
-4QCqm8Fqzwo9XdjYu7z0Myz58Gh9FrH5x5GRxsT6CanCN9Emp0hxvW+ARikvGm8rASw67107L1M9vbix
-Tt+V0SQg9/2Lx9xLP8jbmUz/eTcTpIC2r2KBGgv7wCCRAq7djrwRxSKVtx02T0oXPrFSAvVS+CZgNYQM
-9cAmzP+SKzYXImMrHkODlSQkpcQG9OxlUlvjexi9OeuOFKoHmcmOnCibMutWsFTw1u7o4RsSfnOIe6eL
-n9gI4dQtaK8VkTOzUL3OAWPnNBpm0aXu9ew19wOUl6aBYlG6Rl04z9Z28Zr1KpKsBCrr2f9EvFKYS6AY
-phD8E+KsbbwkIVriUsDPx1yOBATvJf+eVpaqyLz8aGFgs6jTZ7PR9oxpiCFimmOkt9q0D5XwhMDe2Snk
-bZ/m1g8G9si1Xu2RXvMLl3AZFnNELW25nfUR++ILv7dN3Ep4Ah7Lq5ht0Lv5O7YCkOB/ne3wqBucyf/J
-u/M7uCZcR8g3Eapt4Jxo38HrwBK/jWB5LOeEDbsGLH0fHP0QBu2M1AZ0fIiNogm3chgGNo9lpRqLf4iZ
-wv2He70VCeDLZkyAz62I0M5p1ff18bxWExKbu/0j/FYhb4dmzx/mthrwfgS/1XWAd5C3zJaBfAB6X5He
-2ITaLrRqzf5czqwd8e/jyxpwe5Az6xrWDJt6zJkePWTNaB6mcCPogHfrtSaIC01QPi4KvhL/mxmiLHwT
-lDOIOhsXfGHNHsGTbMEjlzw2eN3WG871CooYzD3WLE54Kq/q76mFrK6WmhErO6TV8bvZ+gZbsZSvRTFg
-7FLa908GT2uYAUN00hSTV1Aa1wh1Vlvf2AnZHjSAIJkAnmzJFjxK0qSECL5fNVvaOciCxUmh6autQ1/0
-31VGb/aWd2fJil+hJbBlumFgtIJiKrnK4KUfXgLIFMIliw0DoFuxAYIe3zJAOWusgEplM+MaibQg+Ef4
-xo4qxJyDRthWwivE51aqIg2TxuqCUrJUcotaeAK8RsAC5JhD7B8YK/izY3DMFzN/bM4gjYuNGx/yM2ew
-YJ9y0oz7ej59f0DvAjOVPurbiH63N1Wc3Pjl8Lf9qBd5pLt2FxtN+ihY3KdPYBFFdRJYyUdrfWQvTMzq
-WzS+kJ3MbMmzTKTus0ekv8Nsw1TTS1qYWKgHQPLopszIIOajDznhrOXFANJYZxq2XxyxTpJHW0mWlFvy
-umNUueBok4pBKq+6nR8ztDAxZkgwGZjHYafPsLuebxVqH/lguUpkscG/82whP+ITYhsoBrksyvFAZitr
-04PbYNaEr1c2Yjx9fCl5XHsbwucRxSJ4rdcEtMBkyonqs3N2VQllde3nJaSgyjr2LRczludAY1UpMsiD
-oIXA886K3nyN7QyaA4Q5p5aiEIa+5YWc83m6NoHlS8lULvg10RXMSCWrgg6letTW1u7EJuIgmjAEDWac
-vr9jXe9j86rdhK/ilr0KSv/Ann5s29T2TsBy1RuKjt6AEi3RC2cuVUl9m4T5/9DYfuhwvdNnvLi6OWTv
-/0EjvZVFebh57MnnD5+NHZKhFX32fnP9D+34uOLrEBsf3pbNm30B6fiCyzPJFtK3d3wczWgc0SYmAH8w
-uBLlNIpEXr7k2VWlSZPLTZGaIvdGDgv0t6MTTLTTZ++9HNE87PmQ2R49C7CFLASaEsxkKovDGs3XMzwL
-q3R7XnNnibCx+XFYJWiO2qKNTWfuc9BsIfHVu322+K3R4IyvknS9qQl+ra1NiR9/eHno9urHH152O8NO
-z+N3P3+wf5infu/8hUj7oxIFi9IkuiYNXpRKJTQhLMHYy93jLNbyvOWFNiP0TPcwwy430RsYpUHPpSKu
-6kFsxlk2+gYTqvrV/OkTq5cNkBK/lrEInZBePGne7V7lQSFW8kbMlkmK0PSq+bfVzF/eH6QPs5al1ggE
-47+dHnhH1u9cE1EknHWI1kggwg2zwei7ArPBdEyXEVazBlSiZFU+AOHjXvLvUxKi6G5Kh/D/55oiZilv
-MU3WOTGePgIZO1G6LVURmSlpPleQITo2IuMeaw56Lzldgiqkhn+YZiVd0AX4IrSczEsCL9mo/cTTSvhm
-lMCvJRl0YfjSmpOc/+m9rv/hxRPfWcfv2vJobdMyOX78k+LUEW0HpcY3CtxwZOB5Wgger9lNopJ5StYE
-DV4R/A0Ej0VhbWE64738rvPCfI2TG3rybjEo6lr2vmcbmHdBDdwO/NHps70dtHHC8WgkqIF/dfps58BV
-STGbfZcGp4efLUZ+bUP0L9Q1S5n7FanvLfvyDFXNUowRx1GdvJsvH21dC3BTOZAsrNlGVAheilOUo7qd
-OLlBQNvaA9AaDCKlwCj+iBnmqGMyJB0yPofMuOKFU5J0yOrukG3divl1Um4tUnHnV/DLt5Cthc5ISejV
-LGV+yMaj/+KXaQAfsp2gDIB7yJ6HNRGQh+wgLJ7Luy215LG8PWQjNmKT/M4pfu5nDNgz1gm7KmJRHP7W
-LpiSaRK/6DjMQ0R+zPZgzc1782joU+rjLeKFDxlsh8jiNhDqxQWHrQ4IywNtRcjs/E6I3tu6wWnVW2s2
-aEsB46RJQePTglikTZNzbFLtKIC6JcarGuHQs4QPdLyeTdNrSCVq9RY+DzSwtrPEEz1iw5lpq0Q5LSkV
-abdTyBSc4/BjveoG7GCss+LFVZJtwcna2g5g5L4WuOuNz8jOHrJcgmrvRW3YUtyVM0Qnckjhk0UnqMLj
-+FRLly9JTu52gF/s9ANuxzB8IaepuyH09/cDe+4FFwPd0Ed1jcsmaGMNXA6xOzJLUZjylAZ10ZOq/vHD
-qMsP2biFpoGjVzBYgAaqiAzsqiK9p57gq1QopSsXlbgHvbG5x1vpOzKoYds9SvF9LdZoLvq/j+4b2Ynv
-zcK+F+tXPPd14eYTW4J6xYa0mskMM1zK7Hux/goVLZgjFf169JefLr8Xa1UW8loYmYkrJaOEl5jfPVTR
-etpYZOEFaLkP2fv/6/L0h1cfGNfQI/84WOtNOfi1YTjapvetDeFNwzynJorZ/fUYXLv+Fl2sC8TkNMs3
-Jb4V5IWgkEABdQ4Us66xtYYVncLaLadrFvG8RN8rMzcD6IV0nZtvRAOcWtUbQLcye6gw1pHLfg7ARJeN
-cimSgkESR2D39XWo+kzxG71jujsl0WsOUY9VGbxpgZ8YJXrWsmBAGvuo76coMrGduJnPR5sXgLH3nXla
-FY6KHqdV4ROrD31b8VqsY3mbubrfi/WJvM02V88LIhy2/ltdsrlBlQe1f8w3VNX3xXmWV6WrfmmKgiZP
-GEPRBM4XIzHMWKpfizVb8Rwu16+G3v6+4jkpH1tPrKHjpoUeMMmuVHubY/rqtbKzyWQmDtlJoiDAFs/W
-bJqWfylYIVI4LasquzI+Xl+xqCzSLZ6Wh2wKCW/ZrCzSZ9O0ZCvBM4Vtqa7mdoO6IF+01wVSGVRGQtta
-28KJ67JXmnwal7VwbecLTKzILpbJotw6z5Qo6HVsAX7hS3ifM8oC42YD6avBGnxg+wFOCdLz6z0jEgc9
-zUEbbk21lhKMoPxpKj06Do6W8kcUhbB1rku5EkMB9qhpaoOOBa+PChxR57xAf3jdvW2GvUFbJfB8s58u
-9aT1PaMGzK5Gf8rccAo0rD9dwn2k0MREdxV2T2Or2hL1Z0360UXWy8HorfACBGTF+FzeiD5ZyQOvmfMr
-wap8CD/1Ca/1rssf6t3CT2Pk1tu0UsNXSVap4b+LQhowKvB3b+wqNEEcubch1YTPBK+/fuxjb25YaBdL
-G/gqXIs+QboadKKr/ruUq1aMgKM1Q5d3Ba4EsD4ad6YRTiOb/mJ9BEwzWMyzWWtl7MZ16zUOZjkDv5pW
-YMMYP+EZaU7tp0dM7afWytiN63bT1H4y56hlbqfgQD+MDUXL89Q4v+sLgcfYn6HFiaIHa7Kz5WuWVStR
-JBEcdLg24Xzbd0mPawiIkRvoexjnUdMDFsmborXRjMWD86S6v3uaqMJ/4DgBYdQ8AcTfII96oCzHF6zb
-+fludNDpM37N2V+/6w0YZve/TZTAfsLm+rrzu+j8fLe/6NRmaKvDm8+xbdw6Tww+gymGjeJSk5SYl/wh
-umytWU9NB0esU5WLrYPaPXIhSpeldin0OBzXAjjMWSo4tBcq4rlgsoBk6+FouhGs6BQrtR15fyBdf+sn
-dLE0lsWybRn64ytR8p/aqYghYEY9zFNkO8AIWbNjwYGgJQzwH2nVM3Z6MYNQFckdHWUMQTow9aZxzMaT
-AwPsKoNbQ8Re8DyuNAN/R3FToA+6cLeuxXowYO94UlrVg+HdiJGFe04IdmvsRM1drfhame7Cf104M7cy
-65TsVhbX7FakqZZK8pSXEA+SXOa97lo7YrkosD630UW4i2gN4Wg0UJX4W6UFFTXo1U+uEiWYuUJ8kBUv
-wYIaWGTdMFEsTlSZZBEdX8CvLk/Lc9hYlijsC8lhr8EEAVq906A5Yh3cwAcw2CABj7QMp+DhBHG6DyZr
-goP9el7IuIJQq7DdxAMGUVftOosKXl0wfknfsBgQhgZLtIiiC3hVSnQUd0ahZk+aDB6B4AEiVYA1niQH
-dHZy+hKWR7KTDQkE0OVpueVIkszonKAL9JsLdkM2KBw6t30BOvordiq9QzYlw65khcGCikTvd18vzXbc
-r42cKM3654L4rFLqoQbsQteulMaQFV9r9nLJ83ztxFY0zwCLTFtlUVSqLFDKZiYIUlJ2FEtWuVTwGAHw
-kUhX7CwGAMQljkibp1A61uedmJtb+A4QSaJrOOX6MIUQwvPaBPGhhXFQ3Ne9Ep8qAqS8ksAE9pFBvRIl
-42YMZLz9NZLvhtlkPE6ZLHG/RDwAtQKlq4+KeXU1iORqON7f2RmPWBPf7H2DiPfA9fMjkeVrIXJWFjy6
-NgaERsLTa4arooS4Gdb3JBORUAri48vC3ZX3zcDFCkIeGqiiIZhWFsK+XF0wCkRrRM7mSbniOeISUr95
-UjLzKKJYJItCqFxmJsYS9vY0lcgyPNUkAXLhm3XaY9YEpZG2RWzjbbaw6/bcrUSccORmjGCF54OmkhTs
-DEAp/lYlNzy1Y7LTwdWAPdWAetrS9Gw88Hl9HI9MJMGCyAh1jQs7TrgWO6aFONM/21HgO5mSZUpeiJtE
-Vh65X7CAOgPFPzmdXZxeMsg4iz5I8QAcg7C7k9PZDxfh537Yi+UEY+CsrPU4anNgp0m0r0BJI6sGx0AT
-nXrdttrmXVCQXgAUxA9EfnMGIiJEmbJ6qpSvcaSGKk3/Mo2MdeE/Au5Ei+F6OiIrT8zdqu/6UuZvC5nz
-Kz9sFarsPJaARCzsSwSywmz6enb68hDUIWjd2e1gWafXr7GQmtiZO15vpLnlnblvFATP0vwKyNEQtgJV
-sNiXiS9hWpCGraY3sHbooGgokgyjm8Pta7vyQ9xvUDMEc5CZMGdyJeNkkRh2Bqei+rUocLpTWjV8wZnb
-iS/0yF26O9Y9S8AxuHJSBkzjlrlFqBPDCmH8RYX0ZnZx3qdICvTVLIyDV5O5uxhDpzzw3wvWw0DhK4ib
-jIWKimSOqzcaY2Owi7HNTHcmeLz+9PTtbOsCFOtnxiJBH+6nTOFjbvvCjB6GOGd9P9nN1WUbttQFmbOT
-Ma2qPBdFxJWw0YFogo55vhbriEO0HDJCt53s7bAuxtbv/NdOD/p8vmuLPnZ6dHneN5DtrDHgChQcezsY
-0zArzRWy4tdCsc5f/2vHim+jUcepihhjnb9+dB/Hi86Ase5raXwrNY4ukyvkQnnJRnfjhR5kdDcZubvR
-7RvsZKFKD6K4RIijGPDZbqs8Aec3bxNALRgfIzaaS93PBmOAVuVsvtbSTxNzVsi9u3lEMlskV1WBN5Mi
-6QpZ9j6AbN4JzrudC0Wqa55UR7yEJ+ufnJ5Nf3x5GdI/KqwTwBm6cobh+ySTeanvDohkaeh/cOM7USHF
-C88T+Uk8iwWQmnJpKYae3NvpxUU4M11Snxapax0SaGFLRJo3cfvguJAZz/tOoBCodf1erAdOUaBnboBL
-si9STnpHwBcRMQgvpG7PNCLCbIyi7Gafl3RVL6qUvJ6JZMVGyoJ4fMhxJSXTTFFWJhBqX5VFkit3IC2x
-wyQ8NBcDfANYYLMLwTHaFl0K12a1GsgXlz+cvw2hDEWdnn/Dg+pDOBcpHkWyiL0+OxpJt4yKxQ9Y2XrV
-e85g2HNbLHZnc9XUwFgVTBDPPnhTG9yU1PuPl2cH0O2LMOhyaE8Jb2qi8aLmX6fe8yA9zQWePE4u8vRq
-zPRse9QiZoTvpBQQ03jGgDu50emDdOJu3qSoqxVkwebVnEQ2dPWjWdm3z7dcKdgvFKtddJ00dS90wXzt
-Y2Bb3B16SfxsOwoeKZdSCQu1pQkKGNHq+0wLMKg2MTK+r96875XVwxeac9v7K03E8+ugmR0dtT+I+rhj
-jV1MI2Pd194IrS7CuUAMsd7GhAHh06YN05646OxgJ4UVjFWGrf8++eBCDQYr1f8MNjaMT6j9+9GHvun6
-/fhDe2y01uUOWt5tH+g18HVpeYSmyYYZIwgTwtNnkCc4ZYC8PE1JUWmj3kktdGpp2dfpmIgFD2CVPQtt
-eOUZYm/Y8aa7iffOS1Mxa3gHb/mQPigqMY0K+NiQiuAKHaW1DDqvSnYrWCyNkcQrHhnOVTNq4PQHd0Xo
-Y8jznFTOap2VS1HSIwVIFaD/Qun2AZj4T9UbHKe+IFen+jkihyqVp0nZ7XR6g4UsTnm07IYEOrAEca/h
-tkJvA2iNxsCD7L3rsO/5LcswOTusoaJGniO6Cwf6/pxRsoCuGADr6Qxh5a0ovofq+kot5UtdMONoy44Q
-6gp4idK1Pn1iAtT734t1T5OXruvgiHWijq4RFN10ep7V3ZsM44cJp2dkXY1H+k/NOvbo0MBbwCLRNw7k
-UpT5eujeqXVXLwVEvg5et43Uai4Zc67mYslvElnhq3rdRhnd51CLY0lmqGs+Yh1Pg9/RtYTNwACpqfxw
-aZBw60qWDNVOAgKIgA5Q/y3ucoocWjv6pKPhmenIV7XDSUpKFidx1in1gUpKknxuBVuBCcFcYNQB9ubC
-JIuzXWUm3DY7f3VKwetM2BQwtsIZAjiNrgVeMLbSBNWQuhsCZGF2wZRf+oFeYNWg/wd1+4JMbbRotc6T
-CMgFkAfvfQLvWtMdRJf3N6CUTCTAHRilv+YcOqCq7zh7zWi5Ee1JleMlxfjCMc7G4h7aR8vmMWCMOLxo
-6SfeGPXYMy0OwcHzUjl6uPHNEdueWEN63b/7GGBgtPRu5Xby0m1bWrTs9Yhg1Zn5F1BaUzDVXHDeYht7
-ZAL9VyazLbCDMQKg9TmEW2AJ6XJW8yQzBlKkcsz19BUI4B2e5xQoebCo0pQCBBlvmFMVdfqGZaSQ3JAT
-V5ZLSxTgo/6jyolc9p+Q9HV6MSNpd8WTTEsmcAXrySQZcwOamel7xUSlvp/kEjCx3TTPX8tsVhYpvOkT
-hDdeKWGs2U+fWFgC0XRbSwlSvRqZInT1qHADedt2X2/zPVcKmJ+1LGGTUvuB7tCGbKN7Mh1ATSzHBx6q
-1wdqKfyS/Y/umH39te7GqOfZFhv3nHl30P9k3+v/MfvYFZtvaS0t/4ZLGoz0/gwgfGL/Ahg4/uFEGN8h
-stEbYKF6b4f5YFETP9XdhW55kXU7ryWD5EmJ1StTczTwr1Pm2t1MNp4mNQlJjWhLeiVJeK+UTZJdCCXT
-GxGjEj7MH9LiFeX5aoETrHWI865zk0rMqTdq0RqoMMPIB0Y1Z1VW3HTkshXEicoh6mpSNlrEYiEKZaT1
-QAHWNz3RKs27IGimBsYprA0AfCXIaQwiyuNIR7TPxqnMXIwa2A7WmvcxM7c+p7YH/AVMbdZd8FRDbOmz
-96JP/X/o0di6c9vrkVHcaWbKhi2gR4XGOG53bJXQCw5r+njjdLH0pgtavyLJU7FF4Xu6naOjo06PyVwU
-vJQFPveqEoMVJaV5scSotQpVHiXXFxBo04oYOTe0abiq1FJfQFdOiUrVUYcFn7A3DV6WJqUoeBpE3DE8
-VCmN4b8LWTRfY/4P2EN84LFecy0PUAOsYjh9A+37WlAd0+Tt9OLi3vq6gqkMGrd7a0ONwAGugDBz9mIz
-XWm+96huFvEt2VkcMsOr2xThaDXRqO+Y+lBsOXR/2By5mTniWyuNvLeCqarAHBJO6Wp5IqtOh5fBp+8H
-g8GHpy6qv1W2H7EvusO//vz+59tnP3/4z0PItNLFQzHALol8q9sEgjCHtr6WoGrmvGMMkjuH9jD5Y335
-pQXpl19qEAZB7U1jN3UyNobBBoy9EyxKBS9AD+4/A9GTh5NIjNYWn2FveVKigl/YdwO4gxIL0tjeoDbm
-vd17m/MATjvsu1f0Gf6n5HweKKwN9WZY1MR0q5mrcxaTnpe15A9Oy5iB//FZjf/orDwvK0MZjZyB3Zn+
-2ygsVen4iRSAdjukamumV15vos/ZfW30904zYcP9dN/R+POFPstcXV8QMwzhx7X42KXEPraDnpaeEeeB
-ooKtNz6ePSHcRodmHkWyykoSRAiNzbsGoLw1DiUNmJa6E4gl+oQu+kLLqgOXa8h6vYBYDO/YunPPGvuS
-z82zoGc2iv09nV2cs3+n1BLwx5i9YBP270/NbYCrOdJiQQAN0FMYQSFg7/SZPCJoW4bMMmPIaUDDQ6+H
-vp6NnsQvdtBfyAxqLm9IN6NJzaHBWuwIvB14WuJfes8P4X+9k3ZW5NhhKLnx8wCXUvy9SxN3LC9V8ri1
-WUDE7AvTPCkVUxJ1kVmnhBD5pQSfDxE81TtuDTfRQQJiZZqziMdvFRjsbeS/wtxAFrlp9gPvhP5uDkzL
-zl20mQtoTJ85oHnaBj39R6u63DyIQfDg/Y5MOwOjnaAHtH7bbPFqNXrnmf3SDy1f8UBAmDcT/heb2tvG
-mXDBUXb5m8Ic9i1JpWZezuuVMw/D5yshTBLGlEfXbMWvkmgQbqLhgRxoHYcLDNSnT5u43i8MMdZ19I58
-+gQY1euF2kRrDpSoQM/uve1STjqCGCStwrSATptYZcFLrQ21Gr7IkU5R3OlrHR4Effe6gTcrsLnkWa3f
-vi5vf3dGfbdJ3mV6iniGJpZgYVzpb0AF0fohos1N4IW8Ve04T2V0DVtFbLPeLmdCgHyp5rz6LrYnBRqE
-B2nTWUAvyMIsu8ZlStCHpiK0yA6N6pzVrJFaTT20zL7O5C1JrmWxJtE1Mak1RI2vAo2u6cw8oQIqhvu1
-UaPtoRyAwN7CaUjIPNpVkwsHSLX+CYKhp0Z4WDD0T0jAK5u0yUdHbNIYL6xJWXK7SLcxZfio12djRwV/
-g7q0CVEUrVrfXqnWF+FCQtBpSRelvkZsPlSanFPOVE5BNzrsGftvF29eD7BVsljTOL2NapMLjdshUpu0
-VU0PYcfgqATM1cBOoFqhAI0d2m6WXFlmCYLF38MxDQgqbdfiEXOcpoNCHS09ZnJDJ8B3Btj9GxojA0qt
-69d57TAZtBr12aQHrX++G8/fwx3ZJfrtUXRAPp+UX4Z6IWMN5d540LA7iyEfK1esLJKrK0hq6SwvgR6A
-ZVeEKjH33mx2CG7cFV/j/VVKNGb0lUylNF21Gh8S+VSU6zopB055tJKxd2rxgMFdFgLAE2BWMtY80ItJ
-J3iy99iQL6ife5tvN5vb0U0/NeHGNd5pNvakZW98LeM0m+/eM7bfTyB2m8Z7G9ftN0VsaTTef3jVGxd9
-YNrWqaxHSbcDNQF4ZJDySssZ1tEHma+/i0I6y1TFqizVV7q5/wd1ooznY6ypl57TMxaeo4khyG2pP9+g
-1wdPb/mahuclSwVXaFxpp9EYtXFYw0VvsXHPTIiauuamVbOJB8pGbsEme+eg+jtuKGrZIq2bQ+jsWDf0
-O3KgNW3oBdM2DR8yXV0cFp8u93YQwWLBvj5iz3f9eXhLa32X1I222N6O1/XnJ/5PHy3vE0fwldcJIh72
-eilGvSUakajtofaRM+8FJ0fLOUKLdjkE2EjLYd3FEfKcJdZCc8Vz+1bBldP1mt7wGvd9SCFVWmHDBMYo
-VcE4ticnBTn6HS1FdN02pYEF7v3Qpff0Hvv0ye4TqG1sk1rHvRbcJnPeZ54avm5EteFJm9iY3xKj5qNV
-DWwIVrP7HyRYjcl0Ah488OZeyFWLAv0tuFFCNkaeOcYKzckCYfYMFNS3uA4bKcOsJFQ+gRuceYeHt832
-ABxMlc6DSEFGcboh5lWSlltJZmJ75LApGHy5A4aTWRLBnYUmKuC1R+bxN/hyMhebIniggXPrk+uxCwHS
-ZuBm1v2RHUHczCBNAiRPASMXg0T3jRDEHxW88EZkjxxyGscU6d2oe2xKKL2pPIWsw8j5KYw7BEmaY4rn
-o9CdOK/w/Rl8/MAFC5NG8TimycKmQvrqWJQ8STcED2pBrM96A+l3vwFSqU9mxZ82Pe18Jpx8JCTdlP8/
-9t61vY0bSRT+nl8Ba86myZikRPlOR5mVJTnxxrY8kmxnR1L8gmxQ7FGzwWk0RTGJ57e/D6oKt+7mTXFm
-Z/YcP7sZqhtdAAqFQqGugaHcjcGc2M67zekGTc51cF5LVFFWF346d3Aqhmr9geFede6t+n2dU6v7+jy5
-7HgdQAVuh0hvDk3/qHTDJxjuSLT6ev9c9G4eJQ1nqDK0Z11Fge56/NWNqceqOO7R/372hPp7Pp7KFF6H
-X7bHzqnB5WK33KUgOpOpGtnZWhkIVkTJvHAZqXmL9X3kkgF4IXXD5wdyPOG5aHjSC2O84+Oj7/1lZZbP
-YeXkdbb1EIJP3ZYkkcAwcXNzFreJKhTc9ChOwx39BtYbuIVNxCAZov8rAVGs4NcQMDEQMV4EgZ+C9zpG
-vCZpPOB5rDqMfZ/cCNjX9kDQw9oC48P+Ftw38Y9v2vtbLSr4YV+TAQ81suapBw2HDxvBSkEOHIg/mMYv
-htpuPprcVZgEopaPDltjDn07cX3fadaYd4BDWv5jYOrTT9zy8SQVPXTT14KbBpeLScoHglLX+J7EruIU
-WuG3Rsmc39vq4PeOVTUi/DZqsa0I2kRbmjb8XgY8G4i0FAEpsiLJRTpnDSgnkBRewpxmTTf7adE+jFor
-7frlzsWtGEypNjEGumSxiynx9DG18zqLWqWzFKMcgpIR38uCcXZ2LzJ9L9l8JReBb8xe+qbmgPnXO5hK
-51J4IBkXX/BBQg+gJUIbnuKQ6kfh6s+sVoy0glp8qlEJorzuDstgJIvUfj+gdp0Ves+hz5LbX8ZcGCZc
-wz1Fis5JR8vF4KVgncSNFgCTsNna0lZttccmdkee0sNGWF1a3NYnkxa39TOBY2jSSdQBFQlqNOsBTExW
-6iNwqxaxKUhpRofKU/PXYn3pAoYE80I/LXtXM7pRMA0MeJryfipKa+opyUvLajAcLKTDqnermpT4XMMq
-SypYXYhXf6ol0eKfiN8KKden+zNilIXmkXvp7LW1XOyhpgVoTEsypXgxfQuXQ8MuenZNO53OZz8aB2JC
-/b2AVdhz8hkb8wmZ37JCMjdMZdNKaki6M4igVdiuwqQcV1p4Xhm2+3uPLWYOq547rFol2Hc+rBgdUb2V
-RxR2+bnE9E0NqTGfbMyXgyvfmE+QZq0oj4vE7IsK4SELHfOJFkEvyRhULaMHl1s6CYzRBJ4JVZCoE7qA
-Go8KLIAJ89Y38WmausL4kKwFvy7fzAxKDJh9c2zgOnPjoeFSkmjCA22sCUiEi58pN0rNEqFcGDhlWnVm
-Rgh1hygMAzTjY0Hl/MZTO1OUKsGHFw0RiyVtB3vNlXW+HaUTFzwW7PVv4UVPN6u6I8P1xWNDzgF4k0tf
-eO8MLm7exazuNqhHZSdRquZAn1nOGIxwAx0YKhn/V6drJmbip2w+8/wVSOkEazqiXGsmf3MBwSDcKtZQ
-yMJcLpDDCFzZWYNfY3Y756Gumrgv9GMNzPNdL0SaIh8o5U+mUt5y5kVx2Syk/kFDjiyQow21NQpDr/RR
-YjLrVDI1KAioJG8KDcu5VvpuNGEwV6uskqb0GrNcZlfWnPiNwWKL7g05lkW0PjEuBlp5kT+HyRAi4wtK
-haNaTE0HIz2HwxuZ8+tgpkEWaugL5iox+pMgsMLGhoGKzuCPcfTClVkY4k3xbeB6Pc37kJLhG3PRmfKU
-puzhX/NgyiGWKDU1h6iX7kTpXjKZtd+f2o6UZuTQ0D75ymTkAoV9WPzR5qTEKD+OzkS6b/AYR9cWE2w3
-5nOsTH5DPi7gaqNFI1oVf/SeLc7jxdY1SO8EcH2BESzKKq5hQWLxFuarwfu7ESUEGYeTIhUx29qnDEXg
-Tg2JYxalPMIUzj7/hleegvTaxSSbn8+9d7Ab9/z6iygjV+U+zrRwhxvM25w+orwtC6L8iKuR8aY3YZdD
-maZyRmei6rEIzWdRy/rqwSFuHRRQ1ANXA9MVaxu3cO/CYErmzr0UIh0Gkp7d2SRG6Y5tmmWmt6fbbOKW
-YxXBmfT4QM2ifVXOa0OJb6hPki5bLOL7UIfihf5v916E09m7H2FDAhP4ti8YmvGLR/pTf59yLXjkfEBM
-DIlMi4nnr96eXur+zl8fvTy71F29mOuFgCwfMmuV+qMdS+nbXTIfgtefFibpFzre0jlicq/7qSxYm73F
-yBmS3Izfg962bi42m4wp2irmxs+OcupR38anpe/cLjInSnUYaOX7IpUzKxhS0UlhnHiUNzbKVPqFBsdT
-6W9jw9DuPLr9tPhjRuZHD2w8KkoP+gcMC4yddxuXyfVGBQO8xGSL88JxZQucaJFKd4QRsKWAIHDi5Bnj
-ec7ntYFl1Qgi1PN690JKaxXUJQ2TqhVlpyTojyUFSBqoac7IVLfIZYmOVXAzQjHRuhJRtkFzhA1kFrcL
-2U65KmzWFUIZduxrw2d5UhQiC7gdZJcsp38jZsqV0ZaLOMiP5vKi2ZxocAEP06L5R+eSoy68mf5oYiX9
-4w986PVB5II/zRWODm1z6jVrvP8Op5i4WoShki5QsnRynnt2mFgMa3QlqCopSbqY1ZCOUy16BbKqtXMW
-kArNHAb+91zpS1KCycfzK6x9Btcpxo74YARUbZ4ndTC85eKO+Mw4GkSebihSXqOfBGgk9Dd6y/WTKzzk
-ZwJLA0OGBMiPCyK8QadT2OSCvCk01bJUFnh/1jIoSMc34CrYadJA9GQqI/ec0h/bpEU95pbfZOoqx3B+
-42KrTFSJS4gEvr/O6aNJ/Igoz9EbsSbQPAme0SUHoxT9DEob0HCgXLnh+SeeX6mShsW7N5uVVXWXZ6N3
-QcgN2/Y8uTzfuWwFZjj69yshrMeC1t361ozQWmq9u6g1obzU/MGi5hix4jd9uKgphrMEbR9d1jX9XNU3
-nUKRzMANH/mbnwUUq6assZIgMy9wigiF7AUVIo1Lvd1wlJbVhBdsjfkgl1v2vcLjC6piU8rEhLyF/VTM
-JvgWYlSaDIDY/U7sAXxb5pQCDFp0/Ohs8t5tmB2jt5dfR3MN1/QgUNiLWCaP9MuVAcWvhih3VsosMN5C
-c3ff6Q6UuYrnkMJ4Smip+ZhCAJLCii1GavH9Ki0m+HVgAafRunQDHmJK0d4NF8iFEbFBVp+aUFnfJcz/
-d88EelPwYrkcRJP9mXHWY/3QBFG/iGSYKCF6LGOqLVBXM+Ju+KaPN8X34F8Y31jX4gvgWyPa5itvQ+at
-dlDMIiRzH0F9dVcElea1qBrGF5mctxb101CjO0/Dg10aaykc01St+H0TIcqpnwZPi98xDwL9BTDuhzPV
-DnQs4zsPdOOd9Xt3yIEcT6aFlhWN6OZUpJgFFe1AQbChneqgSBuDUZP9arqtz+NUclgG32k3Ag5VCKju
-i9OGjvkEMoBwTFPqddoYez06r0XCq59u9Hx8SY8/u0UkLolEV+SkSHeZ68PFFHFjmK23lGCiCXd+Jf+8
-79+1vc1eQIif5ggt9lLmM57HKMqfCCj/ifxfMn4jk5hl/Ca54qAm4zOOOlkfWuHVLJjwK9Epu5KVEvU8
-foy0FDx7UvPsaZP9OWDgqwykrLdmcxNkyjwn+hIJDxeRr946R6cH1kvbeHUcnL6yIRr24enpA/Pw2NXo
-/rdONhJeRVTDeM6j8XOYiDQGnWOPnZPRoUWqSHN1a9lodghiB4n/8isPjotUhpSVxuKiCWPHVc6BXcPY
-Rwg9/dtUFb4fiYFmkxrFqKhBsx5ed/tze0vA+4FUXjkHdr7TArXr+7c/vj3++PYyagFSq/+9bNnBv4R0
-07mcEYjdJy0N4uj0QH9+dHrQqhNImM263XI/vKd0VTrvdndbLDp/2dXAgONr+rrPondRC+gPfjY9IPhw
-a/fBP7ZaVWgPANpuGdpfHLS/1EJ7WAvtIUB7UIZ24qCd1EJ7VAvtEUB7WIZ26qCd1kJ7XAvtMUB7BNDo
-8+6jf0Tl1ahAe1oL7QlAexxAe7IGtGe10J4CtCcBtKeroT3o1kJ7BtCeBtCerQFttw7a7g5Ae+ZD291Z
-A1otve12kXp3Lt0i7nbXgFZLb7u0F7o+tAeroT2snynuhe6uD+3hGtBKMzWM4BTTzTtO0H2mx/v/aYgW
-hho1tCwT/aemZPj1c9RstsKO3D/iNQDu4TPNWLr3fHCDRoSJ6N5Ox1DIgTF8tp8W5hH8/UYUHB8YcI80
-r4t2//NLgetqcA/+9KXA7WpwD//PlwL3QIN79B9fCtxDDe7xz18K3CMN7snXXwrcYw3u6TdfChwcaM8a
-Xwjcw6ca3E6zAi4odKohlADWNrK7+anmgu1Pq6GueO8Aapa/d/9LAURJQQzlLWt/Asl77z719OTBlx36
-4+4fNfJXBU8TnrH735ih667uVyntDl0hxKcogL348fSdZst91cCyny0WXfQ1HHjSh7/18+ZC8ckJl0nu
-c+Vn2MPZ/gvdgRo1oovCHQB/1RBB8m1ZBtxiVbnsKbC7v/8lai3g3Ay5O0hTFVHPQoGtNfu4CsrHpVAe
-w8EgjlZBOVo+FuC4+ckqKCfLoQBnLM5WQTlbDgVmNP/vVVD+ezkUYKvT96ugvF8K5QkcHcmrVVBeLYcC
-M5LHq6AcL58RnNmTd6ugvFsKZRclxl9XQTlfDgVku8vPq6BcroCi5c2Li98AzGIoFxfBVq9u85dymhcj
-2Oes8VFApiEvOdx/YfQIpIzDnNKH4uZMypQKsLLzXcDt+cH+O3C5cTvf/ljwbxGLeAzkB15DLEARTGif
-Ts2afwtXHyhRndYDPN0c4GM4iuPDeoCHmwN8AjgcvqwH+PIOAIHNXn1fD/D7OwAELjf6oR7gD3cACAzv
-b/9VAWgk/f/SMDWNlEAvBAhkc/3jQoA/WuEKMvV9MveHhQBBHExfLwT4esMRdp/qu/XzXgWgd25uhMNd
-uNhdRFtR68sA7D5AHczbs6MT8J+7yBE0RVUsBGjf1zCYZGj4C2WlxLw/ilzGTHkNpkYyLwbTQnUYO85A
-cWVguGoNEPgE/Olg+wOU/qUavfBCS836BWaVJR0Xlm8woOhbbGlcKYDHkX+STZ1GJjqMn+BMiVQYPwpT
-2SL2WeUHBPpN+4MBSxlk6qA3INuVgYTOE0qIMbk75WIgr7LkF/ROQfwUUjbNDRlI8/z0h1cvz8rKtuq/
-RYz2GXCdX/4aHiFA2n+tbuiF3BWY4e1PNVB+Wh/KY5DoBgclKOa6dPApABU20Gg/8OT4p4Cdmw8LYH1Y
-BeuDfycAWOC8WsMBXpQ4gN/A/ob3lqkAtrK3C8b2dtXY3npjewI4G7+pwfyb9THf1QsYtb4NofC0aBAX
-CTjcQiiamKLOd78XipaNtv9cj+1PVvfzZ+DdS6Sa0+S2GGHpZnDC85RLqGY+ODt5vUhcCR45NMF3+69h
-w63/3TM4ic9f77/brL8HcOCyqIIypwZbhMVn8On5yaZdPkPuf/Lm6O17x1WWfhdaNMBWYEwCD3EU707O
-Tg9OlloEEL8PQY19enDy+kdv1Aubw73g/MXJ0f6K5oGxBKx5cshUcoumO6zxTT6gWJoFBg/CxPmrt6dH
-J7DgsAF/FHOsl0m7tLwAlZV4gHz6h+M3R0g1BswPcizsVl8NBhfm3ffv34Vg3vEr8X6y7mge4mgOj5As
-HJhDkTq+s3o0j0hIOCyBOcriTcA8pEkdksXInxTUMqmj8brNvq9Xztq49LHtJYYFX2yTPwvWueqXg2Xj
-DTiXqhW+pUMcysia5HQmvgrmAUYJszKMDxqkEtEXGDLF7K+aCq4PmBAMOjxILxykF2tBegAX1ZNX3/8A
-xOsgHThIB+tBeuKiKIIxHTpIh2st1Hn3IZi93r5/8/r44Me1bIQfk2LEsumYtu3QBq1MeMyuRCZyXpAg
-CXUqIGYEY7eUt6l9gS3yeUAUmkjRsg+xsw6S8cTPhTLJKLe3GbqboXu4HWDmjWmaJX+feiPqdIweDXfh
-j+/AtrMQbVWu/oS+62743VP6bnfD757Rdw82+667A2T847uHm37Xxe8ebfrdLn73eNPvHuB3Tzb97iF+
-93TT7x7hd882/e4Jfnf/8ssp6XeeIcz2l4T5GGF+s+H8urTu25t+R3TWWfs7ewdETdaxi7VkhZz4QuJj
-mMuLfeRTDLyMyLR+37gQ6B/Nkl39/gL/gcdoB/9ojswA3l8MvL/Uwau16D6GQ+fk6PXxPoAM4J0YeCd1
-8Gp9CJ6iTZcktdL4Tg280zp4tV4ET2FPfqQwPITnORMEl5MSvDo/gl30Snhx8uqsjW4OHrwny+HVeRLs
-ol+Chne/Au/pUnihL4Elqe6TB+z8zfuzo8sW6z55yM4/HL9uX8J50n3yCP68fwnxheDQ5hzbLS0aSFiH
-j415xq9E3sLSERGUBLgBPx+QS8Ydr8qU7jxRjKdKlixPkWLtT8YjSBN3GNZ6hJUtXTlg1DTInKrmw/Fl
-Aq7WiwTwpeQFxeiCsFx0p8QP3kHvQeWQIJnCKg8tl9QAfct2/xHVTVcNckl1u/EnVB3tT4dDkf/+uYNo
-v6g8Yzj1kRyLH8VcneKAfvbn7UUYYDWpWodT19xz4C71UuO0bVwcA2z9EOZs9t8d47tqXWqXWxUxqSeP
-uYJWLplx2/tnr4++pfwBy1PvEPwl1+flkvV5ueb6HGXxv/jy0JV2vRWa8KuFK1RB0vmjf0TPV2II+//9
-SEIkFPlUsMOj1xBRq6Z9yBEkCu4iHEzE4XHmjoIWJa69zogt89QUjGKYEZGKm1+JgnENn2qlYMmEb9gg
-5cmY4lJK32eyMDHJraAPDQUzRtu655BMKKPEDhzT9mKtwARyUXuzYC84JI7NTLtJLobQwYBneuaUPSSc
-vM0jMeBqEyI5FOl6hwtPCxvNgdX5XHDH11/TLqt84hdE+9oFJ1RJClwpnpePnQf/E8eO1Zr8ETvn8bo7
-B/IP3XnvYAUtykUz5vl8G+K6M14AyoTIlAmjraJwXVyh8S/AkqkKU5M1xE1ylkzEgcwKkRXqd7MHMAmB
-7ajb6TwrG4cMMWg5LwibJ17hJD8E4SxRz6jGGGaiSedUuWoiBglPvTwuY5BBIWJfYjdJhkXXdedXmRyL
-tp05RrrlPLsCU1guADL0t9vpPAVupEHNTOElBpPCei7E1CApJOpkZiOZGnA0srXXjjznlqze/3zITJUL
-WUzqZac59EVOzOWuoq6p3Lm4CkNYxbMb9eyu7pIsgW923RtjXfBfPyi9Pg9fPyy9vrgI3z8qvb8MXz8u
-vf45fP2k9PpT+PqpNynLjc3LZ97LZ1ElBNrfi/tpsfFWXIdiyQ1zCcGuIBcE8PupZWVLutIu5FZg3P4j
-UGRcXe+OI4LwP4skOr5/PoAKeFkh8knu6ndTlkuQrwZyMmcDOR7zrL7KwALOd7AMRRRUL0yk4gJ0XYni
-kFLANJr6r1PzjVdu7J4FBGla05RPlIiDoPcAur7yHBzoWZXQ71fVQccL4xoBOPATVPoOFohLjUljiuk4
-OO+oIp3xHmH9OUtFUZi0V6USedgtupSMpSocIGpIZStNlpBvZJbOv2EzDglrMAlqIW4LE/g4SJMJqgy9
-4D4K7YviPLkR7f48YjPRN2NeQrxQ2cGuwP6wELlGox+DqERxloyFnBYNtyoDWpMzeZTFkJfSvWy22CNX
-VKcUsrdOnJ9XHOfeknVetszoEPNHLPaYZyDYGGciKCwyk/k15JRRSTGlxHdQ7NEBMnmPOuJWDA5w7zUi
-DTJqou4slTNXR+pfYs0WDUBO5rb/M3lgCLJRyru8Vsikl5jZHaM7D+ovMMTh3moOJyeQfTwTM6OxJNYP
-hrhUokJjI8Fu1QlQpTjsuaPH0rArbPsewRU0iqrZU6IBSKV7mWwNUqnE3lyoVi5U8gv+NJeLXMGfUVCu
-i0CMkywZJ7/wforfzJK4GO1F7L4ZVZJlIv+on9Z93hqJ5GpUVD74AR4HuazXWsjyEoqlS/gBMnxZta/h
-mZAKcsnJBephTCNkT69yctS+GPGbRJ+BCpNZq0KYZKBGM6CUUIHrHFpese70NzjAhZ98oOrWlPlsmCag
-T/czdWHF+ps2dB6BLgIzUW5Cjx9W0WPDT79gSlYFzPIDaLQ95V8j/KKGv9InpYD21Xy7tP7dx7XrX626
-++X3Mrqp/e/ay3JabLaX4YMvsZd/jyxKTq532eq4yUM5lYqn+DnFvWNc7/iaLQtjIHmAKjyDOQt0DznP
-1Bjq15s6bo1kyMoV7uCYb1LiVLScRYNIdxgdRHUDC8SLGVA8zthDgElaTMpH0FSaAu42k6GVp5V0M7vO
-5EyBskOA5XgkxlS6JtXNJGgX8DaEaBxxSFcoS8vSn2Mo/ihxwitUzbjiyUabbeW9wGynNa8Fzyt7lP32
-m5OgwktD3Q3B1A4XVL2eVM99oac5EmkM1OLTkR1hSS60njuENJd1ifJfy1hg3kYeo17w6PSA1dNQkU+d
-k9dMEPIpA3IsBkksWF8UMyEyoC/wFgICc7u3VtcSJOGBappBuWdTURRcyhYU8tZ7oOZq+DvkUE8G9ZOy
-LVzGQDxtNNlnK5J+XocZ4TFU5USQW0O6tBywjB9aLBYTKvErs9L5DLkj0ekOvvY4yAcsKax52Vp8DIUJ
-FEE2vHCjI/j6p9jdNA1lpTzqMj7YZC7LIdkkLpsdEj8gzQ1lVrBfpBx7JcS8jCKRclUWdSssvs36SYFJ
-iDGVOeMFG4i84KZVKm4EFgBk+wW61o055SmHIbUo8y1X01zEDI9OLCedyxmkktTX7RgSvSu6ZSM/prag
-+Z5xxcTtJE0GSZHONbmbOXy09cxEsNVgeCZfj2bKV1SmH60XMi8ihUjRsgMUOv2G8vroZjDddakndJJa
-QUX3atQpAYC/6pEHJpqQ04K0++7NX42+W+FcranEZ8LW9idtSAx8Do0WAoEKAh4U34powNDZzmYckypa
-j1mrhccktKoQHOr48OFQs5/sCnpyVWMDJgsZFdufyrkUtbgwjJ5Dxz9/sm44x1k6Zz9/0kOEMvZIbJKE
-oqCidCZN+tLOneSzJaz5ShR6yV7yQSHzRpPd88oF+yW8IMdgwbq9LuJ6CB+07CGhbyHs/nZ7ewfp1iDS
-q8fuEzf4CkEclOAqQd5ovFenxCuvBGbb07+7Ozv/sebcg1sG1DuGvPvLC0BDOWdawZ2oVNWxxoooipcy
-K06TXwRVhQ5KOYKCU2/MZUKMBdD0ikqaMbQjLcdUqQv9IN34oJf2HuvWFpSEt/fdW+xnjTnpD5vri/ee
-8XCDyiwTKgjzv6U+MZY10gOwCbf7SYFmYJPoCvLEggw3TDJBJvRSbcwzP2U3VBsCZzyRTccCiwPTuFTB
-i2TA6koLaTi2YpFJPwsJmi1k0kmAqMmGPFUCRN2tb7a05JpP4eDLlDnQ/CTiWPioD7U1lcgK+h4/0AIi
-vM9kgZeNrW+2HCyoliVUFkHlpGKxMdyUBvQOJDURA8/wbcp3HsgpXBh2/GIZmHAOrLbmT9hn69RlGspc
-48pJo2MZ+zmbz8cyviTg+Pu33xAFzwOzA7XbY9E39lCojvz+fdhpqGfVr5uh5+MHfTqUKGIF0txc2B47
-/4qxCI7EqIXqJv2/PIU/9eUj+urSp+MBVoGFRMTAfzVj3oeU405ugGqzzUAWCwpp65NVUYSolp7wlOU5
-lMrCWqUmAf+gEKowlYQmpnbrMMlV0cLbLC9YKqUS6dxWdbHt4IDkTF+3oXqIzJNirr+BJP9QIsQ23qDW
-M1+zXX/FQnhldX1Kdjk9obB/iZS/Zf3wSSCyt7vW+lX+8LulH3Z9cX4noLF3eXLDC2EyZxo2ZWqbUfmc
-ianOq6/9qiBhxXujqKYbZIGXswx9vSp4N5XcNNlQCbfCK/pm21Glts/IjtDRjOw0lJ/Yr+D7XonhNMVg
-6LmcAgliLYtCmrIXwI+QKHBuZio8q0xm1R5zmwFABaKz7P+thf280e+qpmFgT/f29PTNn8FaETchESa/
-sVlxvwAL0xDHMv7A06kmSv1KHymy/7cm+7P+H+RbvTJLu5ffaEbXuOcmZnTJAafTjYKHprNQMDaZxPXc
-bE8lNujoNb95XleDkAijWmSQ6CrBAmeuGGFIyv931hhcTMRUl29hnUFfF4BUb96TDNBcb5UWH/eJcnWd
-jFqfkxQC2lWxPhffeO41ModX7nwJBmx9dD3V5jqSsJyg7PxvXpuQFvo/9VvdyU0iZlQeIUkFS8YTKrPh
-1fs5xqljRUAo9YEV31Qhc6Fs1mG9FzBB8UBmMVVTMZfEwONP04XZ7hocHF/+7Z0pkes9KIcMiEZAwUCr
-oFP8JsmutnOhR0BFQjDeluqQmN71ZTWdU4EUqffrTRJjxR+u5uSCoYc4kOOxzPSnw+RqmoM6Cc5GWnXj
-SZ0nVxD0DwvVn7MDmc/ZGz4Y8DwnUt92PqcyU0U+1Rdvi4c6jFIv4PrIUWthNbY0IQOnxiqKtw2uQkZT
-THrb27PZrHNTdHd2OpkotmM5UNs3xaPuTjsfbx8eHZyenaDMNRATo/rS9xYsfTMt5CznE9bQ/8V6hU2b
-rLcgF0wsViXEWJFSqy/YTD9Dh099c6jf/oSBvc968p9AN31MWLCZv0GZJidzlDE8LHh8wmDSP8sJoHXa
-1BuQF0We9KcFFDIm2wwUuNTIm0z7aTJw9AXEwQcDoRTFUiEgS8TWYdnhhu25mfzZ/vQb9GyefKoFeCNy
-JT6uglBt5x30AEkCVb7BC1QdCK9B+Vu9zAc8zxN+JYj718OoaViGhVvwQ4IlUurBhG3qIbxIk+x66ffY
-ovx1ApFeS/DgNSh/S1j+kMRCLl8IbFL+vp/zwbUoRIyBZvUQSo0sjNWnD9Ttzv/dVS+bFsYt7XkGdczr
-K27Ns4Lf4kGiWS1aU60pb6oKOU5+4ZaZG/ZBldEr1YVgAEwPQcsbpuw53oAIXfgIlJzfbBsyoHd7LMKo
-hjKcDFxJEY7N1i+Vqfbpg5pIhTqShcP5M8GxHugUkrMC8GDE9lxp5gARnogFBfKDwuRCKX4lAqkqEzN2
-hJX0AQDDr3gBpd3sNO6zCIu9GRhLe3bl+6vr4ktzgKA9H++mZtcy6JVyUvgpHMKfJlJ5+iq7mPjjebgy
-1F5fnpwiC3BLgwl11v7lHVBVDcdBdY7/VG9HuqzgPUXaysdeKRJ38EPhXX/eVLgVbhbmgx/FXAUeD9ym
-kugw9qMgmSMW1g+KZ2AIvUKPbP2XBWuNaAu7NSWqO4y9cdVTUMeKtatdscu/ST0RkCPwOhFDlVsvmAKR
-lDHNQPNEXYOykoZptCBJFmOhQRukNs1cSb5A56pPfyN9Geheidke3un2ewxuz4KcQnmQBmZrfyscImPs
-8aMeO8WrEOYBouc7tw+79W/A/6zcET70WywCDC9XQEdDd20f9Mpv/Y3fEgcCtShnQotTyitsFlAs4Nzk
-4aPSpJohpIIrz/SkCWDf1tEEZk97297pS3d50Ii2wKLoVaxoGTLCrtz9vcrlPSYAx+mPYn5qRl1hNFaL
-gyoYW7JS882voBCJX0Dw2t3uV2w863x3c75W+/Pry8tA36L7nY30Xa1hmdG3NUwwqFmorpPJ6YQPnPFK
-j7qQ18L68wNKzvQT4+wL6i/9oAOHzt4ei4gLeAat/MZT3mPrG56S4ssauOpA2QlHYRUdD8jGWPW0ZbaO
-yioEe/1dPrdQ9EjyG9SIff01Mz/vBbYBxG8O7m+JwiPTL3Rq+sHDz+uo6ToykD19YS3iViPE8FMfGcHy
-rPyyhItwKA5odcrvs+tMzkCZt3iun1eRhJqP+zJdQg5GX+mG4kp4xnciFiSXydrEksQejVgt6/nkshm4
-bsIjtsf0cF37z5sgFEvti5ghVpahtQZmFeKRgeed5P4erJqcq0zDKsQHI9gJbbsT+rng114rj+7uobDZ
-XDKyIue+MM+HWpgteH4lwM4Umf6p6NENzwai4bnBlXrc83us9vcmUQqcIssdlDTXK86OfRMOVVeYtIy7
-Fez2q1pui1J+FNgZyix2Pc66CRuhYuDNuoCPxc3rWMdSPkE+4vV0TdkmFtIw1QGvkPDSdRPiGuNtl18r
-tAi/x6KLKDJ2EfNoK1pOGEJcv3KC8YpOUBnd2D7n7V8+XW4ny+9EAJs27rqAd9rPLrdXgLVUWIXqb/aQ
-8TrZSAPpWcbdYrCCPTOSz88X7dpgr5WdnkI8NpoB/ZsuvXUPu4Up+Z9/fl6BjmSwADLtuhqo5rMaiLQ2
-iwZLYlPdSM2HGmgt0XtHAWyTaI0lXUaGLviuHtHLjo5ws5Wu47pjsqsgWe+0n326vL+dXK0z4kXErYmt
-z5WWX7o7IeKJMHciGyGzSBhmbdYtJeUIL+meqqKr7+sA+DYqhcqYkTZqJrxzqzccbw8v729fJc2KN9ay
-by/i+9tXzXolAVWylzmL5bSfCvb3qXQs0LcHlNU+NgO5TLLC6IbgjOUpQvGurYydQkcaWNCDQh/4PuVZ
-EDFLk0LkPE3nrdKQoOEkl6A3F+Acb293Z8eHx438Ksli3uyxD6bAPvpXy9RGAfmWgne46XghPrOJ/e23
-MGo5nM1+BVnt8jSDiyZ9vfKiuOjUAD4o1DQtnMJPP8NO9wwbNP6K+PgeHCSaYt3fF1G0aO9R34YF0L6r
-sFQ7GrYHSrkTcXV0O2lE5xcXFxf6hMXO7rMIcu9fEZxNLpK56KRcFa+yWNxaKUYq391AQAhsw4PRXCz2
-vc/InOId6ERakataDti9v1ezZzUrNoNohaNrs26tyFhqZKfttXcLtWcXKhALLsrepuWTrTJqoCGMFXFx
-tAOZFUlmyiZ/rutcSyDLei91g4S2ZFgLu/R6gN7XmKAp0jpNi1Ko78YLDQdfyPQy4h5okoAUUXV6bdYg
-O7TP59AMqZuDR5qnHd9/eXZ0Am9SwSE+BJJFpVyNOs2yEmpd3kAxQLW8YQyOzIjLaCvq6f+gS7te2x78
-1/x9AX9fmL85/Hm788Q86NODp+aBwAfdvnkwpBYD8yCjB9w8yOlBbB4U9OCZeXBDDyzQ26hXnpmRAOlK
-vuTwv/x19zOe/gHZ1CW9sacjQG2x7uOmuZHSSKa/byQPv8BIrN7Pi6wYjCD1GJ8slJ7MnQdJuucsI4OR
-Y9xmCmM+OaeXl88XMHrYsvOJkEPm1CIGNXSQGHjwv50BT1N00fKlNrpEVmQO5/Vj/AdVwXNwS1i4FctH
-N54/ny2EffsrcKsqfPMVOHioCc86jL15f3qGGl1SJUPTratU9nm6RccbG6b86m5Heo3j0MT3GoIVNj5n
-2Oui9dWSa57xFG1fPXY64ZlzdzWe1wiDDjYDeNF5CnXh8znbsy0r56qjQ93wt99qYLY1jPOdSyMD33Od
-rJT0rQce0qvBjRM+0AZW12l3Ed3SGuGgQr6/jy3XIi1jQh3IKfiLLlxo6t5fY/jGU9OAxL+HoEIb3pI7
-QsWeF0qj5NKCEhvbZ2miIAYNgoZYJrP2bJQUAhMFBsRPzq/msGQ4d0fcNzKJl5K21TqV3WFwMB5pb1+o
-7U4hVGG4WHBxDeXJ7Qt1f/tqjGnpFtCsyRJkLKYe+oz4a6TDQDD0aMmH7ehsvcWgLspTDcSbTqIhHw+D
-Vnt7rN1trqM2lRlYX/Vu8Jb3Pota6AEBGyWwCxgW78Sjdf08PvkKtn9znw/rC5dSQDB49fkGZJmH2sUq
-hXvo0AJVRZew0MqN0hcYN3s2puIrNHD2TGzFV4ztp/o9hFh8xSAquGdCLbx9/qMt3DD1XNdRcU0+wjYQ
-0HCtd3obYuIgjeexVEU6Z6kYFkxOC7scwCT6YsCnpjaIRvZY3giGTl9gEzd5UK1jSyoHPBVgTDW5B0zO
-gqzkYMDS5FqwLTDkHp0ebLVsSPtAjoUyR7sZixxC8I+HWMZegXu2+Ps0ueGpwEhMBLj7ZAsjh8gM3e1v
-LV9Ga3KiJdreZmcuTf7R6UGP7T7Ra/Gy22Pd7i783NU/H8DPB/rnQ/j5UP98BD8f6Z+P4edj/RMhPNE/
-n8LPp/rnM/j5rMe6uzvYxY7+3cXfur9d7K+rO9x90KJI+xPNGmZSD/D47VGPPQRAZx+Pe+wRADr74eTo
-qMceIaTj9yc99gghvfqgn8PQT1/91GOPYOinRx+O3vbYIxj80avvfzjrsUcw/LevdAePYPx/PTo57rGH
-MIEX+wc/nr7bPzjqsafBsEa5gNxWZ/svegzG9ZceewoD+dhjTwHQUY89hlcnPfYUxnXWY09hJP/dY0/h
-1fseewrDedVjT2C8xz32BF6967GnO36nQzmF9JYH++9OXx8f/NhjiM/9HnsMME577CnAOOyxx7gAPfYE
-2nzfY09gdD/02BMYy3/12BMYy4899gQ+f91jTwAbUDOwx7rBSgwTcG5gf+2xZwDypx57Cp0c9NhjmPCH
-HnsKAF702GPEa489gTZveuzJEwPuqBhoSITYBzCcdyev3p59Oj04OdJL9BCenR6cHL9+/Qnn2n0Ig4Qq
-UT2GRIU1nXoMX/1w/EaDg47fff/+XY89QGwcve6xhzSzwx578AhbHB5/fNtjDwAJ0BpGik8fwhRPkEYe
-QGevj17q3zBTKnyjR/XQzArLyGiEvtvpsWfQ34/vuj327An+3O2xZ0/x54Mee/YMf+rttLODv/V+2uni
-b72hdnbxt95ROw/wt95SO7hw7/Se2nmEvz+9e/3+VP9NvX168+otPqCOPp2e7etV3aGRfTp89eHV4ZHe
-oV3z5Ojg1Zv91/qRpTxXYGMs4gR4nNIY2P/w6vv9s6NPeov0WJfW2zx9eXzycf/kUL+A8WB5C/0n0uX7
-16/tYneRaD++ent4/PHT8Yejkw+vjj7q57u44noV3h6dnn7Sq7SLDMd7iiu2233inx2vvCOMHBT1+ekM
-YXUOKzV2NsMvjUPgu9yk53bZCDQnds74aq4KMUb+DilBzMULPnS+gRgU3FsZNtwKHBI/8qSgzLdb12IO
-gftbCLrlwvTNG2Yy25aSedIQKKPEsjGYihnBIF6XMp9g3qlw/pQNhUbj+ny3f3q6rEMoHhX0duqSbRuH
-cJAOJ/xKsOnEgfazvnvKC/OR9dBZnCV+s9QBGwwzlrOsbqCHcpZtNlSXlvtLD5ZIpJAVlJ7JM7kBRm1l
-gj9ohH1ZFJRkIhjkC3j+Tx6nS3Ruhwn572pymtNwMduPfb/OeKsJyzdMcrHGTcgm7vlE9Wr+3UOvPO93
-/XMYtRj8OC1kzq+E7/j+zk7+Dc6dqWkf41UAGZqvoUaJveWnpz94aY6cxY0yz5I+JaWUYDxjMo9FDoY8
-1EygfnIgs4xSkk1yqacQHkmVIfm6M/zgFYWs6llV2jstZIuIhAKYaP7V9HvBvwjpYtuMbRv0UrZfozaL
-xVA5b67KGKhL9wL0behxqu8kqqEBNKvhuNcumyDc7NHn2sHRDVrQ+/m1mF+edy+bC7IELBragBfiSkLY
-G95xVze024ztscj8jtb6cn8yETwn/Vjk/lrva70HTaBLZP9Y79tTvWP0HCP8teZXwJ/QDBvZP9b79igb
-yJg+Nb/X+/JNogYiTXkm5BSGHDzw1BuvaSu5b1t2m/XnLE7UJOVzfMQahZxAqgU4H5rLNpkZzPzQuseZ
-FBG/siTuLST02qVuERe/LXr+mrPGUGaFarGBTGWuWiwZ8yuhmhHYXtbux9JB0A3mdGZIIJsBNGsVwLML
-uBksszsCWHbLbAbLkl8AzBHlhtBgF4SgcGNsBicgzQCcftOJPkPWkIWEVuWMdNWIeFq0r/L2WMYi6n3F
-2Pkm6AZPTBjNOfxiUSYzYRKbtCmrSSqGhfkN5zX8gSX1IkwlqA+u/bT4XrP4gs6pER9c//xxJKZ5oopk
-0LnISAcbeb8i3e9F1NNSgcRvnSYt4zfJFS9k3kl5djXlV6LnPsWD5yISWXuqLiK29x27gOFfRC10t4Un
-dsAXYbfQsMcOE4UBtdmcJpCLFGzh42mmT3J/2BYrMGClpmOMl7i/nxaUrgdgBN8Y7HnfgIJx2TfeoO1H
-KFXVfIX1LaOgLFM7Ue2w4tIdiIPSGEV9KVERy6JXQ6ZE0WLTLJYU6+eu/Ptp0bZVltrfHR69ZrkY84nL
-O2NmGIyVJVksRIzJ2P3SUv7MaD5fZhanomCzkbCJkU2VLD4oFAZ3677gkb476wexpuFsUJi2weD0TVq1
-ZyNe3GF8EZqecWjn9i8WPW33E9hzdHFuX4u52XUHJlXfqFooDO/Sce1+Y2To7uA/dgqJaTPQNGspNbnt
-+I1hCB3TeD+OWXf3qblYTTNQ2YvYj3FUtp5QAMibQqfj1BKB9gFVJZgOnOLUfbVBeee7f4pDAfAGyNQz
-mV1EBVQGwGAoLTGnvBjKfEzFAQDsYnCmQ0pyA6sfZs62taM11p1RoRl9xZghi2mc9FPR7os0bSt9YqxN
-GnTkwPWjnQu8AfVQvtZg5bYGSqQ8zc2v9yevTQCivVvqhgx67zB2NJ4Uc+Pio6eQSUbDhIaWpGOhrgs5
-aWeysNk7YSIbT6Geg2CStGCQylzS9O6/wkB6cFgcgFsj4+yj6Fv28dYbWMdPpzB70JH51fbZybY/drUd
-bAX8cYhCn8ZF0Jbl4u/TJBdKr/84UQoW3HhWTCGBNJSah+upAYtRinr3KQGWF5wjoIrSFBeFGE/gHVfX
-LjWjXgivJwMwGbJMDIRSPJ9TxVdTtoSN+RwySKoRmUN9AHr4QhUsGbLEMdwYjNsFw1wDGqN+aT8k61qG
-Qdxd3BYiU1hfg9Lts60Ab1v+ICCNmPd3Ib2RoLAdfGxJzy1/G8TdtSnOE6NZlF/1G93HLYb/3wR5BqAh
-CZ6FdAavkB2JWwpnzCRFT+JLl5+ibqQgkN9tpHpoxqUd8X16Su5BtJe9gUI/CzYyvqtbQT9g1md60zxt
-6K2jetvbV1J2rtJtnon47Mem3ypNMsHz9lXO40RkBVyO8GbUYn1wwcpF3KxBikp++afhBFJzdhg7NDlX
-Csm0gFe3WMZx5Z82Nusps8nidHf+Q/+//2ggskLk/oxQzkLh48vLecSlaUooqZEvJ1gOXpyyxkV0cXG7
-81RL3Pyas59/aHaYl/PcDD4EAnZvDxAAeTK8iOxyWXmiPeaTtsnprO60ZHSvgTUyfpNG+ebCziFimgKa
-O2RK50xN0OM1z/m8RbKD4IORXg70JolskjGqIuVEIX0QgNrSZGOm4C6vJwrHtscIi8I+WVIokQ47WLCA
-F6UBwVDKA7CgcjEQyU0onZVHAkH8yJD9ho5UPVpdQKwaF79u7Wz1ft26v9XburiY7naf7W61tlr2r52t
-1lbb/tXdam117F8PtlpuyBoMPH/09OnW58+eOAUFPtoya4vbZH0hO7x0E0X7ZP6RLgHAQ+Fsho6wWiUW
-RJnhWW/TyDM9AncMYMabdj9Nsus70WfN5quOCsAb/zslc5vpKS1qR9IezAfp3djveXdnZ6fFHu3sXIbb
-Jjrzuofx5FqOSDI2TtI0UWIgs1jVcrl9VswkE5i7zFCvcwKXQ0pZluB9JpXG+RR7cxkorLgFVSJ0h8E3
-SeG1lcNhGTe/T6Tgjd1Hj1psB/+v86hJeCmLFigy0HFwQ7mUcARuQLpNe8JTURTii3O46NikwUedoRGu
-zTWG+q1dK7/CUpk/IlPCJCOGV7JXw0o7u0yURg7ZKagxYspiu4S5Wo5mZWHNiyFfczIAZcEtSzK6hCKy
-l00IcmUumFI6lmB0mbOBUgQLnbIpEZ4dAqXMYH86+f5Fi/3p5OT771+8aDEtaXY6nSb84vQTSsJomAAP
-c067o5AXmBIuVZJNeF4YSlGFHFyzn7pdDapT3BZoqKqb0n/LKcxnqgQzGjR0z9aTm7vMKmbBkeG7vaHZ
-igHWF1QTITaTTqwLHVx0r5PJBLLeMTXmacrQxRf8BLM4GSTe7CyztCdMLQl522Ay11wdi7+sTf1Ol13D
-0/enhRzzIhlAxj6sHSjB1meL+hjaMQX07HimSrQJZ23k/22oFHiHgS3h64Vk5EhoF4gOGyxriXXUplbW
-AxNw2w6/DRH4dx7Y4lMQjj9jn3bYwoB/3V2SXbmlK/K0PUmnqj1Osqlq/yJy2f5FyvEdBNDqkKz8CS6L
-79Kp2oaiGNt/FbkMC1V4O+TV0KDdS5uz9GNqCa9BGlXs509WIolc7/BprK8VxaiChsFmi7FU8gZ98oFG
-t95YicGDqZVZSPDK0S/gY2/28CXM+f6BmUvwAUJykBFAMBNTOe/LTeUDVuGpTOXDmlP5YKbyoToVBzmc
-iuCqaHOV8KzNx/3kaiqnqs1Vu5hJLQNMx+vf/tbWIx9xVbB93SfbN336AQRYb2qm6U8PgEGlOTdiSOvZ
-Bn1rmyj0y4wRkn0X0iQOhR6q5dGNrg4SdGEy8GIkxrWnz0dxEaUpy4Wa4B0G5tXuzwvBbkSujBd5AVmY
-q13Z3ZWLK57HqVDKuXtgPd8yXvoyXV9/WiMbFXmiCl4IHydBAnTNj3UnbIaVhqAaUKgSQl+iNN7uoyXG
-2jKMbqjD2EuDQ8PfM5mPeepD7TD2dpqCdxK3Jq+6+WqCxa7uNPMqT10wa5wNCYqNp+3uI6aZPus+DoWr
-pp0xOBRm8xrcsCoyXHHf0hzt6Qt65g0UF0vPslN9GeAmlaERfK2u2QoowEhmeaL5x0JxoDJS+OALiSm2
-MCMMpZA4GhYnucB83VSJEx0kFw4uFoPu7l1vxDX84sTb1npkF5Hyddde+azgLqi311TLjVpSNIRyeHRg
-ayFAXtfurjf8mySXmb4R3nX0v0ZnRydvoh6LwODU3n30GO9iGJVaubwaYcvrmt1QrgPVCnVAZph6y7aH
-fJyk65/vJZeOaOtQ/I1/mLJTnin2RmZyq8W2jjSrlJn5+2UuhP7ZYltvRJZCkzOiWqciicYyk6jmKysi
-SfdJnAsGXEnvDTs2nNqd1bTdRy1InVKDX1dKLcnYJLkVqSp1OpYoRt3tLs6zIuFpwpWIa/WxYR/2PumN
-IRdfxsiw+3Cnxcx/au0Mrq872hlGciza12Ku2ugk+oX1uxr8trDWNldnzBGNraOLtlmoBmc/szc//bn1
-KP9wZmo0KdQQkHChua/9FOW6D2cUtcU9aKVOcAQOJ9diburj3NXnJeQO+5ANQA5LyTJlEAEA2Ve9YnIm
-9tmkgHV7NA8LPC3P5xomc43FINESgwdvJG65eYx3b3BfI0AuCoHAmVCEisLDCizGdGRL9Tlv/xZpd8jQ
-HQB/CX0OtfBiQFkfVZoGqj7K36oWWPRLxqan+/Jw51mnxifI/joFXTPzltszkvxK8Wz7adH+cavHtkqe
-0lst33qy5d0IX+vW7/ZPT+ua/KBfXkQ/HL1+fXxxkV1EW67NZ0OAY37bRiS2DT2sTYjWb451d3ZA1+qx
-0Tf8llFAOFRaODw4bbHj04MWe/cG1mb/3akj0L4YQtkezAjAppNgEzk5HqnNDl7ECUeWwnPRHupfX5ir
-DGR2I/LCCwIiIkty9lITiwtT7DB3a09FEdqMwlq5psirN5OCk/2L3Ga+hO6h7B1kfYGQYXGboITSoKCa
-3g1KTpXAa3S7Py2KDUyNnsBYdszbabFui+222IMWe9hij1rsMbkCvQHlFpa6xf6AULLqJWORAdI1abEZ
-Xu6KfO5dGsdJrPGP0KkrtDtn7Z+6Xbtm1s+mw9hxBrrMmcA42QTtGAjhgUXWhF/9kacbBf1sQ/WszU44
-O6cKiLVOueAze6xVTzzsLGhdPu0mXKk2T4s2Mv+7n3ilW7BP5+5OqHsDT75up/OsXDp9ocGA3M7meMHK
-pxnEXKP7S6Jvk7ZqM+87d8C5nLIY/bXsiSKVcSnUN48tNUugWJPUX2652Go+GIhU5LyASBL0yMlkYbt2
-invG9acsgZtMX99kc79IrRI+f8LLGBZ9n5sj1MCiQjjsJhnrHSbGfFBv77L0Z/FosrSRb55JoO6VpLdM
-0H4TiFb6JKegbhuZB1/h2vIa71nrx0a70gkAIO6BwhkSUwSEBmrAfx6lwZn7/0jt95OaQ+QGtOY++mcT
-G1EbnOD/PGqDivL/j9p+P7U5RG5Abe6j/xnWBsR288VlRAD7gV2JQgGVoY0bpmG6J1+ctjDhMZtfFqJp
-MWw/jVrs3P6Kcj5zYRiosrPZaE1XIKjFvODMOgRZb1YY4wKbeRKjERdrcF1gpxcRCC0XumfPXwsllrbM
-QJQrcnm9viTuwnWWmQEVxff6Ib2gps7mzPZZMx45LSbT9RWM3mCWiZWLRuMiuaHb0nj6PG+TO8iXwc7Z
-CAwuYOr2ZNixf9kjVMxGQqRtLYm3x9O0SCZpsgHH9UbRrWj9HDyrbwSdH4tFWnDwD9I3FBgB+fhDIh3L
-Kqx0jFiFhh0tBoHZTM7Y0NQmATG5LB5rfvfP2FiV/WSYVrDTQZ5vYymzO9tS68wa+ppwn70CwHUm1aJq
-T/W8GvL2QN3NuwhcXitRBuA2DqHXaiTQ492ow8oml1gOpmN90Y8ul0bY2kRpFNn26RM8+fSptyBi2X6w
-Trz6tK+m/X/3GHUKZ31fJGlSzKlEoqmrxOMYSyWq0baa9tUgT/pie5rZ3zYgnMPXmNGOZ0zcQmzTlTF9
-+BGv0/7ptL+gioLsAz5y9YlCor2EKvtuMC1mR4CuUf6QsEI2Fg+tHYkG9tYoyb36v+Q0RR5mHEqiTwcj
-EZMQJnJYi0yyTAB+oDCqzPM54305LQB5ZB5AA1VYnKiujLHs/61UZFvjHEiAfHa0AFbFX4fH8QvTICxr
-7dLRIoVSgmj/a8zgYEupjL3yEAjd7gMTAg81py9dnH2p2fn4EuPescumzflgFu/ULg/66mGohoDir2RY
-VYRFrhfXX6ty7m96jaX5phZxyusiKFJukYNIb352hfPBnEKvkeHcyGujiPKDSaoL4CVhtB37ReZwYC3b
-l5eL0bw0hUk9svezJLqn5/SBXoDzS5crsaZFZzJVo4btNNhB7/2Ni7E6d0P1tARoXXTvu5+TXNwkcqrS
-OcvFVaIKiJy/SbipIGZ7aDSXYt8fy2r8a3IHC8PeQgya9JX3dDs/b2r0KsOUntQSM6XSHzYjZsL2oAeb
-fdJbCwScsG/ZTgD4rSzcfOMqXICnJprbN5JqdUFkip6LwoKNZDdhrljDOO6bYnhsiHWOLbo0A8T9EDOu
-5tlglMsMVqxjs40gr8ULG6WDIQMCz+Yyw9CEQTEFv0mz5zcktomZnp5Up46JCqgPmPeTIuf53DJwpeQg
-4VinABxKgHl7YX7LqVZOik8pV8WBpd7MQ5bHNDTK0KPRVr9LU7eexlGrL/DabXGwkKTNjOvImepW+gND
-yrYjgi7mrxNVNBLDvrUoA0JnoliRjIUmD1ooWGKbmplAwok3EyyWWVSQn5k0kAYyG4g8Y3KaK5HeCIrf
-w6rSxC2ZI3UgXleVw9QHUKI4w5E03IjBKJGw+y49v/76PLlseGWCNtjDsIUdDiA1BeSDh76SjA24AkMC
-+qAoqo8wE1FOZ9TclHw1XZ5fdjQCeIHAg2o29StTHQrtaGDVlW8of2xY3st0Xm59WUrGb3v6ajmOd9Yq
-ga8GuRDZv7uIuygN08wkzqd8Q7gbT+TsAEob0d+nyS/C/XUmbot961ERlC6uFthHYdrmP1euuItvJtJs
-ASzgGjHi1nLrVwUb8yQreJKpIE82OUnZogiak4+4Yn2ukoEVf41TCGR1xSsepAm8ETl4hVFMQRxTXa1c
-jOWN/p3LmXL6HCIBP8UUTkv3mElIJZWK+MoGK9blHbMVa5yfP/QiM68PTQSxQMyASi6dm+t8+BmWezCl
-j3maipxmqFwQB40blH7ctJrxDCM5Raam+pCyxfZ5ViwdXIslRaTI3JwLNZGZSvoJ3XoQeQQvh0ypOQae
-6D9wbO7cO3NTBo9dZEQyK3IOXEkxkQ1lPhCU7hcPXJfmd5LzQZEMRKcDJ1gbABryJLqiNZKpvgjNJL0+
-JURTuX20qQqjY9HAJnky1mcojQ8oxrVgPIXU90WZLswYxqnpAwcAizjgOeT14AUiVgsWP5y9eX2EwVtg
-6MzMAFKeX4HvUgYeTXKIQ2+xkZyJG5FjkBfAGfHJRGR0oXAhh5p/eHWRUdH0ThL901lG7jOMs6GYsXya
-CsrSgSVOOowd8cHILKfJ6Z9LKHtEVIlFxmEqrOBXLLpt53IW4caC1YfvoEdDGZhI3YYjIVOTOVJUbDXr
-GJFEOwppiJwuMrOnF243ptcCko2CIyLwnVw4murPS8QCmaZtJmqMHkXnVdQFGOGgREP+gMCzpBbpKOjY
-pOAm9YK+kaqQGmu2B9WuSueG2yD9aL7Fxvw2GU/HxvcdMvrrYeyUxUuqxfaZqvLr1gfQGDSNBMXtfWxB
-/HOUmIrGlp+cCkE72lSHC/mqfaoBJBrpY0wGDvIrQYNMJEi/ihyIpWRjiPByTu0Q0xXHoGuQmjblLIxI
-J2g7+tjPZKHp6iaJQ+mS1qtUH8DDQ6n+PdwmBi2WCx63sfj1AOp7EWnCEtDF1XEBv9y8brEPYX32trq9
-bbAdxCpYJHuAMI2+G94nqrzur9xvv7GnOz5gezTKVOYt8BuHhEIiT5OM0qWDT7HnDWn6KoIjPdCWhKd9
-A61AHaN9bJoRHFDvEK+EBiU6owdS5jEkx3D94at35vT2+0PZo0HymZteJmPcahwTzxuu4E5B7C5RViqA
-E7TU54mcvZWx0BjNpmm6qgc14ZkRSjbtalE/cjhUosADz6MDOLb9L11Snbr+Sp0dA1DXnXc3PjH1Ci1P
-ASdi9Mt2Al5JO2gLcLgWn6utayrbECEgNzG3QSNQcHTYBe5S2UCl/erdBq9EAZ0uqgLqyEc3a1T2T6u0
-K02Vt0V4CidhR18dcYApy2VrxLzNJvsDBrYsLXpansz6c1m8AOtM527L9xFofHkVV2/BgskYW9Hig2oi
-ciqjUH/sDdY47DwYCyeiRHHg8eAlRXZK3JuK7QSFPAMeSB+w76itpwPVnQZta7/P5axF82yXtWMnKJq7
-1AAgn4fXG9iatBxJlQt4wioShAO0ASGAGe9EzpYTgmmlGt2mrZoUTiW8oBVy4smCpckUIzEOoqRXE0lp
-79rLTViZCDfftx5mvkPUYIyviOHrtfChFpBS/X4nLegOrXe41mTKBEnJ1D+t4KeMg2B19Ydn5nSVJOgt
-nQalrCstbC5n3oaoG/5OS3dSO348LdYcfu1KAIQzI61tOhFVmomqnQq17/DJJJ03wpcwN7VwI6b8y+xD
-C2eDbTiRk5WbENusvQWNt8a/4y6kqd5lD9aexKyNs1h7f9Yh7wtv0clUjdbcn6CSXWdfrjPsL7Y1aQIL
-9iWssW6y9lasWwcX1KMfLp2XpVdUU5xZhYWdjbmhdL7gKmKT0jpCv8hJl7BbarUmy/1DMKE6fwhlWKSo
-eqwQiVhTf8L22M5zlrBvcVAkPrPk/v0g2309Ctl9lhg0qvPksmrnJ/7IN+Duq1DpacEWsMHKueCzv6Xo
-Q2h1NLUG/yMs/7MPiRpSg3KtoQa/869ywFgU11HoukdN0Ly0f8EmzwuxzEYS2C+UKFStKqaQDHUveFVP
-Bc8VFO2DxLwJlX3VcGJecANzHyPZKfqRdr3MnUaQYnPBsuhpNKz9JlGgq00TqPmHtnSeYFls2VeDaS6c
-cXUFNzDIOCgrmeq8rUq3KL1ORve0XG+0RNWzrmLGVa0ZUFkZszUIPau2LizPgRnf0hma8XeSLBM56Oux
-fH99K6RYq/drRGmSYUK1YSpnURk7bpI7zxdglu63S1oY6HoePFXCupNo2nnuX51DdWUnUaQPbjRd0Z7b
-wk4wsCPTG7CCgmLC1qWtqo2cZXt7m300MQ/gDiAzOc01zYpc2XxwoMWGRYHEXpqLCd7SWwJKCyMcSKoQ
-KcjcSfHpIudKuARVHeNGQ9D36pS0HXr7vF6H23EfEzLrm6l5NjiA0TdcYW7QhNb3iomjD1BLKvKGfr1o
-r3RAuR8fjJI0bmiY5YZ228hYOI+uhRNZMeNwKt4+e8Pz64AzgsgHLilg+CW6EzZkO9M0oinet8eAFt3S
-KETGUEq3mBYeo3Mxp1sO+Tyc9k9DJsM2WrWvhWIJFHzGTIZw+gzkuK+7KWYQ+g4h77ZLazC3IMHT1NjT
-w/FijI1Nr+/XMIfsIkIPkwxY4aQ6jCFLAQubeQU56XhhjJiTPEGVb6gRt/ycgkLH46RAu1+AvRabZpCf
-CFMYTnIxELFxe8iFgQKL4zNEsHpLxiFCtjTqpXwSBvI6ycSxx2NW80olioUsEN3Jy9f7VA68Y1iB5yrj
-GEcOVGf13wtlG3MrgPwHaEkp3yI8WQaYaqm562CpInPh8UiqRA3E89is16ATVx3ITMlUdGY8zxrRvkts
-A+l6S8QhMyYw1zYOKwqKqQXeRBoZ3+0t0N6X+qbi1fokpCrDfciXj76EeOuBbmD5F93cu96JYUbwrZaH
-fk9nO8GknKq3euLUd4NK57qeaJme01d0xlZV0NWJUePFc9u0UzfJjU73HHdbZUnOczm7fB6eSNS2A8pn
-OFesTv0eHDA0k1JzOctEfmgCJvAIOxO3hX7ZiCK3VNC69tSyXnd0hhx4s3a0ihPZq2Mmvh9cmQBqJaV2
-jYDlnOf8I9o7TJ/T6/IoN+zH86gLZKdaeZjmvXT1Qa72+QpgDe8DDX/lNIrJQLrH7AiD6Ty3DWdkVKqz
-VHc0VLA6uUX0KKWjz/jTpA/V1X77jUB9R337xfEXSCuV104GRhimieNqhJ/y6tzfw96f+6RbHmM1moCM
-Ysbfwcss6gIpICPwgOei8BOPFr4wBO5f1Zvi4pNjng1ODawDAO376Jo3dKjmc8KkfaHJLuUTJRpl1Lbq
-CB6Z1kCLz6wxTHIxlLevIANLfGTug55368uX4B+JPuUQ+kJtIKhrJEguS/RNa9hiOad0JDzDQpGZLAwo
-qlNj7do07E5lISZpUjgxDNYP5b2ZhL+sUk1LARlRhzn+/eTv+u/voY1ufXj8hg1zfgX51KJv4+Tmu2/V
-hGff/SDSVLKPMk/jb7fhybfb+m2Ejv5M6QEBRsGbjSsVeDNgGmWzyeSQPTYJimcQ0Y0pltC9y/aPsmZf
-C6iiVzMaMxD4b83Q0AnRjAGcpDhmc3beKBhDjYNw3uKQV9WTzGc0CwVliHwBvZDtvmjD7HEVPNcz49ch
-chdNbuCh3wd61WqEpXwgYrwDFLJGS6cvzxq9n/FL6zoCmaLTpKgX1QjfgQOImZT+2nl74Azwrmn9JwYD
-uBcu2Zd21f39qEG3Aqam+SY46r/1GM0glZmA0xDO5mZw9aaat6at90hvzvKzZez4dNpXRU6D2rHj0mDs
-kEqw0EkZ4osKvG3SO+9rOn9rQTQtU+1MuOZ30AA1VC/A+99916pwXRuvcq88zXq4qDYJRAePUxyhxy0S
-nyYvcCROJhMR2xoSztVnkPLxxFG+7+O3lBDGfN4XB2kyIZesWs3QBqdnnSxjcY4wvl0iyXq+YZonQ327
-TBYYPwizh3Dh/rTAAkz4mI8nNuhgtcfEws4396ColZybNcd9nWCrb4TBQV+60Wxvs1PNjuRwGGpqcSYY
-b6I5Eawhy4UqIDylYCkvhNULrS/cGbezN8bq7C6l6AwCftLmuvjFkIWdGjuISWmLigOekzurwRt4BW5O
-iyVCrBHEviWoekxVARZv+JsxLhspURVdduqkFwricatvUJKmRjKAJPBuZTr1l6Jw+z2vUdP5kwrExnox
-29x1ahjWkttFVSA1N9qAIr9dyg1eBSruGcfQqSRwUHQZ+MCsI4A3Zt6GUFZKczLrXfyl/HE3K7ri7W2v
-6lIqOCZcM14pxvmCdm+9Mq1MdsvYxuda+zRllQyldq/6kFWrOC91T7SHnRd4/AL0/5ZTFI9AYqw5MhpN
-os2ESqdjGYNk4qlLHfyMeHZIySaEByqQKsmmE3uYYSxKISkMIJ3bOB+KTavoWjUsnGFw7tXo9sJg2QXm
-YnRRD64uRe7ORkf0Cy7ZYaMzNCq4B6GUdGcji7U86KMRAk8APcjXbIETmEl/zia5mAhI6InWOigTmF0h
-kJm1cygT2Ugh/KB3zmMfGHbAR4JDdHqRjIXhTKrIjS+nkc3oCWDQjPkt2Dz0gGcjXrTMjgYPJRumCTWf
-fG5gHaLTFAR3hKWxRAkBxtPBaIEXuZEH7u/ZMZrBvJYDCOsdjETJwmYvY6HioU4jYjg4WiOMDI/Dp7/G
-gmsJz0sFJLI4XKcOgoG6F8l4kiZGox4Kf7wof07vQOI0hwSO5diMesXh6aizydpWSWGUVwEsTym4vc32
-WSaueAE67HD6WDJ5oUv8xGSYEVlsgJnpuBAPSY5rYMBg7KOI9PFItOl5kDhyLhF0xymE1ALTXjuYnwms
-BYf8RHmgvGI+A6kv5YVI52yaQRxm3GHsvQmoaHklCV1UMIReQALKIk+uRTHK5fRqRJfavqtbJTOv05aT
-/KKCTZWwuMqIXqXCi7PCbDRpTLlgwKBk1Fq1tlEXAPLbb16gZ639LBjyyuZeRcaVbWcDYKUr2xVJKg55
-wdk9NJc3nSC/vc1eCDiwNC4GIuN5IltGYAblzSQXhUkiipyOzfSl2isH5YBRydbU25egpypytqfp6L7+
-GcQhk6BjOLzeWGeQV2ePPQinBv/u+Y3rEMCsqtYeGkkq1mkH1Ai4O9SsG2Or1vrGLRpYSkP8nmLpt75B
-cyuMH6AgZU7WSkuG5Y3IFpyDbM/HHxyZ9zWq66O99bknczuSmbBhiwM/gM5oXpaMBhkDneXe0JZaBeyw
-FnlReAoEBN7yYZd1CHWXhmA0QHRv19Mssz0W8rPnFbyTQDJTwXUUZYlMziBkGcuQWY0EBvzTwYOCrZUx
-688gVjmBQjtX3Q4HRwihnAOBdyp5R84Z6eZAvKS0iqSYs5U8kqx+UNXjbG/PnWdLqLNMnLUcQG4EUrOT
-+yXAteS+CIK3p1fdTh0IX7lGRUAdd7u/ESBPRWfJqO6i6wtdrFbzcRaulRGdZrnU8irkOTAh1mblUWvs
-EyaC6ourBNN2ynyBjNTCGyMEbMd/44OAg+nLCP+K7o8azwmIh1kMfmMdm8qiutRaGiLnZzeG+s7ZYCQG
-12SjwUQ/TCFLcAKLeWMsUQGDKr10FqzyV19/HWomVu250vfeKVB6E9AirvESblY73gWcrHx3WNJxc4kl
-DdQgItCVrHBYwkvKWnydAPuMvfqptQqKmWPftVNeukGWMy1Hdkuo3SM4MF5UiM03ee4tOK0ckfmtNyUw
-//jzbNbuaS2bXPB+CcVVBr4mtTki+B+kqqqMsIqsyAsRffOxEKe7J2GaSJ7NzUXJv9aMRK6vGUk2EJDF
-w4C7kuQH5qSpisrKoXGVnR3HWu/NU5HnNFNXcizQ8EWHOZVEqJE36GKIkIwoiKaypKgYXCEvDnohT2Et
-nQFQd9UXxcyPtXfGs0WH391J4i5spiI/LqeMFezG0yoeO4dsukBWFIv1XuP/FysMS2ktFuoMx/z2NXqR
-1TtgLTPT0BXegmh6W4idqyK/tCbZ2VLt2waSd8WY4tj8MjuJtcXWeCftAdkFcnyivCgAqwfhLJNtOWnh
-xX1cMk+5kJCFzCh0mlmuDpwtPHXhu1ikohAHI56rxhtejDrjJGtQViK3IG4bBplPEOveDnsp8xnP4zZC
-RRUOefL6aU3W2nRn5IMAjiuQEYAYIwKPfYiJwqInImapGBaQ1iidMz4cikEBGVzKKjfBFB8L4wxd3YhL
-ImeCxBVy6IMuJI3Olb02Tgz1Fm0EAtWOq6rgzuKMBOUxlLSKNuUi4WrpFvdIYEEU6BJ72/M1tce+6RZe
-rmXAZYT2PWZpk4JL63hMDXznw+AlFiCM7jgvzBuXpcAo+nleIPG3tNj5mrJMhAZF9vXXfgQT8z/byDPv
-C/iReFfd9T6lFbtvg6r0p3aqGw2fMi/sBfNv+3gzcrU0Kna/5ddfe/1+/XWIxT33LtDXvZU+wYOq0zAG
-U5A19zTaKELl4DLY5/10zop8bhTqANDuXWBbYZYizJU7S2JvlznJzGR/C1SsNY1BECX+DvocLbxB6kzr
-QFfR3cGDDQSviEVWuF/q+GMBL3D8WUCWGFRUJpUdq4QzlNCtuVOgdnGBfyfShwd0j+1oYgDE3asyntAb
-eIUfEiOHXudhSoPBV7KqwnP+ChXpMxlS6AY3rvyZzNog2pkrRMkpg/wHjM783h57oKd2b5mk4fsErzTI
-srJ+vqzkWObSu0rpcrf7kKd4WzKupTYGjyLXHP+6V+KdWjWkRjUs6GLvkzW96VdHhNl+lmnIbaO1JrPw
-Bu5viJI2B2idzsP8JpTlkixWlOnmp/bJ8UesHgmXDS9RmAuWh0Ygs7yn8gFqOpnIvAgvKGUJC71JtSCG
-vYDn9lWSMSV4PsAUcS7fjhzaWC8nGiEILRcRCEpiyBdoKw2EpUmb9CXpVJ9EJ3L2qTZ1BEkCuZyV6drX
-GbGa950RV4tdHzw/JYwwqNuXn72Fk7Ng5b6H+GaTlRGx73AAB44JqjrFFVLrLtHLkByqS2FyeYP0TpG9
-fsuVKNcf1qObXMPIurO19TxYAg9pxrqmJ1lRpVGwynor4K9BhbeUuEc/F/zaz8bsrw+aPio5ylSZYLMB
-eHkZQqXzpGYx4Bx7S/a/K2HWxAIY4lWmvEdKreSQNKimuCflkYTkyiuX66cTOdsnUBXH62CL+N53oF41
-NuS3+hK2t7fHIhhZ1Kwi048Jcad8aQtgWEoNfr2gTPCzQzr3Lm2aSdD5T00M0jfeHITcImQ4bo8sX0AX
-IThc6kR/7DnQB/RRuSi+u/vcl667gfsxKUZGjVTesi1W9b53kWr+NazdNZcuQ5YnLkaxTGVOoANg3hcL
-YE5ciOeOxzD8ru7tMY9/2A/urxRxXKDjWkxFi3wlRnIXPhNMscJr3PAtAv3lSjJ9mtUs0Fqbhw7gdfbO
-oXF1v6sMEGwJhL7RLrIMcPk28sa9GMTKnbU+YtbeWLhSa+6qEnMskbSfWaWeHkrfl7vxrehrkVUAa6dp
-mHXdhlyQmagz0OLqW6iDXJOjyFMnvbXhrt4358llcC9yDfEkaYabyds1fvziBrzA66NZOpv0Tq3ZXDb4
-CbJHI7G4TOCUTabu+PojzyZL4bbj2kME/GaLPBE3lTnU5A/6zM5mkgnMH4TJjfwbRBkJGgNiUCQ3AhPf
-L90yeob7WYy7efmBZKZUFt41X7bT/W4dpu+LMr+P7dt+2xucNl/utDB7uYJFf0NbvH05MjbXjz/8lFhN
-zzat2L8mOS84BkJavjsPzZYyT9NC0+THO4WbW7R/uxdCcltgwX3g9N3+28i1ggT17DBP0pRBRXuSfq1p
-GxKo6I86gGJ7+V6P0PG88ygdvy5tJmeUDNtf2vaeJsbf2sHUy1eX+pTl/+oHBKacAwWJf3WhGPPyaCqf
-iyxmR1m8wacn+u1nagR/QG4pGSfDFftKiQLaV7cRTAIMPC0E7DQN8CogF+9OsvjU8eDaa0odKHLxLtll
-QQOZxXftVmSx7bQKpr5LmLZGESxlzVjPdy5bNdg472L2R/v9URZXOoVvKw/hSz80+HbCs1h5WRpAd5ij
-f/37k9eV6pk2B8Nn76NT/3MBMJfTBbZxny1O5QCXTfcsQKHRTAFF7nm5Hq6I7PaLxo67smK7334jxBXS
-1AtBH4XG9oXabtb3EFyLA/Vko/6yTGujW1kLUdMbSnhrth3BB15upDqJP6Q8/36w9r+60f1uSMfOa8lu
-PTeVPdbuLthzf/R8cYwii3/XXC2U0jyDCVRnSYHQ6AQDLuXIvkEXHP0jYjJnUScyTnITXowUG+bi71OR
-FemcxdKEXaViWAAcxtgei85/vrhQFxfnFxeXjeavn7/9busiurj4+d5//un//MfX37Se9/6/y8ggGZw4
-6Nt1Pv1Hx/s4yVQSC/x68ceX30Q0WQj6xkmSxwU4hlTUyP52AtWzlbOxymAm3k/O5Alt7iAhg961BAgM
-8d4aOABiWJyIq2nK86PbSS6UciVfTsTV0e2k4TB6P5jlfbb1f7YsIORVIsYDdy8cWQdNJY3a3hyNhCA0
-lWg+FD7+Ltz9NWQEmIVII883p+Mh7GUux6sRFnSz9lYo+30RuGYzILIVGN/6eauCa0ecFhRWGNwL50Rc
-ur4bx1rh21pOQ9g+AtAhe7pfmR6AOd+5rKwhfF5eQf3w24AMy6tnQsRRHCKhwV//lg/MyES6c3es8TiG
-zxvm5VplJGWaanH0f2MpSb+Ia6lspFcecp9FN4mYaSREDEpEyiEbJrcibo+w4A1ktgSObG7DEKPkUldl
-4EM6kJN5u5DtQZpMoLa/EY6iDxa+KYcA8YImTpUXWI5Pz9KEYtp6fInNu4RZOm3O7XeYYiuHCmzdnZ3W
-zs4OfIZpS3Qrr8JeQiX/dh+5So08TSkgxbyK5dj4KueCQtpirFvnA4NIaK6uGZUGPPFG40Lh9MYOpiMz
-FosxZHqitEq6F6RBodeZMmn6eOC54EH+ULNWWJRSJVeanEzyJFwTymdZWg2mCj3smcyvVUuDEzciQ5ck
-nqb6oPUiMr3VxeIVOEQ5HJbyzb+VhXCh0j91u2wsNWW6bm1uGd2zcxQud/yVVznQ79vrkrFXhXNWjZPh
-MBlMUzhHh8ktEpYmU6pmRxVENYrAMT8r4LYJ4fP6gsqLkUzlFbnsQ3lHr2tNocpPZOUvMnlvOTOqqq/A
-WPArlvGxMBUYO3YZsc6nMkF+sOn/Pk0G1+mccaXHTN6Yea5XlIpIMtyRHp24vTlQlduIN+TPwZbZt+no
-BnwCoaNySDnrbKFVbj1Ec04hf5j8GndIyXPTbI3wIms6xMtKUFSax/ELTK2MTstNdw54H1ImQ/OnERhP
-P+5/H7qWYvW0aVYkqU3Xg3H/mCuM/E9Mc6pbVqpQ1t1psa4rcHd4/Ab1HJBNWPM81x0BB2cbO+5piiO2
-teXoWHP3OK9DV4jSXvQ8RECA/5hPKFkxVufc+86moAnquBorfZzzGSRhMzvZhNNR/olc6BafGk3w3O8w
-9pZM6+iTCFXIy42xqRfOQJEFehQHfDCqFNPbcNgzXjNuG1NoY/RKQzfjMe/LA/r1sxkOuPzEU4gRQD4G
-jK8gn+hCbwLjl0mFGee6LVYdTajyavKLsH2mXBV4ZQdZqJJh3r3HgnGVaoP5VJjEh1PluEkfUhCZjNfE
-yjEjWUaBIZhquvOV8+LHdNJ6Czv3UjaSqmBwSVKFIoYc5/zKzNwcF6aOOsLTovN0QiXpIWhH0yRkv3dC
-VoWojzLo/JPNmOPiSm0RJHS+RG7peKR+Q3ky8xbLRXsiJ9MUyuLjeiEkSG0JC4fohLgQh2moTEn13ILK
-m7TYs5EQKZsktyJlsUgLzsbTtEgmaYKHdZLp41qJ7Vgo/IUgCgdCTQScfYRJhIio7LBTIbRYWUx629tX
-Unau0m31k0izv1tMAZCP+qM3tmc92i6O1pQ39ckCMEe9AyHG3njg9NcUMoI9ZBKGoenCL3eaqFM6uFGo
-L6/PQCkoMGyJAersSqz4LFMkJWEiHLjpAWDf5nJ2oNTJNC0xADOdfXY1FaoSU2GqAuemwjRImprCcZfh
-6nqToA9PzXew597davw9Dnv8OBKUN1SwQZGn7Rt2LealUt601yZcURoor6ciTz+80y+CbMgYjZLcVPa5
-qbZa3t8HGuUDozvmYGGBZD1yWrARz+LUr/GKz5XHtOC57MONIq+8ODx68f77T26En60g/y6Xt3MXrY2p
-YSr5Z2ejZDBCRqcK0G6O0NA04+k1xHahTKsPPxCsTApEZ0gyMgCmVJEQzmcAD+RYkHNmWRopn3ufiRhA
-btD0iLb5anVsvw5zVeTo1OtJ7Xsvkbx7CKF49q/SRiTeVC46W9SJx0hD5sNEQU6ovvAz6uVQRH9uK9Og
-HEvtQnmXZ4ORzBEareNQDqZ0pUn0TdMcqvo2OeU5zwqKNe0Llgql2sWIZ22Zt8XfpzxtFxKh4S1raKKV
-TwyzwLevhngFkhkV9Vaujg5ckBQpdCns4QTqPb8KBAeE5NFbXhXmTbreGqh6B5U2pHtdw18WrJKf2ulf
-co2u4HKYr7FMZvr/+guFHdUvE51qFsK9vYD+vMMKTkcwCi4DVBkqxCpyNpGJljG81NFU7qPSz4GdW031
-H8z0zFmaKFgIcwcwxd29jMWuPJa7Kej102AS46DOBwMotH2FJSdiMSlGbXyFulHDJY1x1Xm7Zi7iepSk
-cS6gco1zgV3GCj1jkubgL21mfp9HojUYmG4L+9jP55XAOmxQTu6/wH/WQOlAXazjITW45zT/Jc/Y0PO2
-4hfQDGIgIBwOVjGcFFnJ7RSeexb8/KZZNpLnN0EMULYst/sSc/jpPBuMcpnpqyToHswJCwK0J/No6vCZ
-UVAMhuu93J7xOQi6CA9SR7UsZZV2psu8jUGc2G0LdCyQ9g62gIbI8w1pRQ+hPi2xEumQkO+LkLEcV0WM
-EYeLpB4BFNgBKVwLB7iRtIhAuyPJnLBtxCmTd81kExgmVzYbkpwWePnxbkA2b2FQ9kdfbfByZ5VTW8i5
-t2zGGSOlOBDYAgir0XQ58of+UYRtTuTseek1+ft5Cmlo6cJkXFNrQC839jNHQXPLWuGMCXsl+3UABpqV
-uhSexb7U0vXnEgJthFOAsgyl0GA5RmumVo/QusktwmdpemV01q1iHT5r17AeoeUVtMVJfAVQWQ7tXInC
-RG01mlBS32qDPJVYRRSqP3mXnKW1x59zHWC//eZNxWsV5nL2XtRa6C1alyDRebpT06+/ZvcakZGawEhg
-X1qXRh+y/V2Ju/D87+n7+io6pyVxDiWjwmhxIfWKueZAdZ0aO2Dd/KyvWH26FbTeIbUvpmxCkG1Ygx/z
-zqLHg2p+LkSO/XgVbqDh70ONndgKzECpALusVoL+1s3GPAsJgjgLEKAXrVkD6rvFoHweVYZUMxVIqFGz
-+L4gVM8W2Lf1HMqJOaVZsaqvXzhYK8eUU2SfBXIy8A+UYYW+8ydhqgm6u6gsKjy/RQ6JfQAarLpXS8Wl
-rwKO78ellkQzSx12SVrsvA57rTqquWx6IuI925cR6bCiTCZm7Ahp930mbid4HQJqBqEKVL8WduSD9Ea/
-fE3vujJ+SpOQLdeQ6L29Ko2iyGlkzjMtiXIWJzemUEmiqgaFeoHPT6wB+UmFL+rFyY2nKSF9V5zcuDMo
-GeZ8LOhxbbwxVeJtRNjUK0hK31K+04FS5OViyCPqQ3aiHtt57jhKhMrHHuvu7PyH/9z4ZvYY7yuZTgvh
-vwXFIn7ksnWbwkw4EKbygZYt/6QJ++XLDvMyylstOs5B4fep5DHqfDXFC4UfsqTwq/EWrmisubphfyb0
-PhrLX15lmcjRPvAT8PJZksWaGetuSL7iGjZc9NFeWsy/YqyMylyL6tGf0LFIL4kfxu03bVbWsEOj/Ahd
-d3gcH+mpvU5UISBZAypjo009wVB1mZ3A1586/STDkTRd7ZpYDgyn8BWodaMz1KWpKJaDTl/G88UUNOb5
-VZL12M7kNqAVNANXni+iLY96/MfGT7nHRkkci8x/h6HrPayI6z1vz0T/OinaUyXyNjKXHtzrg0Zj+Utd
-i6bn9ThP9VbVKCjtM3iF28xlH/ZyYoDVmf36OTIoHAkeB3QCn3kEUtLr62+gyelIiEKd71xq1Ou3CmqL
-13xFK2Qs1nss6qdycB25PvRsD5R6nWTXn+rnlSbZtcc9/A9K5T9zkUYthohQeoxuy58dHx43NE3EvNlj
-pzLP55ieh0VoDPgU0ZFnj8JiBEUdVJCcDKFBqhVyRPib6jD2ymb5mySDa8ZZX0BmfbD3D2Xu8oSLMSh/
-bmQSu+vuQKZpogKTGg6qHiO3bXztYYXalxEiU6ExoimhL29Xti94H3Q1+pt2t675og1HK9xjsL4+TQ9l
-VrSHfJyk8x4by0xCTpdKC80keqz7KNyY8OqG5wnPinaaXPFimgtV3TiLNrDZqe15j1T8z22UQfu2bv9C
-RsY2jLHHJvmiwyToZFroYxxHxe4l44nMC54Z6rPcqsKSCav+1Y7wXGXCOPqoZdgqnuUltroSChgtwWZZ
-hgQ2yQ3BGQQcHr95owGf1o7xLpCjgZzMHaADOZlvCgGseg4E2PKqp5BbnAqAazGP5SxzIF7IeP6jmB/K
-WVYF5NngIUsmTzIvoaUxxKGBM6eSSsbBBZScC/Z6nNx4+9A2vstOdJISDGPdE6325Kocb5WTC1vUrFR5
-F9g5OUTaLKEmbbwcDslmCz4Q5MKBrHMyR1nMmVHlBK+tL/jVYg4KLdp9fuWNMfjyLihehscqZ/E2fl8W
-hRwHw64ZkSsC6FVxAXtE3+RnLOSEDTXKONQJSMktAOHTm1zQ8NCbEVwPv6JyFukclXwG8+D+BSo+HtZp
-8C6U+r4IPpKo407n5KzhewqascncjIXAWK9IY58x834p03jh8umJhAsHzStrVlmrmq1UoUgDrbI+Zkhh
-n8GqrIbugQpZB64J3Of4ANxklGUcULZ+wFNMtUZOGq4OSqamY3CmQXA8TZ1DIF3v+9P/n713/3LjNvLF
-f9dfAXvviqRFckbO5t5kxpM90kja6GvJ0tGM7WwUrRbsBsnONBu9DZAcOtL//j2owrvRzR5ZzuvenD1r
-DRsovApAoR6fWi5Zo61mB8Si03MPBs6RgDxA6GIinNeVc9JQcktdM9oYNwoniGBGlQJxQlPpwtRDKval
-UTQLgbmDfE0+wssKJskIOliUhTyYHTVS3bhhDHFpF2qE6nyFbD6AledBLSO5wvpMOY5Fh1P0uBqBNxR+
-EyOyrfe0yQUZw89wlnP0hdHz4dxqtVMO9YXGYsMmc0J+RNdTYIZp1PiKSf2Q1Im792ua3cxDx4RHDaOD
-rgSveJv7E/N3/ED2KIYMKnYrG1FsjAEJxzmzKIUgP3G+sQb0rZCQwBV8+TBThMnItlUiNhfSGn7Qw9K4
-+1DMZ7KwPrDzlFSP5nznRw5yO6mYMKrKyrz4wWe74tKQMyeWHokZgOo9KdmOlYigqP2FwGZlnOOsqA+6
-h0CE37ljPC92aVQ2vajfXdnLRbuP7ff7+f5Xc96sTr4+PT09EbsVvG12/n2lmohE+NtNWQl08O+k00tg
-xxr1ElEkHs4ftsp23Yn9ahjJ60idU7KljH5KMKviP5thTbAq12poo+0y3Phn5C1IYEbRoYusgbGFl7oJ
-KWW0lludyBdK5g7xeonQwOrM0AGefMOUVL9fc8jS7eQ2qHpNmxXi2yX2qaJKG0a9OfQrDX17hXWS8983
-++5t5D2t7FPG/00vSX17bjX5I7ysQoXJiNc0g1U67eqmVto8zQsJHuWev2H/2eNT6aDd+QxR8/28qrfS
-iOzoX/faVb42JdryO97a2u0wNO1r51vw7bFvWc0u6uW64Rx1BXhZ2xiJWqtffb83gzynaj5DQj/Qcmu9
-Oy6vroInM1x36voxtI0fvtcEIVfOadg9saGNeapxXsv3rtevIPs6LckOOqIasjJ/OEAMWVD/e8KWdFui
-37QFGNWZCTU6m4VcPKqBFkw+c9PhqaHdJE3DPodufJ5mYhnQsX+YwLQEkSQZHL3q1JWdpouwC+exmWUw
-GQSndZYAcaiySz8WwfBfz5StuqYMB+XjSXRNUMTjklATqOL0Z37aCifPRazt80K1Lcsp3MEIMmJIZgJe
-xiWnOYgDqr1CQn4+U43wBkJZ1ixkJ4A9wZy8NgJAbb+6LguWew0M4bPvUXnoz9i2KV34vP3juLJx3bDl
-aEpUDd8s1a7mLK/OUuUrvNt62BYNP++tNb93NeL3P2rEx1jtaOQY5+H92+K4QGUPZcbtp7/9fQh3N8zL
-gncXFocUj0fbEL1tAI3OUybTxYe1tGLycZjT7y6jidIBDhlXT2v941q0Kg6eS9fm8w1dBcbEQv0woE1T
-EcrfrU0dweUcvoufhrSoq6nSd2vPg2GwbYZgR73tetVNrSHtXwYxCXZJ7a9ey0H4gvsjBuDVYUcgokHM
-nFHtuNiSMMLz2qY2tk8ocz+E73oodPREXjEdHBUvoOdriF+QyIrJy7JQT0J1TUY6dLuL8PwzYi+Ea+Ef
-aMHUoi/8btLP90WXqFOxb+LCVM/dM2ey6RX5ChAXXU6NT542A3fVc5oEUzye6BH3jcdF+XQOaFjvMETr
-bt3DxhNIU4FbqH7f8zIX7UBxE25/Z08Iz/uuv9P2Vu1ExDKKMftAjTr8szuKk6ZdbY5fJkFHL0vQ4W0l
-pCKDnNu5Cco8LkU1TB1FkKg7LQX0hoUOCNX0e7pGBC3Pf9oLAA62FpxH4H5h4wkxmzjEv0P8JtWBo/Gb
-7BV4Kt8pKNqsoXkPGSiDOy+lYNKPJe+LmDYvVD37obK5M0baXkVrlm9L9gZmIHrhPq+WvNnEKAnWr7fh
-XHqxD4D3wDeQNAli9gM9vqJn8RoqTkperVij3paFxYC48tLjaSu8l9SbIgDCsj02AKtCjhlPSMX30Jje
-duoZWsmiYeUB4ACYS4kMESCFJHmRayURaiQNGoEiU7moHL8HgQ+P83W0WeURqgJZAqM9MuDZPGBOcVPU
-Qk8aUrXgA0B2qd4/yJzofaenQ92vCww5h0ASjURAMCFfdDyvqSALCGvUdhcATLdaWRd7u6Yi6mgvixYV
-LF7kIpbOuWRtIlHshJ+OyKRJ8e0gPthjkAIznYTFQ+/V2raufCpeJpU4lKP3IDKn1Q7sHvUbG75ur61r
-9+vYovHgmBKlHwcfxh5JT/2k1v8H3EZv+F6894tNI9rHX1Fmyz/vWT+Xic6EnnrLnYZd82M/4rjVkFcE
-k9f4ZWwjAMbOh1LnMQIn+hQFE7gDBdzP1p0SMZsjJYbR0gXi7N3OY9DXRPJofdurctLF61vygIzqW8/G
-0qXbacs25oL7Ob1fpXsfiAU1bQR7Xslxz1jCTr7U2Blw3uiOWQdgh4JRVBhZ30Ie8dWOe5QJr80y6b8l
-NyAacGiNKt5saDkixdLcsHxTSJ0jziHdOriOj+RRBOCh7++jc6bbvYzQQOzkuV7jNJ6ckO+2mwWmXC+L
-ijlDGN2xhmp5xY+Vj6fK4JBVQOfV8gVQuSAPT08tQti2VF1R9dRX7canCo1Hf0j8L+16H/3vn6GmQy9D
-8QBgVgKdl0VeiTRgXVbUoFqngeuYiStl5EqauZKGrvC7ebrSreSeP1lYSD9jW2WMNhJtZtYtUXskGwgR
-SkRNwcK1oTfe1s5omW1LiDk0VEKopqIiz4qGLfltNHVXNa2Oz7pq1Uy75XOPu0cj8qCL+c91fEYbRjrc
-SDPy8Jw8eFDYGNOgjQcXBMFM58uGb9S2v1Ty5MNfTfpaTg12XlQVa35//fKFErr9Rtps1fZ6smQmLeqP
-qWDqZLnbdOKC72klEbcDQ7OMKXPNyEKT7WqtfaGNTvVlli4fORj/ITJseDPlKP9olALebfDhAxhFBvjO
-6O0enZL9GqJ4pj2tUgT5ZMlpLclJFz/ogOR+j3hHTd8FJyGf+nbHPiax842u3YqgWUnDH9G6IAz3Na/P
-owZa+v9WA+01SNfxyyacGzr8XXcrbxA/cb55RjMJimbnYeA7fZwfa6LVOd2EE3eccjcZqR2jhrV1Cg2b
-bTwByOYDDsHGABAgBzcEd/trGDtaEfS9h9NWZscVfC2xsUvVEpWBH1MCzdixvlppq5lrUzHMGgmyCaf+
-tSHiKPY6UvaVj90f+6q0jfX+QwYhcbz81j9itPIIUq8utqsVpJdjhOY50fEgBj9PsZTFw4JXunE7NcS0
-Og+1G8Z7LmVu88JTdCPXvCYX9sA4WhwfjF6NX4Fx9PhyfQzfF4JJkhcbVgnA71J86mlLtUZAya7ocQjX
-K9W6AW3zVYQ8EvpZ4pz/hqgMe3i4E9MpOtERbDZQ+pNZ2/SeucLngY09xA0Lnmj69S+e2FG+93ZM6slb
-bxdlIWwCURsjRf7iQQmdoW7ro7knouHj/zBwHeq84ftrjgs/hp8Tyi/ANxtPLKyFJRDr9/Djx/QT2SY0
-ADdG77FpIR0Nqw86qxLz12HN8a09KQOAW7IEsp6rPbcJ46OyDmXPK7x2JwcKSQB0Z0UlwE6jBgEIpmDB
-NORfgDEqpNp4FowOxGiDw2fczJYlh/T4B7KEwlzDseFOs95jO6vmudS5wwGpdy42tJHPFI0nhVp3w2Ct
-0Uy7DwPPaZNVJOObeivjRynfqge7ZKuGllos0TCNwrxOXRdFeF/Efe85l4KegAVHa2YzRhZM7hkLvIR1
-/yjgdks9cfpH9ZIR1gt3wciG5uCGCSeWAOdyDPtDZ03z8mlYxpscdyJ6OAlOCml8ASujYCUllYgCmDNc
-VOtQb3RuidW75vVLaNRkCou+4262RVrLSGbteXZ7IHJrx+GZm6G7K+YyuOcUmIrMqyCBdKfqNpHGNQHw
-E9J80Dqt9TmbUMV2pHD+GAXntm+cwMpnY2vagTTG+0obR+NTIxZt4npW6GhvgAfxsPspqce/B/MB1xPK
-5i/UlyO1FUMnKytJYtbRk2M64egebJ/SkFJcGOHYeLg3jHru/4BYzberNYQ840IIwnasOQS4kDEkZ5dB
-R99pPS7tdk3G3SLQV0PikxP9GqCK6tn2n1I7OBQGEVCLG93k+sK3Vk2I2IEnF6EGPQpc5cyT5rk2N2+Y
-XPMc4KPQ0KUxUDF4QRg3apAGkPKaCi0TZhgH8RVptpUHPofFeJZtmwFueIGoMsgmgQ18gj2iMW18ki0C
-awd2CAs93GWE0KPyn4otDEv1fM+NVqYNhmqEM7Nw8ILBhotqNYXHS8PAHX25La0OT1hkLOthgDjwiFUJ
-+WOMh6Vt1EHfgLlWyUNb8AeAeIkD2dPDnJAnHGAzuBaGlCS0bRaGmCWCUUBZqd0/tzWhS4kIfEaOQrFq
-WVKxZgITeQlpkgYUlYE49/oFWPFCzgl5XgnJaA4TgBm1LCoagpupPwVzkORKDABgeyGpWEdRODAtJwAR
-uZWiyFl8zcChhwYIi89vswAKay02xbV/7J09AAy0dvp1BPZ++y4W7/1T0iKUqO0eAEQlXjtHvDM0quxf
-3dx5raWOqIhX4rEzE49bJtBPM5wOe/r12oc75/J8KDo6FIpRmcfuPRvPucmVHEvfv7tIXGpR6rIqPBuq
-zpjRhtl0FNYjI7g/WjtI7d0TPFy8bBmOdhDeafM6JFxlEFxUahxBUL0tmNapeVktcCuCHKKoeZeVHcUY
-I60bIQkGipq37JTQGxpIuxNvcBWX05CQ6YSj5z2KoRbEwWVNgbGZuoPujrXpLjZmOtzs4Smlz1ZFzB6v
-zoupdUz7pyBe0MJ66qlzEOND7KS5Y3FzHAfI243+YeRvTJdmLj6BDCTdhw++j3u7gAfBdUFapPUDwEO4
-tC49LpTZLM8Uo778u4/huqlZIDbYFFcO8EDn1k8/krgdoKl1T7FBwP6AXI2iEqyRj4H9wqjhaVzUETem
-ngTeWTyjPj5U16Rq7NzjU/rKZNSx61T4+19NWjQ1iZXzHXN+/iQNkHoHdGTSCsM5OSGPuVw7H7SBw9Rz
-2TtIKy7+ksNsd2QSINRC0ohiA9JZUan9zPKCSoa+CDg+/V4ftpL3jvWlc9xt68vxxfJw0KCXA3Zim2po
-7+8xVaX3YKQkT1+P3dgJn+WGdOR/4RvSiODhOMbY0YxXefcl6Tz0kvekT8+/KiFhxT/5Tfk4wIOwl2Uk
-anbelxqqdejB/s1FLMQeuy7dqeet0tAbExaw/8JURcJd6rtzpo+L40gYn+1mbIsbfVNor0e9LIW/+xO3
-htfv5IXROkCHXhse4U++OQZciZ9pcPFp/IsP0Db4f9OF2N5pXd0ZfhtamscvQ2Aa31SjxG/AFnLniui/
-Fq+9c54Ksd2YVHeBAmACVOMX/wSh32nZMJoftOYR9UzusoMioGxXPGDuU3eBNHzvgfDirXwPM3a4X1mV
-T4KBwsi8+6th2SErmfBDIQB2Zs3i1GTaQb/mArqCPv0mUaRRmzV8f4UZQY0Gr2LG+FosScUyJgRtDv+s
-V6ivsGllouhT4PTlIfiWsRrjxTEAPWdCZ/EFtw/IjYjjRJhhmySQVWCgZY1GKQK8CMCqJuR6bVJ2LmlR
-bhsWgNrjPvteEYLIlIC+o2N2qT6KvFKwsVvl9OXnlZt43iOKpUdPCgwRsX3XZGDcI32j6lwcXnOIbrxM
-3diW9FNDkgU73epbUu0AoiujBjffa9Ko0INe+H+1QzyIJeXFtgdnmvken6JKJlrzRq41oAuB+ByhfYBW
-XDv8YERYyW1WaMv3Vw5fv+MQP4+rPDXQ+8kDOlSXqgknbaHJz6QBplLsL7ulG8xo5IPe6a1aSNZQAzo0
-LBbHKHlhAZ801PofvKRyPd8UmHcy1jIOuKyPGPdQwLPOu7nX8um59+c3cde8jw8e+IFCjad29lTWD1z5
-KKOMYnzvSWP3GfiEjHaMrAvZFpn3zpUA5RlC4aA2KfZs2NI+AD5nMltr3S+ksHYaa7u1dRXfGcig75d8
-Nf7yUh3Q1UgSIIYpnhSVM/IleUBaNAlZNIzeWM+fewOEMt2FKfHs9NALWVRbFohZNi+Pm/cLEoPnO6ww
-EyWnzSKYRxtQv6Z4QdGSVyszgz1pd3o71N6z9++3N/KQLref7SalVmjuURf71CCS4GUNd4ijtWeYKA9T
-zRJ41IQ3RBUi3F+FYq2djfY4Pmlq9NnkT8zT6H05bFpYlf/1JuWpe8ykpuRpS0s2iHkDZP8rp7QmrY9P
-I0kfHceYdy1afepJKs+clvkCXzPwOtLEDkybNIMTBdIbMpr/X3WyIKOZjHQYTQvoUbQkdGFQFAkyCObV
-G8HBwreNNlC/YXC0GHKFl3jLpmLzYoxVay5rxV0mOD29P2dyvalNMGurqU85Ke+6Tv4gg/eqlcwW29UK
-o8t7n52pQNwW1UDZ0xkhfK/7zPDu9NDsCTBSin8WXK4NcOeCrtANWh9XAMluH3LGJdsDPDEeBYiHa4Oo
-AvdFo+dNppxUdHQYNjVJnAVmZoMkE0FWe4PHS5YFYudupeeGraGwLeDxAKgGz3mgz9cmcNjvQJMKC0XR
-P36cUBe1tk4iKDkJWMFjgeNda5Xr6V0PzXYH48JtjclrdfIE2ADasQfgJ/jSKiSsX0qsFNnQAzpTGVdU
-Leob1673R1ca2jJnVoQi4eaq0y/hrS5mJYJ3CCOBbzdvrM/gSPMdxS1C8QqSZRbaT7YVRCMxWQ1WMbsN
-p6hYepoS3pgakLGc8AoY31bx0TIQadAzTdgJ05Jn3fBVw4TFXPGMJrnJm+iF9KjeHJ3q4HqIpjrST9is
-lpad0+4k9+97aWKrHscTw/L+My9Z8K1dyJY22K/bBa4RXFLhhkyxz8TtrYARzfl/L8rz2QbXpGUZgFXv
-Cravh8Xrq/qPyjIdbwBmP3iBR4dd62UcJF46jZQ1vQ7Sw7XBnRSC29r0OS2PnE6St25wpxsK08h+7dXs
-cX2K+CXuThfEh81ppx2RApewbr0AROuGNc8TN0By3oI185tNLV+HXWP46nURCBZP9yK9dkEXk8vom8h0
-6faKRG0MmaDz3hSULg4tTjnpZ+8zEcUee51GRditZJXt+dT0NJHK13PCa7kxpvExNvS22Gw3xiXdRjAF
-sBMDYLN4Wb6kt8HJzQKAjHE6kkFGnumTwHNbdvqH9zp/zzwS/c1CvFvsAg4z4S5fux30xQb5Fpadbqk+
-RgfEArHmo6OB/19X1/pe1Hkdc++2oWwQ8th1Qcpjnqpp38jG6fnu5A7pAtB4WWLHLKnewMp7/QscUX5J
-b8PINs1uY4tb4XrwO1cHb1C/c/ZTeBpavBZX9MLVa3nId9VxVYYhhB1ltTCnx9+E22xc1D83w/WfKHAe
-2yZnnxixF/LpN+S0zZ+nvzhfpq8Bq04xzILunV5sWJxUPYi2iG6POTnybEAbZ+NBO0Igv3rIf4X6pK/g
-PSHNgTvkFroOPOzTME1gjgGLatcEn3QvYucl2po9cPX5+5g8PEKGzN/jOOyg21nZYGEGBqLkngA51Ju1
-30PyAEzdpQcOWhfhY+GaHGdBNlDQ9ThJYSS8jWNSeOoIewEaH5ynDQUFQL5l5lCF9DQbWm1pWR48s76J
-FILXMsUZp5sNlUWm6R6dRZtALiEHDQzUtsdEGF35hXceBvHb1lerHQTbrqPjuCd+DuNC6GlWM0VLwWG+
-fWUJxC2OF1sZBrHBz1D1i8k8oKdzhLRz12Aeo2C2CRVB/iuka+k9r3T4EUA0gt3QBJgXq4oHeI16HLTK
-SckkMUlSDSnMW6Ht1rjLjCFhik6g4LCQk22tCXogFvuGQ4A6ptmxcf7gBUqDTuPbw6JokLb7nS7hhbyh
-ykGDH7ikhCnUg0kEOlsAcEZGKwC/bApQyxfCZaTSs7Vm9tqETIXRtjOEFBNk1MwlvHxYJXUygvGkRdbm
-jHKQmVaxBMwEs24s7K7NQdsIchzGW6n3MJmlxuYfKT+67/654s4Fw6FFOFtqcu3Z2+o6IY81tAHcnQ2v
-JORdsmmd0lFsxtdHJzC0bPXk+Q9Tn6+xC8YTCSw5iwPJcVHCED7oHt0xjFsFhi+kPe3uNvOpUww4NSg1
-Zg4rhs11p14j37C8JaPgnn72bOoYQl1xYbJL2MP1VorgglTvUZKzUlKru1fkRjmTtChHZFmw0toJkH8B
-kBYPtbKoTRLJulZTCCnCimxN8qIxJgVI80YqtqKy2DED4YDZr4JUHcY1BLtzQdhczZ8Sz+K8neTfyXj2
-kHxF1OSojk7IGWFzWNMnqvZ/qhMA6XzlItPN9L7EMOKCNe/9WMI4ht2TTpGWuUmkL2pKT8i8y+tKpt5V
-sutFxWvnVBl3z7t6XqI3meu5zpFHg+N8qhdJNsVqxRot8BjE6VaGGE/olUrcDZyRw9MMd/4efFRoJlEc
-MCi/eD/INWvYyO46Q0xyuzPddVRTrRFHnpbrBmL5BSeX64ZvIFWWSYWGvIvJ6nascSxFCGuduS3TiH/s
-+ddwcOCZK1er/aHUVhCxBUuDk6xBeBSSHoQnbhszuyJWN2yJCB7ei1Tjbql6dxQ0beryxOECKAkzC1kq
-iipj9ho31wmKFmrsQUI5I/n1YsS2budPv0pdhtS/1zv0UvUwmmTSwUZqNDPJZ1lZ1AtOmzwa2vNlCpwc
-lYw69S6YV53RFcLJ6cF6eS0VHxaV56brHKAToT2hg4yW5/WN1jgsGbg/i6X26tsUQmBIvYMEt32U7Fbq
-hJpqMKTm9bakkgnMIg5+hGsbKMTIl55l+0vn+GDaUOQGLkLPPao+3/ECNRx8pwD2T4oujeNlZgPiQcjX
-5oSPhN6/Vgx8X6yl182OIFmpXyLCD/9ph3Q7LxdW5Y9pdqO4W5sErK/HkdlM9gF68RTCjYb1gbR60OUu
-G1gtIttHFPKEBgeRiA4+1mivDbDtPdPn+tBl4QII4fG9wETQt9YPyMNpq78DzH3tDh531x0enTLAXNjB
-za3w5CFBbNFyeq64jpWhtwOZORF43tGNTo7u6Q1J9KXbbTxY6uPMDdVEOpTvePOD7KauH/cG+9IMZPd2
-z6ZHtvtQVo/710G3LY4+4xnI7VqdiWlitbGBV4RCpqzZjtywQ873lRHrqdDiwLNnqoyeCGZym5qns85v
-jBlqWe6roLClYU5bvHrM88O37PCE76vUhexuSC/1V3z3qm1yw5RUlQK7Vo+6IlvbO6zke9Z8C8Vv2GEu
-+Qv1wyUVnqJvzKA9VerDB8LmGybpt+wwIffve/UvyJe7Lz1/kCBza5D/L5DtbNJefJLoBZJB9gPUo3pJ
-lQZMJUxOp1ST7lwaWCoBIOXhR0E8jVWLAV3Qiqmdc6a/+o0hEOHHSYAtlSjhPNccUGiYSpEkUahwVgXg
-U+qUt+HU+rw/J1eS16iwB1keH1O8pisKYVNUuOcjpDFDp29ow+XwNpONTm1HF6cnOW9ivdhcSF6/Np1C
-74QEsKnLk2/Vthuef2ImEItJq0PVghSDksruHIPqWbYs+f4/yQWBkuTfidGWkjMysrnYoyEEarSNVajM
-8VFBS8kaQdZ8TzZb7YentwRWVEcbmBIgY32sYRw2XNTl8B3T+pwwK5XrUzj2hBKIXHgjgJGq2+3V928u
-n5Jnz188PUMt48mfxQn8472BUp3/Waii6sWBYSPjbEK+Pn34NagLQEdRbDfk1RV5tJVr3og5eVSWGGIi
-iHpwNDuWzxWN7wWzcAoCgy0zHZiyUmtUqXP6QCh5fPVkBktHyiJjldAu44gPqigtIbhR8/qL55dPv7t6
-qp6KbH7v3kjNtpBNkcnR+b17ZbGYNzJn9Xik/gk5NCElu/rrNSgpWJWxl7SiK9aYDw3DDpq/99nIF+Pg
-tyUmdleL+C07wPPX/YJ5nIX7IdGUT9AyA2OVq+Sdt+43gDZu11U71mbE9Rr+4RqyUBh1Ba+EbLYA9G6c
-Ra/1UpOspMK+3R+53+ut4mbJV0yuNXxF3L8p8UcA72XT/MPTU8hwpYgzOK8wdZuGcOebGuD+DMNZ9qZl
-IQ+ebqpBpD3qIeTCVTcr2Y6Vrrd45AmfZzS4ImJnNyyT5QHjfKsCX/PG9VTwZqqf/Hr3N2xlwfQ4Dl83
-BDp4O+FzQn7P92zHmqkOQi42tDl4AI8QDl83TI4nRjsJ6X8E+eFa0WIio7WSdv9nq9gE/eKLasdvtPGL
-1uoeaCCcPh5tYCqGqSZ7Ksia0V0BiUKWJVCFHXbJmwN5SbOMNg2v2EiQZw3dsMV2uWRNyAXXr568Gjer
-osrp5IxAqnfUeEYBnEZfA7FIjYfIL/RQaaOhy0Vd0oMqvC8yiFPfK1agQh3NVU4bwP4tKo+CUePgZadb
-AGb+/74lY9D5o8fyQa+Qlw6ASTHpS4FUN1ydG89zl3m9tjuV6K+kohuGql2doE79T3NxPjUmFejeSP8R
-ALHb1YpSGdnWfTnI/BYA1J2ckOs9NxdMUanJyjy9pWY33H7vw2wa8Jun6oGbq6KS9Zd2EbBfaj/jL13W
-0eCedd7Pfid8CiVXYkDF4SnlkpnCZVn8pOYWKz0GFhRGfwl5xkGLC0xkkL7zIgMNHNrbanXJ6DRdc0Ke
-oAUaE+igdlcjye05qBbzQtBVw8DuenJCHpWCY4GiohnYbzSUvkAiGAiF9z3LdZB6AeYnQL2GrQkVkWBy
-jq6SOU5OUVZ082SdOhqpdhj6x2+F3mR68xAZ4S/Pw7tfncetRdW/w6MtBveFCnOxXYisKRZs7AD0tb5R
-697ni0J7wEyOkrDW6MD0dycSRnLXFFCiPUrAarA9Xalfy5/uvNjpa8LgJqNIbWQfP+NJvBtbGXA9Gsyz
-wCuWxCPBBTmsSr5QF4giZInADecnvLA3onoJ2EsRQVSLpWLBNRXVSEKiSbM31Psc3gGSE+qIH5hE60zD
-ZoKBaTlnGW8gh58bp/E9JhdkX1Q539uf/HE7OFvYosb45avxIQVLkHSU5nnDBGQ1jvh1QbMbAzzx9p1p
-6Iru1ITRBVGPDcfjki7ghRSWBqgJ2dDsBg20KKnos9hRwQ5DYk7WUMHyc+uMcf34UsPQl4zCEVS6et4Z
-Txum5rxhQvIG3bGMnQxOBnDrYC65gK54rbutGLPZMn86f7hGW6F3Nka4LrDJiRKgbTgSGCuU4ABdBsnF
-egLAM7HKPXeUjG82tMrdLO70A+Oa1618wOYb6sxTjP/k+Q9W02KkSHMgYV/mXoST4I2Jz4kJ2WgfHIBY
-KwnIzEG8C7HQlSrz3mYkMVer/3X++MWry2+T7UAqfd1AsqeQbD/u6iV2b1EW1Q3h1YlidMCfUftoI6Zg
-+ds3hZSsUgKWEiHU8y+D2+GgJKWS0xwyJvqNPVYELxUh4OaHp6dT8vD01HL164bNFvDW4dWlq/HeWLRM
-ngsMazYOHDnX2gPeEEbNtamnWPdePcuKrMCgf+jR5vAqbMOdoN6v6YNUMD1khMIGzOpUzxpGczRQokCg
-thBdMTCTITHVX8K3st6iPe+GHYRs+A3zozGLqpAFLYufUJzVmD9GaNvg+8oOzKX6j9ZWf1/yhvV9x130
-qnoFver8/K3paYvPmy1DY6MJerZ2YlQNoC6oYerW1zc9LUu+N5NkVanekcKoBG+RN1ALo0lpKdy5Yu6O
-BStL9dquHO+pnx5t84IfT2lHVbGRu2hd1blg7rk5HtUNUxyunp10K/nIcscjdYwGnVEn3VJJcJBOwl0j
-cCqvWMXUpZSTsRK6DO5SUR60cLXm+2oSDOU7j96LQsjwYvhRXwV7hq3Uqi9CqLPWk6iCPnnzLG4kr/0G
-HjPtpulP9mV4/OLlZ1+GRMBNBqbgJ08vry7d9ac+aE2Bh/sdnVmgMlsUUtijtnUyckfDCX2asCfKayx9
-sLHbNC6Qn1BdefMWYn+rSz9cx69U96r1dtxOBh354boted1ojQmcZF5V+7tPwKhXQjL/AaxSkuevsBNL
-mgWKIh0cASJX0eSYUogJWAi+lYTdqgUz+YMQcRDsTZZdbdJaHZLAg17Zm+f5q2h8esPD7p5lZZHdzPKG
-rsz2Fy5+o7WSrFICEmztJw1dab82T2bA24PXh1eVDteMziNoFWTlx1spIa7MP43MftCgSxBmbxybQOJD
-F5UFW9NdwbduZVSPsOCPUM74idiNgD1TnGStZo+UbGXE8WAEDYPzW72C3o9/czolX/+b74tg3F6M1BQA
-6jP5Gt/I4RsaMlea13fK6OqbCszdZhbxDaP5YTw5Jx/DB4YfWq7DogNhRaSe+b4sovbQPUJAIjkjI/gv
-9O7x00cv1Q9PH72Ev7//7snTNy+ef/f0jIzsv0cpV6P4FvHMAHiOqdPGuKf6jwgL26cOkq1gvuOXK2cu
-V2qdCLwMJqgIwx7g04N49rV7OpilldYkOUu+bShYhygOw/c+euJJ9HCi8iX5jc3lSG6hhf7GJF38qLMR
-/aYd+5xQ/7Qj6NVVF86uqyWiIHxfh2Rya1AheAZ6PPXchaNU+g1rvYWHm8L2PR2LFVtuSwB+GXVuTQka
-hDzjzV4duaKkYm20W74CT8fpYxBu7sL/QS3md8CsGurW1NIr4cup1uwvgN+14zfMMaruj2lfNrQyQaXC
-aomPLK07GoJ88WY6pkG3unRu9o95g0A245M/nZyspmQ0cgZJ6TR60iAe+sACS+EH4uMP85yBnkjnzb8X
-fAyulpaxYBx103P/R9I0z18twNbSiLE676faHDqipZytmpmSIkZnblJ2IbThDvBttmXpwxQBBgzdFSsq
-eTMvabXa0hULLdLgu8yq2VaM/KqE7MgFGVW8YiOHXBQ5WNhiYC2a0VL6Ze8FdWByDzXjSwJ9HSGzB40q
-Wru2wTzZcrt7H32Ixi9O/musvn4ALwRayg8lW0IXP9jOTv7XyVwyIce7ySRJ1/jHGUY1ws2clvI/mpeI
-/bAzZuN7br0Ue4qaZmxWiNmGSep+6VjDZBuPTaXn4iWT1P7Z0apu6y4tIOEOeoJVuZjt11T2MJ6aaJQp
-P/xmtijkB+2eO7thh+4JxhpHpvhKtf+jEggT/dvm6i6fqQfEDJ5HHX1Um502B+AsuGPGJ/9VFouZsRCe
-jf909WByEkB+0ebgs6XtXOfrSTRZUmLp+J9voZyvmHxCJf2+KVW7bx++m3Qz/cCekJ0jEU6bfhHN/PcS
-zGHv0XL/PvHfUMm56X5rBfPif597b7kLdSSsGlpJlnuHCLrnHGsjPLhOTlQr7MzlJgWv7KBlDYjz2nUA
-tLc6U2lITPvxqyoQOYRYOw6hv2FCSTR8SSgGE2GmUjIGp3sqCK1CgryCN4VRAk1QJlMXASkLIdWzCDUx
-zTaRfs6jFJJ9RPb0ANpT3tyACtFgCCuxx6C1eY9mSvKClnzlwmo8avaCBBmGki/xHST5TM/dzK3el2QB
-TxW/dwbFbU+batzNd2AUVO8tC/AHxhp46o+60/mNhqgBRpPO2+hODGzeSMkd5bRTM1BOHjt9BYNj3VNY
-jXeT806axYaujt4Zgf3Fp/9c1e6lD3aiTyUPNrA+6kaj8sktvNYE0q3gFYvX1N0vWFsD7pm+y9XK0rMN
-rWfm3Sb6bkUnkKln7c5afPmSvAKtxCSFJ4mb5XXwkPBahjx9VvNm7JpV90YZoQbkTJXwLgXfS5/4kpNF
-FM409NouEM9oQ1blhtZWef/D9dwG27yk9XxDa/FW1X03h4KuQUu5YRkrdujptsOy4fENFd+aYu/UcmAx
-91t7W5PjHQKrzhiod92O+OyfgZlgwD729OrjL75ocahPbQbWhg6a5pr1eORR09ADuX8/WFYjPr89fQcS
-NPrRjDqLPQyKhbMsQ1VHaMjYtST+lvR+d6Jvd1Oye9f7ljg5Ic+okNpO4/wEaEVY06CpZ1hbniUmZJV4
-dYae2ZfOuNQ+i4DIrKYlk5J9pvOh9TOwxMBTI90f78SgwF+8+XxHh3POw/+8xtZ1Lnb9RUie3Vx6n+cZ
-rzJqgGPsVvBHaU+OG3ZoHUl4KBXq1U8bwZ5XcqzeHedeAUWwEN/R78bFRE1qQb4hp/iP35Gvf/3rkFwI
-Pzt6Xu1oWeQ613lRET2pOC2qRw/ISM/R2xt2eBc0HSPIRlNmh/u2eBf3Ao7K1SKcvoo3G1C7Xl5dYa2w
-NUWsWS0m0Yp2LM3bAoApV4uwc/G/PrbeaKGbEAQ0OMdFPGf9NXaPeEsh9mAaTMPbcvVhxqsZGteObeBI
-3/7FF/ENvxVsplXPM1Sjz1SdY3Q7dOpt+qBWn1lL4AycFgY10a2QT7Qim3JWl1sx2xTVVsx+Yg2f/cT5
-ZrBMpCi8Lrfipar/R9bwP3JAgUq2lA0aQED7Uvc9SW83Q4+huxDEiA3/zuoSKi9t6fYBzqiQMyoKWs3o
-ZlGstnwrZlTM5J6rG2K76ZJg0bd43rAVbfLLP988MrUTY0TT0Ay0JDN1MDS869ltx7CT2qL0m8cFRA01
-vEwuvSa+4GWXIsTNy6HKHvMyv6JLdiVpYnN5xNQsLEBjdoxs/6mAJFWzj8TjRueft8O4w9HQTygegg1s
-nu2b4jhr2em+NPV+VNX6WshZ9vDrwXSfqNJJckteydmSbory6I5SC/iMV/IZlG6tHlAa9K5jUpFJv+KQ
-yoYjBMLP7pJx0RgscD0LvTraHVzzDZvdsIOYaVfFoceGqvgtOwhrm42XQpVUwme16hLhEo9JXQHP60ie
-+WI3aYlPkTA47H0IUvB49FT9R0kjXk8909AZeXpbY/Q7CnGj4+KbbA4ppUh7fDTPH+t/jz01I8nAfjf2
-I0Dv3m8lR3lo/pHIvqG3M7QazIz7woCNt6G3GLV3ZVwe2isOSe6QmWjDZkv1r8FLD5UVQz1q2DP132QD
-kmpFhVZ9D6cuKSgonqLjRoI2uCnA1TlDPdyQ3foycjNo7dmarj5tf6mKvfurpkLMaCln+hF5R6uWEo25
-uHUurs7EtRWsebRilTSq/pc0I6+uyB9O3IaEZ+YLJsmjUs4ezue/1RAuPIAsG0tO9B2NXqT7QmZrgDjm
-lU+p4tVMtUDEQUgGvoSApQ76CPPi4jWrUOWh3l51bXruxkQuyPgLNar797UqA4tcH2pI4TCqeb2tR5P2
-xrX3LxXiUSm/w+CajlkHOetvO+1KDvtnm3c1pv6JhwPgbzvxL1UXfv7Ef6ZJ/9lzroYzYM53dzi3kOgP
-CXpa9ThjVcbzbnlIX+cn/zXeyuXsNx8aup/8r5OJNYL6j/xQexMqGpa8IV/GbX7Z1sWgMRXackYzp1/w
-r0GrSX6qySVGqcH0eDWzbrvDNPeR92w3XfQMHkrUeeymKS5oM9Nu6wMkyjgAuS1S+mCCsw3fsZmLuR3c
-QDvkN9EQq/K/Ki8FDf4MRnJv7yHstC6WcoYhLXd82kNVzOcVPfB9lU0zy8RROS1SA3wvWHMpnOwKmS5b
-3jzzhtH8Cr3b2xAFfkEwOR4elaWV+dW6BX5Kukf+bxrrpe2rGMIMi8jpSxjneHTaQwivalmsTDbLMPgJ
-ncs0au9fOlyTPva7ZK2YfA2hAV3Yyt5khAmj4MGrLoi8gKghsmhodsPUswQBEzY8b7nALTgvGa0+6kD/
-6zXTbCw5waicI/5jj00jhm86QQaM0/V8EVeBkklEBIw/MQ6CNgDFgKUd+NZDdhBMmniAmjWiEHJKIHbL
-wQTivE1JQ3VkNsUEV+g3aB0xuxwFsTvXtmNDJ8mzZvgzBETC7EZ+RA18Pk8EBmmchihQY0gN3uSsiUqn
-wbajSCMcLwXYSMRkCXhdT9BR1u6YiDaD+1NxhM0xKASxviE/mYd/VMVe45+X+a9cwkXVlbyP/bs81buZ
-360vTv8/IOM/brFoJ/Mnwo66TDFY9Twdyx0rMJ1zvDD4Tskwpmm6G91h4L9YQ53eGaiZM6Pv37cuhqiL
-iyrJqlzfarCDLBwAIk+kg+UgCoR54R8GvFS8d/EfX33S4dDDLO0DIp6+5A5yU/8Pu4MivWzfDkoE5v2/
-HZTSbd9xB3Vy0d/dDuphlvYOimc1hOmFuEad29coy9Hn0s6LDpLGfKTbyiaupRjJAYEbzQrj6Z3QkI5/
-0LQuAYkFd0kIvNNud57aZrRZofYZiETNO/xobrB0DKGeeW221aXfu2AHut+nrm2XiYJVu6Lh1cYDH9Tv
-mBWT45H3eeRQwNHvx6/6xYXxDxkBfp1PVSuwYL+EzelYxAQenA6Kw+XDQAp/KGbz/cUN6cyb2Q8fyMhz
-vy74mYn3m9dbsR5P3DevQ2f+H64Er57eFvLMn9Mw97PGjlPEeT0OPDDgw7YC9ixLG/XYckdBgDpv3rOS
-Czbj1YzdFnI0iR04tAINSo1TTq1+3qVU4/78Kv5JJobTjrjFMjh1DGKNCFCR8Ncpesa67Lutg8JItP0c
-XYjX/oHff0xYzJoO0BpvXM9xLqDnQcCqwb9OPphdmJiG8A70pBA8JRhDjIaDC3BXlzYCe+v0wj7dvsGH
-C9YefBBX21rf1v2yYvKJDgcfT+YLnh/UYts5+d6w5x1mpaf3LW6/a//hsPA76GQljQnUvVKXahi+Fft0
-gigFSlTSZ6oJjrXkgmU2XbVZNgj42B5sD8CQ7mlg4hsDVfofSX2LiDJMFA3cwLq1aTqlYpdsheMIgt9u
-HVBpfQtxEjqrQ32bOsKd9X8SJAcL5Q89X/WtO+VVuX12KcSbbemS40Y/63f7XsdftoiH+bOw2Ffka8/J
-cFTfjlpYsv9h1Cv60Gktlj1Q9HwPkDzaU5k8S/x9Y+clPB6TvUNXjZ8hGRlniTv30PlYJF4Yl1dX5EvP
-l+TLO+/o0JWjYzsnGEp3K82Q2rFlMiBIqoOjrSeKDxuV8mRqTUoqpj7IxNY2oMOGn4LAuJUcs3eoA79Y
-WkSLw4BpjG3s6bTKC/OtNfKEZT+Wykzltkt2F5gA1kAhIgHWj7FyPSbGP43/8PDh+Z/EAy9wDkwVquaH
-D4rA26/R8/yyeXV1tD9fn8cpebtK/qp1biR0zLzMw9X1nNlwWVtrqijdZVkDlkuvqfNSS4vZzlnPzp9X
-5YvICjzgTRw06P44d/WHO9YlKCQYBd/vflI7/1DQ2VwSqVgwzUOZ36nmyMyVJlAIswiKWW1P5ux/trQU
-Y0PfMaerYCY1tJnBuL2Yec1P+RmBfqqdpkrsMcVeXiDMYdJ7fgQiInYKovPtbUEKcZauc+ysd6kB7soL
-buCD1R6d9dv6CYpoI3yZ0tHb1JZpSxQkiyw/gtyE/9bqBtIwjQlpkt8Ywj7Fvk1Kd0xj+gx5Smjlvok1
-U++sKr5KkvqYW+kDyH6qKBAC/w7rcbhiSUmgq3uRgiKkhFoK9+dQrWD3GEL6CYxvGVftGVtiFYJsUEsK
-+hla12Xh0Bo7LaJGeL/up3dk+Csm/8j55hm2PViaC0XlnyyFUO0FQCI4ikKWDn/bjGkknHtNen2h2rUl
-MHRFMaDhGur4y6l+wIFFAJNzqcvCf6NlA6xFBGeB3BnlQaNqdR0VehThSaELq8FYrC587ynyx9RmWKp9
-LiBVd3c3fK811ct5VtJNrUvMG8jSP/XZ18+7OiMP7QWHQQNpMvgtSQkfTIZQsE2s1daGxEJ3kJi954Im
-yO9MP2yqp/D7xYUpcP+++WTA9gPZo+OgNGUtOlQknl2WjPqW8pEgtsqypKsjKwau1DjoV66ljufIkf7p
-EO7wnBRe3xAOqmMTIa4lAPzCv+5mZzc4Us4Uq35o2dktMCZ8Pvdyfqk3N5KCJEEfUy/T1hA+zRTe6muX
-KRx7m1LcIBNHIKBTBFk1F/r3zy3mU5dGBTkT0kkPOKxQH+Gbokxlpz7xfoz9LKVBCY4UHKOHp6f/OkrK
-wF1VINd4xooyMBkdVZKYDer18UGiKt5S1pUNBv76dkIeWMVKG57No9l1ePnnzZrl25JdHaosPHDed6gc
-9PH3sxe8McnDrx3RojIA2Uc4AFNc+yxgyLn1tw0cWfy1oXWH1Td1hq25Lv2VG3Pf+iVviamt+ylr94QZ
-ZA9rxfIB1wfsSYQms5kc8IuYH11YV2XAwvrT0LG9vXlIbvQwJa2bPw/dS7eCmdWDo8PCgtkhJMjpHdSi
-p7Ouj7110sjYrMrBxhXOveSkLrerovIAKwNkPdHezR7t/tXFUyZa3oQKfL+mCCtqEAlzXrEEGmFAD9Jn
-biXaRrZVDv6JmITZJG4FeB3PVUHtbItLP54Y5B0YN2Qb4XmYSLTZViSAwvFSXSdy9gvCl74RBjtsBv6G
-lQfIBR3mqYdrvcrJFiAHAHknyLSrM0KbFKdckgVYerRbV8abRj1f0XvrwKSbt0o9xmLo1XUhf95OO75z
-kKfvdDN+Yw0Mcq1EYcWoTzF+65GUbFNLjQSt6JMFzXFq0ae4tXlssuxL7Da5CIY2C+W3lb7Kx6HtIhSP
-AwpJOdl9Tu/moEO/I6fmIrB2kAjzfRKc6m1w0y4x3m9n0lJzWmCDwqbRNND485JVK3wJnJOC/O6CnJ6T
-YjYLo0XCOm+Ld+SbYAGsmmnRMHrjsNbCpjwr9sfgduuY0fRpeuz80Tfe5zuAQoL/CCcQ9vgf5wj62de2
-vgAHCmV3P3dwQvHgCS9Ze+q84Xt15NhG2ucNdrLjwLGSoCFgqWdGjSANEDgKXOPolIEOfOOOGHX9o5PD
-iksiNrQsmca1ceW/uiAznUp0vy5K5tEKgQ1LKiCVs8uT/EZ3dDyBw8M7LUzZmS+c2tQd5sS5uCBO29F1
-gtjDBxuEVKWa/KT31DHzXkMKZOfKYoc3m5mT6B4JwBvDE2ldLCFJqjcv5/ciudsNrd6K9ZzWtbFORt+n
-qgkTvHFyQn5k5M9bIS1cLmRLsZi5ktdhAqSp2ozmjNjWOZU2yb/3NPceP04Ng5omeDBu6K2nZjJ3h+of
-phgKkJgcp/yui7NK2kAOBXuvedx40ccBIYO5MvrlpHtbVGOvg33kzj1qDe7GZHFRl0XGxveSNpEWm87i
-nk3jHxzojM8528rxTuPYxt98s4uY1HmoPFPr86Cj0MfEhAfSA1xGOYwlYt6PPkD6D9f4xH8D2es0ILB2
-lfH0QlafaZSY4QO9jVNvVeIBF+txEEwgd+yxDeV/zzcJNVHbNwBHwffX/JrX49PBHWRH3SCR9NOqy+Oo
-oxuYFGfcPi+Pd00JIjVdMbKtyRgQduCnsqjYhDSspJB8zPhyoqYGfGkHWq2ww3TFvq/TVuUiZS9NpdI/
-tg5FeBH6d90DUD4PnQnI7PyLzsUT1cIvPRsP+nX6LZV27AYpWMar3P+FVvkn7a59UTOdHTxhCuy9uclp
-crCIpUWztQEtf5swIU/TluF38yVvntJs7WIgcYDmlkBjPuS4ujCJ8wKhypyGupB3X9ljDEVLPBJPp5pc
-eHyDJUAdOaZ5770SxlD6rkEJFVhifgqMlm17Dz3b+qlvTFKwIyYmJjtWDYbwqCz9jBiD0mW4sSfWbWjI
-BIJfdlONF354jESLcgsYs+X+EsBs+ou2kwlyfCnfmB/9Y4kvZWJ1INEHa5a82RBKVOW0OyjE1QnEW851
-Rvq1lPXZycl+v5/v5MPT03nF5EnOM3Gyk79+eDprNidPnl5eXb/5l+tfz3575AAz3W6zw8kJwS/uOMVI
-VN3Hool6ecc0QT9Cnqq9L33yyp+KxVYanGVYXXyAAuYmvBtb7fkYmwBehSoEk2bI/2w68QdAhIA0oNCo
-8MJnNYZi2Ksp5kWUawhuKiRoA6qR1GmjGNtYfQDam1lOaHXYq2d9RxbVgaiCw9k8ruvSIUHeErKhFV6L
-7FZJs4UEM/dBZ6UEl3LURoRDnw8U/Fozq5ZZdK7zVC80E6ml1vMarLfdugYhQTZbFm67l3znJ1smS517
-RG8vUDKYrIuADqI/qOehVk6BumJJKk42vGFebkfasAFmVd2iPirTooE1v/e6+UAhmNZAFXd6DhihSY3c
-OSkePGipCwMdnDG6R/ecH3W93VTtivbG8xG6rEbu5ETzmF3PzBrXA5u64oHfX6uZv/z99VzPh2+oH2Av
-P28xhN/pYf4K3aZ410oPXynxxmcs4ziSZK5l0SS4q1VlKIeZtn85Frubtrdf1/t5+SxJI34RMEmonVWi
-s91itiTsU6xSLCrJVs6sQf7IGq69dF2FIx5m7cXwx//Zp9f6xkyi6br7krieaCWHenA8BAcgz32nf0la
-NE7D+kmvm3iJjvhnfscJWy5ZJnUMWKNzZ9+FzjF3Hr2Mj2SXf+an7K2OdS/UE/DVchz5SBVqbWcPJ/ei
-Ve1Yq2n6CRikFx4yck/273gitDjWf9K10xAnXZrItg7kXC8DspAU85OCLpMmN+y1Dj4XuMFh0dmONQdj
-IyT/avsKlroJMe5shjzkpzPaUlGzDBPamWK8Iaf6gNYUC2FT8aPFBmQbdZeBHWa5lduGaSOI8UhVxDdk
-Wwf9TlyN7LYQ6EVs7wC4LKYQHx2vi0mtJ9dUdh5hvJbvYawuLZqd2Z/cuWZ/MwPfCrbcliY9mmpmWWBy
-Z76VBJKZBP3syACoZuGoaqz1oPQ5zg7AbTgcz4U3tg8fkPfU1723tWDRz/UrBkLQsbxiO0hYHvGdkkAM
-dfzvjDwkMzIe278m5F/JfkIeEJA7goMcynjiWCx6qE8PLkjkrGgvi3ERaVa7cnkHMaM6H6p6PhozGV96
-sQEu3N5ktYvNaihooL6H5TqvuBWPM1q2kF+so6FsyFWyVUy5Z/oGryghDBjE0RBTUy/Ag/GxGnbSlYJP
-n+BUdE1vUBj15IEnz3+wcARUhPyss/bEc/H765cvnhQ7ncP4IyT5t8n++TFKPdNgEub7s5AXO28W8mKn
-Htd5sUvHTtqU+6racXwUTL/T0n+00vtMBtCCMJg+UhjzOYCSdSD2ZZQOojaBTzdhA6jWImMB2nwVD7jM
-LXm2tYYg+CNOS242so3IbFH34lzDgMAwRNKj1cL/a9FsowpOAi1dJ8Bf10z2AgoG02ISeHfotF1Ed6IO
-BHnPeZXxSrJbuWHVNs7Aqv13UWQgDoeBVxCj6HLFw59hlnhrCWSs+g5zHqa6eGULoFrFVZjTPH+6Y5V8
-obOb6cjGnO+r0dT0YWClbX3nKmrio0qtAfDKW1x1E2JhmKi+FvTKj4CLR1Mzjc/Unxi84U3mFK4b5KmT
-E4JE4Hi084FeHsK4hXizTgUpKvLsmQ5Nz7ZCZ9zHCojpstguFpBXdvj0t9AN1f2Jqr3x4NG0tfBDJm1R
-bpvjcwZMO3GOHeBwezTzPZTC6Er00FVbQ1tWrJvueKRfFbOK5+wtzOrFl9Dgl+/IX7xQvREhC347Q4/m
-M4LAdbMFvz2PCkXp4M4wEW5N4cH0RbGpeSNpJeNqSE970n1dt8jidxjJGRG8LPKgxEf/j/k+g/HEA9C3
-5BkpqrKo2GxR8uwmakjN0oyWxao6IxlTEkFUwHP2a22izuh/MopG9HHktqFbwjWjubaQX66LMh/DeKOV
-v1ozJkV7/b2PNgoHUQsESJL261vv355u4N3cFLc9c7gH5MISe2v+4df1DCsO5PAoj+bFzpsHHx4RAJu+
-o2BjH3ksOuoBU8yEuFbypWNuc3ufEboQvNxKFiyC5PUZmf32t9HaWDZp88ddF7+98L7P1hES1iAd05jt
-2eKmkDOXYvqM8JpmhTxMWxuQPDw93QgwTNOQl2cb/tMn0UgZuxDIq8vUhUkvQmHirkayznCgDnnA30cx
-xzhbkXpkjpJQkCP13uTLJdmrx7eLt1MvbYYcTPKiYZksD0gM/S4tooJRD0GuI0IJJBCdE3yrb+gNEya1
-qk2nro0SLqe6vb/NNWczqmvRCniUNXNCrgr1ZsI8popI8B3S4JOasYaMwRhCMjUxk9AJRp3NUzcA1W50
-x6J6QfuJ+tVALwF/ze/p1KwnJ+S5HKl21zS7QWtMoX4AFVrJaMWEhJytz0kG6VmXBXi9+BvjMfb/U86T
-NoG/+kHx8LRj++OH1B2Q5t32WDrQbTrlOELeBmKPJ00GUuII2FT9I1+U+t+q+wlXCOAKDxqsa9Zb8g9U
-DAVSR8A/2O9W003iwHodvhO9PeiXHdvSY/y8M64bHW4bTL5hO9YI9kORMz5G2S+91EC508MHlRZvWN7Q
-fXcs/++vX76wFmXQ+0ESBFpUkYbhyauXRF3A7TB/UFdoCsejLE3J/hBLu5KhQwo8m+OI9l5TKb6zj7nH
-2ZnsmSR9yGjsQ1TuNHxPQMkeqGHhqEXwUacIfsP3r1HJ26Aaa0kzBpcB0x5mqitkK9CXv4ADdclktkav
-UV6RnCG6I0zBAW15UBMcOCQnu4LZMPjrV09ejZtVUeV0ckYueSWgaUF3RbXyvbKAOhVkDKPEnOxTBE/I
-8LUwAb81WqAOLuOVYM2OkQ3b8KalvLJqYpgXGB5k4UOlsJ2wKdEwJ3nsLGcUxKge7fTRJORVNdPYfDAE
-8C6g5Z4ehEb2dLTAGVhIzKhaMDFvMbBVtqkOj25nDd+P7C2vdwSYDjTyuc4BjkM6jo/whu+15sCyIkyD
-CwPA6fqm15XYM9gky70FIu/ccwGmBxwByYVekF7v9/MOtA01wZB+9a1H8l3XdvF5J9wugOXghVD8Ylsm
-6ILbOtakgGnqMapCc3V9QN8jRcoBlhsHTpMOr5PdkV272B2kIyihRueY3zBo7DD6KcxvaEV7oIP54/6z
-Ku/tvfrOq5/d81RkPnlERFGtSmZQYb39ZvkJceA1XcNIthOKnwh5UVRMHwQLRiq2Vw8WkrOy2BSS5VMU
-nitOZEMLsDzpMoM2sJYZA0B5JS6zKneGJAzWePuuz5LDqjxwo0EwKadXdOeFtt8QRRahXFU5X5PjeZhq
-0mBfun+ffAFFV8w5cY1HoPUw7icOZtVSH/1JA6yBxUgvk/r6Z15U49Go84bs3vIOmOWfZKP332sYoqXm
-5e9hkyc22sAdhhNzh+st3hze9dbH31DKu3Zi/u5kOC5pGQXlmRhAmucNEwiHp+dP8QR+hVWP57qFKvQL
-8WzGN/VW6qezhlXzF79pCdeW7doxiDig4biWXrDHEJCmln/9gw6ZwMoObbRy+2iAM6Fiey1tKikWXrjo
-xuBCXmIQjXgdwNuvKg82znNPIQqV5rmGRjdN6lNIsS8h33FZgG6EQgwGWqL3uGuF7oqDy9Ku+rorZSGk
-mXIMazBuFoqUkgML8M7AxVE7amxuKR2EQeqG16yRB9C2WK88XwjS+Q/kuqhuXCYEHNGipBWI6UTwDduj
-W5IG7izy3GBiBZDLU1JyfoOvAvWux+CCSTCdmqOt00hND3BSVuY83Gl73zWvTzBqaKou6oxBD8Wab0s4
-2xbqmFUDNy2NJ6qDGRUQzqz6Cw+WPeh4NqqNxvXEqsnMvW10MSwn1Dg1klOzHOjqiDm1WE7M7Z2GEEgH
-78JOBAY95kfiBauFfnc2gteFwr5pe5e2t4g2Oi6X6Bjfv90s3ZYwoT10oR8tUaJxHelUlOEDRwsPao+0
-FE5xVXW+w5E9Gk28epbHL8yoHpDivA2cpcSLN3w/bvh+EqBoslvZmCjl3snrjNiy+KGWkhdkczSA1tbS
-g8ImHQsMjJ0lvk9kEF2j08Cy/GmVhzgKxq8Evj/hexOh8/FeAGamGOt3RyZn4kXVDmBEDI32dE6PtNYz
-QlizBDEGN7iKS56pI94FB2NMnJNoEtewOqkrAMuPD3ZI5wayU3bISk02x6xuP1zrC1Ko+eWCkf26yNag
-AMkbk9licVAFNca/CNLCqG9WGNzQqqi3ShLTwo+7fCfWsQkvVnWCTXXEgDqDS3hfhDoXR4E0ELIA3drQ
-3LxP4LKBO3Dv6Wv4conCgmBWiCua1pVRMNWLhi23ZXnA+wbPMpYTwQnFkxu1MkujlHfiR9fJqNbruZVj
-UQ61eqw7naPqyE9Wktw1kDMhi4rqDGC6mZ5j195Y/qFr+zzFDk1NE/HjK+R8Zi+lmEDbZR9vFSisaXuh
-8dZNEF585tBxM/lN2B/n9WeL4CnBIGDXTM8DfYjH2Cimsi7nV3VN+pXv3UvGE3pv1F498fOuIMA3mlxa
-ojF7vVe/YzhMyU4MBHF8o8BOaT2KdPwo8HXwLgI2e+PJ+W0xPqMVIoJ4+8S3jKHSVItu/vk0VhJNRquR
-JDkDj0YlmJqYev0Hk9lk6sk+sHEVpYo7jBQzXZGGtWsnOrXRsV1oFDR6BqNSuJfQBUm76OKRe732T1/T
-vaNxnB4PpVUeU68Rz4/W5C/xevDhQ7DLnOvVACnoLmoUHX3rdJXFu27xJIDpfN0UIG27pESdb5CGiZpl
-DjgTvFrw3AD+3je0ppiaagPWEnDoRt10jqBncIvAzdD57DGMB87Ry6VFLIhuHI/1TdSPj+Ludh8MqxDk
-q4rLr9QlbZKnar/dTHf1e32DOE/YiXkmFPoyo3KkMYoOfDtqGFq3Q/YOvHm9HkhOajXVR7gPyiQ9de0Z
-/Mrwzql3NjcGBbMsFvN9Nje/aHfeexZMxifxja3YCoex0Z/eot6/PyjKK45JMqX5ZlPIF0XFDJ7rOIzz
-rthefXV4VFaIzfS73Q5z5s/EuS2XF/mrFuqr+Si2CyGbOISnN77EXDKRCOohX9mhhk0bQFwQTHXnkwRi
-TKKOMBcSoYt4jd2/T77oWjHXvZMT9cyWjh3NYrFcP/QrBj4K3nKjBwjIgFp7ZEhBPCdwKvNw883NsGAu
-6JP7jc4dhetXT16decmW1PkgOeHbRl2vi5JttLcHuMwuDpKR76+fzR7+79AVH41lsLAe68PfivGnPpto
-yQeDDnwU/mQ1y2gPJ/FCmo96WXzJ5a6dabO65DcMgPLMCRHmJKzLQv5Y5EzNAiZAG2MLmlIylhVIpmJY
-9T6A7+rG2GfqletAnALWjGKisazl9RgcynIj3g8vW3nUEsKnHpDrjxtXa54jAoqd900hWT+N1LnUNS7v
-+NDrE1Tb0MOCXZZFfYkXoQdp5R+xDy5ScuqRsIp7rfe0TYc/iZGuPhnjRgP42gemfsIFN34rhD+M+TO3
-uPEbIhCYOLHftcosvjwxlpnWNaNoVs+5afXKpD5tvSxNtO+2LDtAh/EkgbAu8/YMR0bsi/ur715df4Wd
-2XDhwBiE6gohPzJ1ubs4fkVuxVW/vuTL5UxJQV8ipKDBDSykdmr7b+SP/waB6L/lZnv73y4A24gwil7J
-M1q2ZZmpft8j0l/wREfdMebVr1fb+qRe5RWCFVayqDBT9J43N+ppDMNSb36hVY/stvaTebZMuEbbGVt3
-WmBRHp90kUEWjCmFquWA2NSYdooqK7ei2B2zSrbAEgKRyYxlGvTH+Ru4wV5ckFN1hQb9vuiC+cW7w4s2
-87TEgMuxLcvz9lck6xdoZzyKKNke9pLze90S7AODUe1Z6WiW8Sb3hP0frtvZD7VN5JRsq5KJyB9qTQVZ
-4KuqzE0ShqbIpBPdtYwv+IYRJWY02hrxXFqNl7qr4PsP1/HBgvkV85CcUW/124kkr4H3cbxBv0eizcL9
-NiPDY9cpIAB7RAdr94WfAtW3LPmlzj0T82mXmU/vl09bONmJze6vaCFba4mKtng57Vqui9V62GL6gF+t
-9Ry4mDgFn389zUY/uqRmrx1dVV3QX9juM8RPnPC64erZSigZ/akaOfHWs455N29hg4OWCH3E9631lU6q
-RjsjmNJATWytaYHCyVsac1x4U956dQMl69Gi/nplUsBiOA8oRgOBQdp4bwiS1j2GgNjjNnb9LuxAC4AG
-HsmnVf5qaTPI9r7twEZ04Ymiif8dtSBEUloXvzh4zkdqZ1wFIo0SsDJZ7JhGEd2xxiyZMRfPB71WcURJ
-hgyegCjXaBwHT/XieoaX6ZTUFkXLyodzX3g2rXxfjx9GYJudBpUjIzBQpwHYaWJ5B43IaFKtzRzSR6Ks
-CZgDWxk+aVuMejr/ZUbldf6RRrCCD1NC8x3VulrTHSAAGc2ZMNleDGTDZ+icRiLxAfUGTf3RiZ8T8sg7
-e/wjx6r5DCUQaK0zjpVUWsfO3ElCPo7qwza2ejy1ns5cHQ+A7xsfUfN7n3c6A1HsRXEDTheo3poSdpux
-Wr0YCvBE8ld8ECJOWVTsGWMJ8NM7A6ikdG+t4KbtphqnwGaeLyGd5iVtmoKumJZdEEQE1Ub+oNVKmJ6j
-O0Y/0NcmPcS2iqHdh0BID8bWlrxdl1rrFuNSbWsw9MKurLkQxaI8aAU4SDieqTTh4+EQTyCOCGJdj6b7
-x3iIF70L7j1nrJOZJ7m6zJuZVVUc4Y6G7889Q7yt5D1MginGOQAX1NSGTG+rN3zvEzeqtugRU5c0YwC4
-E8JhgIqRLWVH+kkXKlZT1EC0JKswSgDBb1CDWTd8QdXafoUmVFQmeO1DGJnGCYcOKnrQJMUGJw5FCa1u
-fm0KEB4LduAaJzbs+73hSLmsoYJd8xdqHnrEozRSfcdGP23bhmPtGua1WzH547qQDAYcJZ97QB5O7oJW
-/VQNxDrOevlOWmve+G8ae3bHe81qqNT7pPL2WihSY9JHG/CpISr1DpUeklKrjNSJIkDO1jYwmAgROF+g
-QdXTPG2rJW/ktqKSeUknUEVmHLOBjpbgEZILfFStv64XTZgXy2WRAeYOeFgysq09XtQeghg0zza1PGji
-EOwEiqFqJIlsitpgR/nG1Hs6E4aZOM1ubzA7lyDFquINM96lBPO2InYNtaiUWvll5iBnu4JKNAKC6wxo
-A3FIdNUwphX/q4pv2Mw+apQQdINJe6fkdpmxf7Pf5oRcMdzizWK7mmd8c/L1r77+1W9P4VWaM0mLstMI
-zWv5PuXkEXIejHzYZnwT5yKzLUT31zEzWgxRpvbzht5efhZ7km9Wc1Pw7w7h3/44tY1OyJn9d0tJndKl
-e7vm4iLOjpaq8OTps0ffv7h+f/nqxas3vhvbce+ut0fus3fOTy1pWql4jgmTnOPXhHxzkTY39FoLO+yf
-eBRcrmkjxlk7SUEi3+W4Hxpw6Pl+l0Mc+nX04O7vcutY9w/bDtmo87w+tt+OaQYGTY03EGvG+Yzjj4QX
-h48XxUBoP5V+iWBKGraiTQ46PL7sMt78/Jl9tFDS752n1kyOJ5ron5NevDarRmCYPPIOK9yj9eg6+s5h
-v+RidsBith1ddBAGqCf+Skv5mCWz595xKd94uZIC6P8+RWe07H4WFURk/eZCE/r7Z4BnRZwKQ4tZGkyz
-9cBwkQYePqX2ouo1vsbIgNka+cC6WmgoPBORuyzKo+HgqvO+H//6bkzQvRinZjHsSqMX/uk5/KON3qhd
-19VXt+K2csZLrKz+0Qn9mPHS92M40kWTLTudFyi+GbN1MiXe0FcMbndZNMyALoH0WjIaahOo1PbyNI6p
-zye9TNLp/uggSu3dwmsGIIhgDdcwocIYX6O7R6vyBqDbxvmAPtk7ckBOkXtBSpGLiyAH1lN84jg3Wqf1
-nd8j7US+qSspcRrpfrSzsHvnkC7TeQJ10jhtxRd0spE6bvhW646MyrQDz/novUGO8huaAv8+We4zsps9
-/cwSRsJ9yQPFaDIdTefqugtvGubfD5fcRW8g1CvoEzDkIg27HccWJ31zY/cXcCCvt2KNQRi+lZk22itF
-SPWmg4i1aiQxmci27nQ7n/Sgvbcf1TgiO8KjyLVWq/gpEWgdOs226NIyDONqZ3HSO/0c1pVnruUgG8iG
-7xi+0XXMZhQ44iUMbEwgESQIvGGk4XyjDqV7NoOgtp6MxUTH12Y+6bwQEm1GGI0Czvom74PryEWiz+rf
-Ovn4QxPQYWt4XmYugtILiLIFpx7BB9ansSX1GcfLJAT/0Dgs8oDcTe7r3F86zGHg/vrOhu42ENiVCpZt
-+6qp5cS4JlAQz4micw+zvutcFOBjAbZJCBPVlba11tGpzkJ4FKCl6yh/jYN4l7BPu+m0MvIYTrIqNGzT
-dUqM6MlaBwJ/63bv3nttZZflX0VVc2zX/rTqKkeI79iVDipyOyHm/szkeLcEUrsAso+YlgzdngduK0z1
-CLu7vt6V3T/XM+c5HLvCi21yvKRV3MNSSnSIIJH1aygb66bvdnlcwU76WXwM0bmdyjIlVCQS4AQOzTq+
-doj78Gd7qmKepJkL6PKyMSSV3DZlgVvbhC1q8E0fqs/vcPKAgrRnxbBUHl/2ae2qTsUKFWygRIfuuRBa
-OB1P2prnLjXiHVMl6b6k9nTAMSl2M3X9yp1s8vHecF65WhdLGYCIhLfZtlasJMjikDDQweWSvCktTIFm
-qB73X4C00coVRWdHlVSUG2ijaycH4emhyioRdiudndecRWAqo25Zu5PNAxSotq+Foi2OQRJalkZqBi8V
-hLPxg9LUQJXMPODIgxRs7SOvdxeZSGPrgdW3f5xP1qcfeN2W+qRjgYfh6cSGsScGDlSceCy4AF2l97AF
-YPTjvBcu8IDn0BCe7XJ+/8dkWPfUC3j2l+PEOH1vZAn9+arHJFMaoanlmeKaH8SbcaTMcSlHY+okwrp9
-r89ly5lekRnxplgVFUTOjgiCMueQMSkml8CW4MYBt3NNVdcMW3oxGeFr567VhqZvC1fN5wijmLV509re
-XTgvfgCZYYo3emI6lL0m7dcAV6Tuun3uYP7CFFLYHd0lMBnptuH7tNCrUxj2T2Z61L2TOthJDBX3KH1k
-Jd3UY7TUtCJq4J9dDzitPdFeiD41kw7rdNrpvpAQpttatXCZepbI7EAr+/6ya5Pmqt61SU55coaCrOR/
-qwmODsNhGR8/1wlyaYaczAL5cx21U8P1wnHSI06GrXziOFfd40wC1fV5+HQvWaA5/ke7usIx3GUzvgkt
-4I3BKRhyIRyf8GNc4036UJYZBD7ZP7YB/NJEqXjfILYzOgjKEM+xATx1ddWxckkED2Ug/dVPdE3FocrW
-Da/4VpSHKVTRGSFgbmgJ6ROzG7IvKrCR7zGu1CTXgiIO1TbjtGQiM/BT1EDnYtPHOCJAhX/f41jucpsj
-4aQnHswBXqX2PI0rkgsfET+Bl6/VM4pWq/K5xdQvl0GsN35+b3EyEvhk3Wu48HC4Ai12AJqCu/5vsZTu
-feGC0OHJEwVDu+e1fp+5MU615lx4BmBFLOfu8eUcL/xAxAHc4wHWDeIgYct/AhcJ/xX1KZzkCHRyUxs5
-AIrEyAGa0x6e9stb2xo84NuaxhQO1KAH5yZs4ZhtGAp1akD8AxGLqrkZz3xdbv8AQRvxdzDE+GntDdLY
-RdyYjOllU1ToSGFDJBOvLPLv6fcBOdPvam2a+SRK5olAzlLxl70S7z0f4dOXeY9HZaG5pjPi007L1I0r
-iI9oa8MQyrOHTyA0Jc0nKDH48Lc6yicG0FICmZam4EiTfip8NTJVvtZHqIG8MI/QBrVoFdkziF5A/35I
-GAvlvLaoIHvWTkvbr9/HQXwq+8YRK0fYN7DQfUPa6bu96KbLu4W/tRUOejV+bMEoDfbcb8EuFcIL4NZK
-dgxk2dBmVVRTyCyy3TCIL8NhSk5WTJJCOlqwjib2iQoDEGxceSDQldZ1WTCdQXoPUZpFZTjMkYpYbe5j
-HM1mQzzTPcSfLzJnLm27q1tTSsX2A900vBp2OcPlnTlYHeyDK/qNcwkjrk39jxmai5cl543mp5P0U3li
-HFi9Ft6AP+Opj2R0cmLwbErBYVq1U7DagKfBaqFET6KOdRx6D0yJf+3yn4xAjfy5Sr/9H3hl2lT3HqbV
-vYRpyz6J0N5Ob/WEKPFz6igjZwQ6t3glLYHkksY278i05jd0TD8HEznoEP5rnHmtwKDPcuiltEDDY1W6
-L0VChiqRBgQK67Re6KvMG6aDgtSlFkfWiV4YfDzizIOA3epHzp4Koh5Flc1ykjCohOGe6oGEG1dyIhjE
-vJt72PnTaWQT15JWeQBiiteia84Ug1cTBOUVVVbkzL09dEwhaE4qPrNVR3oC5oS8PJDVlgmIGLTBqBVv
-mWwWnJeMVgClKpmBUkWHMsGOuUhE+dYirFPJPAVJfDmaClDOXKNepXS28Gd26TsyZsa5N0eTyFSeTmre
-Qc1xWkAtYQ+4Yzc7CH9iNzsGHcZBu2wlWve2YNEzGV7WkMugpAj5rYoQus0LdV5BIgJaEV5ljNRMPT0z
-Xh2NPy+q1WMWRg54bIFni59echGOlsRRf37S8HieJ13P4v6nL7xXB/QEC3YnaLWP3K9PT61Hp5rDq//Z
-sjJb6y68t+fPgqudD/OrTrKKy2JZZAj1reoBeosVGhOUWid6UPiRovw+ymIkmmw0CfaYV1StfOCXAm2y
-qPvHVAnEwUCnu20lDy+N45T8+vS0vb0GUPoYDDxn4kby+jtvLhX/vXfuO17GaCqeYfLCIJsUCnvEJzG2
-Q8NrUkft/0AboaNBE+1eF7JkfRfkX0ZSFRmdgc6L723X5vC7usZHQH30cRKvit/Qi0LI9zrFlS5nIVKv
-EJFgz4hsIJa7oYW+SLFRwCH8d6hUzXkFSUuj3ap3SMkhEixoWrwfT87Jx9Z5Y2wGnmafLArpvVn9L3Ax
-T0nGGoAzcXhuacwvkxyK2FRUSlaGK41J1rYatKKkiI84hXI25MQRNa9yH17a899omx7MYExMAgQrLJfH
-7thma65Xfw50pnoIzKoGXb2vnJ5kyMXL/eL22u0Q0dsxJWZBdWhBYkH9L3pB2W0hEJpHyT8hYEXg4qS1
-uhDaiH4nWviOHdthZypq4RqaX4kNxYJVpNUh7MPPXzJvlHdfsucWy3bQkhV+cb1kiSWhWyUmavwao6IM
-1yZZxGLBVYSqud0sWQDHDKoGjWWOjkBUIB5TWVRs6ixvLjG1oJCQm1DwrVL0tDdTXiyXrAFwhwUvSoH6
-9D1IuPs1A8ALtbo8YR3W4jT7/NLrozb40JB1SWAW9a1PrJULlyahs4vPQnPs6SytkX+bRK2Bd2oFceFL
-riqoDYCYiwHWno8LCE8/a9UBuI4YlguTj3kJAWGrkZoKSQqJHnAI6ZHcaC07f88+i6bl7nvNqd8Grene
-L969lvr5Mrv7mi6XP29RvcXwdbgDV9T6nwAu3c88Azsm4e5r9CbWlN7lCfnjsRUrUchmcs+YAZgpNrTB
-cFpwpwUYHeRtX5/uvvlbEs7aqJbDYPQXwmwg016rrEGr3tO61imlVRe00ZEwdNLkHTFzpvpTmq0NbYMF
-J8CTTJ2sHe6Gn77s4azcfbUfmfo9F+DdgDfMwhtDke3hlf5+RvRrGdbhykui0tLFt0A6799PxXo7vJhT
-L0PKF72JWLwA4+fBcaqjWCCdpdAJNvbq/xpG6J4eppDQwLTCBNnQg6G0ULKYzuQ4t4+XoXlhunM+mfF1
-5wOgzSGdDIA2/Xlj2gHxtCx+QtSc9+mYBx9KBQqjda1deh1b3XwlyqHK0HzowsATxYogjdOwSJrQr0Kt
-YxwcEThPzRZlUd2krozgu3fgWDg6JSDDRzDMFBDCuStEsSiDowfENPPBGZ219zdQMK3+p0vGBDI2Pqk4
-QflRlTQpdIqlSViDKPaSQ0g5FwjVdS9InunEQlWnLEnJ6A2hRHsV/GzJoDWTdz+JcDEfA4UhN04WlNd3
-jT5EvsAemtw2znfCq2S2CFjCjOakp/h5S/eRLNfWfkQ9/gHZYJKwhuhPY9lsWS/HGlbq5llTootrWYpP
-FQcbjePnYgm/q5/KFHpe7sAWrkaKMVq2314GuSuLDGQSY+Tr0LnymmaFVFfB6HR0nsK5QN7pTDZynO7D
-0XkfowaTcGSqIkgzNzBeefv6fQKu9e9gDUIlmXaJg9tfbZvo2MZ8yagS9LKtxxFAipR9MAPAP9Vmqz6G
-by9mGuBJ8vqNu9BTavhrV8JBxaJT4JGaj4NCY6+pyXmMzpCg0077m5LYOmAcghyxSPx3Ua89ue3SPqr8
-LKQWKQbMuYUkauWL3KYmN+tJG0bnfTsQY91nrTmyypjQsj+qb9P79MhFEMm07X4YoJiLCzKqeMVG3hS8
-YTPzOTAkmffmEo50Ksi6yHNm88Zu+FYkQLJ72iajkR5Q99EiXRyKP196wsEbsX4JrjEtpuif3K9aXDHz
-t4DL/GXWoKODJTooHWkUzeFfDWBba3bHdpMNB4YeNC302udxHONRnJ0+5QN3nM6UHCNkxnCc1mRkDWjf
-1znVEegZbZjE3PQPHx5IvW2UCCrmzuinT8hWBu8Vk1fm69hGdrsK9++72vNCXPKypLVg+aQdZw2PCVP2
-UvXI0QkFqUf5n7fC+B0Dgi5ftlatnZQlAkUQa1qjVF/5ytmMlSXJiw2rhNroR2HAoQN4inUc9NCQmTps
-/Er9FL2XfY4LTYtLnm3FaAKHBwhf/unxqNzTg0A4IEoWJc9urJSoHjTbCqqD5pAQ25loRF635o9fvLr8
-9twHM8VJvujYkjAM3Jx7nety0O70tpzYFzJbkzH0zgr1VLDefj599NJ4q2Lza+P5evfz3pBoG6dHsqGV
-qKninbgwb3LW4GV7pefIpZoKS71gS2nKjAQvi9wSWzSM3miB69igv//uydM3L55/9/TTRr6ggkFKyV9k
-7NG4Tk6I1qPgVi1+gmdDDRjZhN1SANkxKAS6Z0emzp9ef960785fgR+8TQA/vf88PJEY2B2l2lYAe1p2
-tbEkhyr720SSHKpsaFDHEGE6Fdxh631KcIetfNQjA4M20s83LNER/mGrdIUKXanznDdK5sM7+ifON2RP
-mwrztdpl9H8HRTjZMCHoitkfVe2tQOB7qaGvFg3fq5+g9mYrJPrOYX4QTh6env4rKSoCXAqCwdp45Fnk
-A8y2Xh6GqhPW2hCju+fpEWCMxVFNwprv/8j55kccVpwB3GgEQEMAK/qTKwy3lf8M1jqEzidvXLct+WQN
-o5I9LZn6czzKi93IdyWJCWjpNRPimt1CcIYV1kbg5nRGFiXNbs5HnhjX8kA7I/+yXH799ddfh8WWvJIz
-dbyekZI2KxYRgVWcNTQvtuKM/Ka+Db9rbcIZOZ3/n1+Hn2qaKwlKffqabcjp/Nfw/+2/w9KS12ep38Hj
-4Iw8VPUi+nrDnNlMpeH32Z4tbgo5k+wWBzijIPudEfWISpdVrD5DyTFZbMN/GkZPFUwQm/TxyZzm+dMd
-q+SLQkhWsWY8Asef0dQxK4vQcfF6VdXnmNXkcl2U2ntLHw8RdFCrVTWeS41Ehx7HL3GXvaQVXbHG+FW9
-YdpZQBj2w+3mEdQVzfvmLeRlf17J8ZE7VJF4RjPJG/KVOj8m7zwVVsduUHz7jG6K0lpLQmfOSs6W8Hnk
-8GVbyr/0ZvdmdeJPdl7s/G86Y5Wb8fapcR5m3zramu8C2FOutdYdLbcuhUBHwHesUW97eLtpHB66Acd0
-9SYqNgGmf6uaSfVbVKSo0MV6Bw7ERUUoniVELcOUZKwClCSArdlpkcBL9OHZPKxf9A1jmLrENGdupkVT
-sCWkloU0xOjmEjUJz7ID3zpyaupG0llKsjXLbqxkiXg64ewsi6aNpmOguzdipSflVmLeoN9fv3wxsZ3U
-thjVbx0YpIcxT+FQ8Fq+1/c7UA0XAdwOaCHJgi15w8iSwsOUb+HeRXZx9EmgsH84/7V2CRZzQl5TIUBW
-hIvTAFfrmoaYkPRAtjV49Ku1GvOGbCtZlBq5VPtzlfRwLNuXunRf6dXz7tuNWE39IcdXr6bevnbtHuzR
-Nnt173zt+pX7rtzoTnz46/hS9K7U29tZ4lb9bLfm0Dvw2L1mrkr1YtMEjZ3g4W9ON4IwKtisqIbdcu0r
-8zjdSdcyJm5F0F6qF0THzcjUVaCqWOy/c/tFSF6/bnhNV9TpnUCI1kY3/65McYT/kut3+D/vIdPxNOyP
-R+gjeNfrsE0nlAM2YtXXnG+dmv+fXztDUnsPt25Td5e2LtBg79snV17slJhgNU8rJi/LglVS/Tp2x4Ix
-T2gqx+q0G+saLSi2NQuNdW9cHLPXnPF4ICfka08l0EVX66MtSROI5VPUv3Xqy/G9fmUgFMBv4/Vt2IPU
-0zUwTGB7YQRD2+AWF7NE/Avs4qKdVbk1uYPDFuDte4T/RueJwncIjLB652U/35r/9ZUMJLNWQXsO9fTW
-1+UMnQHcgaaGDXbRf/ir8+EDeYgxHZ5o+JoKybwMRgch2YZkZVEvOG3yOMnrkWu/BmotTQuWh4/PGr65
-NNTH4SUd9uyS1wdCCYpd1pM26p8FfeSSnWlnroahPgMvJpaThlYrFucanyrpUSucFLe3gTyNwCdko12Z
-TU8yXh+ORYjy+oDYr9fcjjdUPTSRGso/tBElYGbHOau4LDI2miA7emyNp4ITt1wIz1TPO4QxHdR0mjca
-hPZ4YVEYd1ofrvi2ydhR4alu2Egjlpk60Q0iZBN975Kp0mKKoubJEglpIy7RKw2hhmH229/Wt/4V6Aa4
-4PkhuJFcz4MYtuHmLPDmq7I1WjwwVk2bs9zPYcFXxlUwLoofTGEwy7SI2l+DYgmS3u9oQrFf8F+PyhKm
-oGFVaxaQn+BXU8vj7vZuRoPh86fg7wbJJ7Z1zRvPd2LObiWr8rlJrUQr4VCObCmkg/krdKwEr8jzp/PY
-bqjJ2TQv9vdMGxDHbvKnwbSbpC8RpbGd16k/o05W9Fi84yYIJrGFKSa8kbIc2BqA1uCpVixJxfGhCTmY
-sVALdwwPpg+qysejAGNu5XAjpgyPMaOHAbD643nIPIcq87L59Bhvda/xrtMscqUDJIBbdTCZl4ptwVZF
-VaHzI6IvYNJBbWSkjUwwuve72RNVvGugDO4a3XFVBFJHfkc3jHxxQUZ/mL159eMo4TJsZ8lyN46iIrQi
-t7OG76HBKUb0FBL05RBUpfOk8w3DnOZFJYy6HurNCXmU5xAtFWems6EGoliURbUShppLsO7N5fyefUKH
-A7u4IKN/UYw1IvfvQzd99g2KXb1+9N0oRkCptBu+NIoQ/AGQlNRJnxNRU+OHs60dbCetsDVNTa9I1IEA
-tAK9sLH/ZvRXOHgPFcSnExZyBjTHJw+cIbsjZ6j6V5w07OSEPIWgjw429SJCfAZlVW7Z81iqUu/8qXJU
-C85S3hlBOe9ManG4JvNZ+BvG94tyt4uN6mRvZlfgn425K3YrexnbK2CVGpa3Po2lAYBr5yEIv+F7ENHG
-0SH5hu9tHEG/D1PAe341yCF+7kDbymIx32dzsV3gBTZudlN/l05tCYlP23Gzm5AZ8Tk+fi8E6PCWgTse
-D5p3Mf1YIbXNmmLQqxJTcqbVtscE/YQ01OG8iZeume3gMnZ+UFDqC/8tjc4DqTcFFNYz0dnJUCuSAn7w
-XhAdyS4ekNFt5FAWBnoEGWd2/IblZHGIfVK+ZQecnj2Guf5wTW7YQciG38Cey5kMhZz2Y0wdD9c6ihz/
-ahhmKpfGbG1JTtW2/f762ezh/z6yjrz64fpb25PwoWZ3pB+kxMvyVWVrvA8903rQGL2d5tAYLbo9DzuC
-pW/0pM1ZlfGcmS6Fc/6CbqtszQTZNiUeVhCETRddU6nKff/mhdodJdRt1eqbrppV3zcBjMi2KR2j7wsl
-PWoYBVVYfZ6S0ftFSasbfD7uC/0cgRAdO5BXtY4OtFLxtjmmeFAtXOni3zdll0edbPo23z3v+kMlQsX9
-JP1SRyqy21r9B243bdI6EDDXorccmhsbYrRhETINeC0iDfdo7H1QmsdJf/edlPLSRg+p9S0EgTgqY0RS
-c4XOIKanxl37d+Tr03/7DfnwQTU1F4w22Xp88vZP4k9v//RuPPnLx29+9+XoT3/6r/9+dzKBtG+TyOfZ
-SQ/YsJIa8JXFcqNwUc23ECHgtSUwKlJR+ZHZTGl7elBS1U2FggGc8No7TGRrtqFTIjihAtD21lLW/sD0
-GEb/9ZbOfno0++M7/d/T2W8fzGfvvjo7ORlNAAHOm+GRoqK+kAeoyXCBJsD0oLsBjnWepXmgXHrD9wjw
-qP/WgGjo+iILpiHHtoIRMFYIVVoDPlZkibmeCN5cvHqpCo4nKYvs8YYyDmGo0L0ABR6iFJyLl0097HUr
-PjnAFvORMJ0W0ZZDm2KVl8cyQumxBNvT879RsjvPmBAsf3ww1X8PhJv3noT6IwPgEKGua75tdCewB40B
-UbCRGlODh6n+K71MWEjMAHFqRBLwhGXN/P9n78u727iRff/3p4A99w3JiOKmxbIVJUNRixVrG1Gyk9i+
-vmA3SHbU7Ga6m5KYieezv4MqrN1okpKTmXn3vJz37lhsLIVCASgUqn5FyJk5P2CUiD1vlsjmhZecbshu
-QMaRc9mE1/IiWYNZRu75D3yZ3FPgo2xMMILXmJDsPvCEVtlsGkzwKK/5C1e8xasXGcwGoCCSAfPoDCKf
-EeQMwwAJqOIYzpzyhSNb43QwL06kaze2Fg9+4etMeAgidhkXrDlX6jNGfWcSXlAq2TROMmDhIXJwTz3j
-siGj+OkKSqWfc3EQd1ljoj+rYN67rHF2cdM//Hx1eHlxdf354KTf3T89PMCte6Hw8H0zmTG5x19E6DgK
-CX6awg0ewkp9OU32MiCsYS65PaLcW6qs4cHj0k9kfaX4hxppLtKfV3I5rck0h5osBcaoKJOE/bi4w+V9
-CvTMNQPDkDX4eoYrlH6R5betAkHfuSEHjdV8JhvI56RNjDidR8fVkOcFESzecTU0g4oM4gc7gKxGMb9C
-ROBwYW3VEo8DWxrHacZXeF2B84qVrF0w85E5oPoqGZMNaVrQTyaeShjYW8amqbMluDQDJsyQcaVOrWa+
-YMPYoyHeOPSdTF2ZbYFeJ20+mcuiTtQydUjeCk2YyT3ISoFHEPMk7qw5X6pHBEutHOJk3IxLpdz0S2EN
-GmZv2ZyrTOXyJiXu3bUQJCwpHG/9INX5NfhGjVCUsEkyH6ZSt0LDbF2RIkRO71Z45GigCpSA3OSr/G7F
-XVhukuauYCiahwhdXVXeErlwTtxaVUAxHClDfkTfRyxJx4GC7kJqFRjYCnSBz69FWJmurEz91/Fh5Gv3
-j9LRQNOGl4jbf8SSi/KpzkmNPwjRjVOFo/M7/IW4quTE82uuBqaFYKWnGaJtUDmaNcHPWQPAv7iAw9bu
-ZUn4ls0tuT5gg3gGGav5VoVqg0ZGFeMX5yjhq2AezyT0nR/PBiGDEoaIy0spXBSCrE564ySeCOkeBgnD
-uxYKv+xBaVPwSqRbg1sEcpT5ObumSNSHqExBkmbEJFa0sSj2WNwH7DkofLbdENRFwryumriLS85q878t
-9dTvhOheclDfjwNvrLZs+AreAPuzLIujmu1qnCoQDne7s2m+1bZT5s0jJA//mFvI5stRbiN/qpgXyJ7E
-d6zCd2/HeGomrYaKtI8KPp4jLBrRkUkgXxJBij8b2sy6vBagkTGOZEyy0isaRTcfV0/OzdBR3AzLreDT
-t8EOsW83v9HnqWNP+6ZZ8MFchQVLj04iFb87J4vA1g9+57wpQ6NSjsFYgdNrtgfakEKnbKw6d7ljbzWW
-Wmr/Vi0XA7asDen8pVV0RyOGwuMU2xw3L8ZSXzSe0f2EjtZl9h+qlQ3SPbo+vDIOY9AnzOaCiOBShshC
-BBSMYxIKOL9SAc+x/bHH9CIPzi/mcsZ9VNhGDOfNBZvTo7cbCLKHayGebnFERHucPYIxwNeMTaZxQpMg
-nNuqG6HKzmCqbg1CLiJeNsaW1ZVAn078NJ1MszlJY5hL86YOFoNgMmF+QDMWzsmtyF0LAVtpXuVbRbGy
-AgbQXTKVnvn8QpLgbSZIRdK4OUR/w6qM4ntCB/Ess+5JprkK1QINCCtD/SiJ4mRCQ3JwcSaBCfQRLBjo
-+3B7oCEArBu3lgrc1ir2PaRiGL/+LPtV3nxFLLcOroDfox3Pm6UCU6xAiwp3E2HWGPAWDOXfMs7NSqe3
-kDSATu5Bb5aBTTRYhvLtCBmvSyJMtC8zYF0/VUnq9/ZwFzWfq0rxiZ+5+ZXZmc0MbI+lY8dqDrO/2IdL
-w0IXUCPcbkD38Q3/CkXhQvHiEo3ukI8VMVDCXCZS8IemGSV7BB3uZHhWtfkxak5GdVL5KFzFRTHn6xH/
-pp8aLSPKIKHeLcuYDzTgXIqmKh8f2oMPnVbrnxWyhj+uqR/b/6xYb1gpi3yR+V70Vj7jGGKaCKM4V+0M
-d9TVeQ0VldV6IYN78XReZoJGLWeWMnH6vIcXLF5DHgpl51Nexy9TUi2M9ZYjM8CiBYGQdjm3VzuzuNiz
-ozgjfpAwiJWXjwB8/lExAGTGVCBIA0gjbK8Ye5tP2MH1CpqR2wAdXKCVAQvjaJRiLiINGwj28G+IBQ5Y
-l0cYPy09GvGdnz0wb8bXlRU8Li2AcLRKJLtpEo8SOpnQLPAIvkXjnrp0oq+AWyWPgWiM6ok8LUYSoYI1
-lCsKcDdHL4QlBlyyskH1999Ja1dnQZOkIKJ9OqFJdsQJOgjuAp8tIEuCKC6+Oq5oWEaiVKoazaNv90iL
-f1WU8h/sRxl6Fwe+uNSkQTbDHV8E64HOICAD0njC+D3ZUHyksMnmRizj4s41XV9vCAp9K06IP0vkg2AQ
-BVlAQxLG1K8LGy6+jsjmfEZDaZegmXxLwaXDRRIeagR1wRAQCZh6MbW3Iv7Rm4UUc8No+62CguFCVwct
-nNPH9bXgDp0hWlxPukMrs0Ce4Jtei9zRcMbS0teUID1n98J7w5qU52WpoX7/3SUMau4c9ZQfh3qBlcop
-3+1kqmAA4/ADnyuguArr+ACVxYADMEvgwEvmmKgzSJ/J24SAb5DqBOwPvOPP5oDqikQD0jMXwP+IiOLn
-ezLTIJdmxUVDSTE8Y/Igo3l155GqRJ8ld4Fn5a9CiEwDd3PxMWWA57nRK567nkAs7LwVkfDKXlcegy2k
-Ra7E2K6iViBwx/KKWApVSIrWtRwGaO70ncwvLAY+xqxmEQXVe3MvZJ8/tD6V5BtaCcLx309/+5MjNlyg
-i6pHt0UAo8VCBsboHb90wYODer/L442Ce8+fgjjqJv/xoKMqmG4F3NFcXqh8VVHeieUqTTJjxkL0I50g
-GE4Argc6/xOmJiLj+J4MaYqVp3SEmTmgEW2WwNbs27cdEZ7jpwxC133jzVj/uWriBhz7e07AWXzHJLJP
-YgV/q2aX8bDYjlnZ9vrkl0xCOS/ZwMrYlBIMW4KD18qytQycreTiWnK5dCf/GcbJIfXGOurQePaMsIeq
-hdKxoC3hnbXHFbMvu8+aTdK/uLnqHZKjk9PD1+hh2fwlbcI/Psuhfg7ixi8pL81vL5i9perVSKfV7sA0
-w7NKMJuQiz7pzrIxZKrrhiFmesEUH8kdv2w0m+QmVWh5JMUwMU+4SY/4GRohnynZ7x+sI+5bGHgskh4o
-qPbzloaQIUAo+qcnvcPz/iEZBnx3eFaZpRhn52X8Qsk14STz2bRa4f/E++vN9dEORHEpX9PpLGtezDLA
-B4O3cOrBtRIoUnkd4HI5mcwizlsje18+LWFPVgiDW0b+J6JpOv4f0Nj+x0ti/u+EeSwALQ7cYCjXbiVv
-vJCmKcGcglOd9SNICE1Gd8KhRq5wyDIhfXxk8o4Mk48ljGq91wT0BsrjWUbYwzROhQY8wWqERVmQ5IHg
-FJWmSQ4TH0j2oPe+YedjxPZDrWHqJ32vr5EJy8YxZmeyR68AoLJY8Up55KaqIRLjnEmYRXTqg0Q0LM2C
-iJpZEk7SOKSZlXRKXQoUZ6ZJzK9KeK3VsQQDFrFhkKWveUPr5FKWomTCuA4bpJgzkgrNXCDM2xzIjR8h
-M5TLGWLAR3cxQLSns4Gispoy5CekA0M2TiH9tuAf+PWRdZiUAEfJN3TKWRVkhNFUbMSACS+siZzHanqB
-MoysU5TBTPD7lRAWPEdM/oluTyLo+WiWcdVdZ/Sic5LMwCWHb6z3cXIrxpmIq9w9moajcA6W3EHIsGc+
-nTSEtMaUFERQpaymETm57OkZyJ9Ntgg749pOLswtWUqBsTWrrZDsKSGR9xs+6JML6eEGkipnnZxcNGCK
-1HVFhhSdXOgw7f+Pr/P/8XX+xfg6XC6XQuzIGO8CzI69JKyID2clS8TBKZ9GZJjQiYqll5E2xtEU+fF9
-nUxjfhD7OjJK5GvmLQlnfsiXw1XVGQ3DOblnlTA0UuMykYWlKQO7m8I9lW+O4sbbkOsHTMhCysGQIzYk
-mdi5iWZTPBb4ABaFRFwL7/UsBnOSHKuqpypdwNaBIiYoIhdTfKoSfJKEEnIeZySYTDGEvuRBw5peVF6P
-oBk7yKJu9ohTK2Nq2b04+qFe1Z7xOilUtlVpOFrwrEok4BufEq6cKffwvLokuCGKQWCdYidk2wY+DMua
-5acE10Ro5jbn2iLPMvnhUtQ3WGOQ4BZ4lolaVlGbB8B1EQVTPG7UKQYqEz+Pg0xqUsLn7lrZMb+RhwtN
-09gL9JMwvqIWdLIAskOD3prFZMo3FC+OsiQOCxsoP7iGQzxitbKBqf1AWxIHmNAxpNFdti2POtm8fn1W
-bXFNYBqysrwF1rRwzcZtYw9ikbu1UD0nm/geFau3qh6dcn3E/5x/xFIf0KoXN2wzvnh5UT+JZg3rOpr3
-xN+yEft8z8lNEO8WhQlGxr/o1cf/st5xpOeAFopKqgO4lfKxAoPB5cR1+cwRlFdWbNlGmzZMNrzf8U02
-lYZb8UZp4QALsZIXo0VOAYaqb6VNjodkKpyoeZ8rhPUBaWB3KElmndsSpEZeFrPXbJILk9QGoDZEaRyy
-RhiPqpWbCNV4U71/TUTwD2+mlItUr8iFQYxP5Zy+NJnaou4KWCVtbHg79ov49RU+rHX4HESjCj6pyY14
-tTjLWzYnKft1JmssnpOVQilXmJZ4AAaIxLeiRHFqfuhfnDewvWA4z0VELiZO/u54HYS3kzqRb2FyG4sH
-vxiwzyJmOx78Ik068eCX3D4EDe2qj8b+g22rT2QPClhr1oKhy5GbI7FUNJVgguITSDeUrxZJ1KTcUvM7
-V/pGXOs2FEI/GzdWKoojWkW6bI4smb+inBkcew8WFwoRwjtqBQ3mGcvhTpVoPa7lYrelm5kmQYlvgjU8
-MALdXB/tLI1BtvZ+8aAnX7aS+J5UupgYVnUuw0WEwqIPIMN5w2hUphquurbBR3NO2GOEUS4JC4ku/yRW
-htF/IjPBdyb5GFXKudreJj/QO9r3kmCaPV0cH801HN3eSkLa3i5jLIxfyXLVNt+6A9gvoePVB29JlEqD
-/aexJIxWYQqI2ypsQblcyJilhv6H7DPVSB//u4z9gHuamob+XhylWTKDh31+G7WATsTqMxWlVP1IJgBn
-DpiAaPvByA/JOpLOvDGhEAMtcFebkNhAIbUSIKdOBnEInplBlNVJkNEw8Or4rF8ns8hnCWZiB4tslgS3
-TJg7FVkWzfIOmOYvaJ4cKqHCuQ38t1iibQjC0mvq63pAEEvGlwtLM3gWkDn37caG6KzFr3o0CwZBGGTz
-YjoQQ8Tk2vL0VJhr7c312emB8ND5on11ruHNEtpSv2UxgYg1AGDhl25luSZwYbQIzd1BTXwbc6HJxo2l
-pry9yZ7qe1cZgFPG1buADsJcoLwQenHtRtxfmhIWZGOWvBaYZVe9zweHR92b02tCquC6HEcgWMKzp6bL
-XR3v49NfVZnC+KKoJKNBlSR1MqqTQa0COf9FLbRfiuf8agHgJABAHTiCkLopDVmGr0ezFBxcYPxaoG1s
-R4N4/fiopH1BWZN3JsoA9cZ41QVPJmUgBNoyzmAM0MrT80yF4Bd6N+fDo1EcgW+BdpTKjU9SKyj93Ls4
-vbhyjK2k3DPtJc/n7shsF6aps7VVJ/L/1DS0r6iwb3YAFVp1wv9fzdAH+O5h+tzjECict7lfcW8p/DyQ
-KXKtX9XOU/hibULFTsTbguN37f1gfbn3BPKk/XMWhOwA/YQFrp+K1Arj5FLIpga4lT5WLOsZBXIOVCdD
-7KVO7hkJRpE0pgAX1abktJ8N4YhQuA1gj6dYkY8Ygv/AHzUllPjBEC7DmXqvyMYU5U5EJ9wj3LOoWr4Z
-GcoAArju44QL6AE9Lv5DHTbAAZ7VeNqRajAk9I4GIa9cg2EA0eDsbQ40ZZmMH+ZbwZRFPovkYzMxOhcF
-H0lzN91PZAosi/YuwV2IhXhG0ShTjo4v7sc0k4A4yr0RtwCgU7wR4y75YiWSrFUqbIniAbpi6gZdTY7Q
-7uRRihvuHOdbKTOCptyOvRJJ5r6/RyqiKl/ljyTGPmiopooiXg/4UZsHhHpHSVYmlB88uB9VNLOuLUda
-AfEBsV7SGnCP4s8P4Sj2me1K47JeP14DWGkIKctka0866F2WfZ+xKUYLSBVXm2EtWFUXbV9Id2EDS0fk
-hXHkwGBTOHymsdxsogpIUrCx8l2hyivcsrlcVdISldx9uGXzT+KQg38re1Jyl9+OC1t0w4sjj4qQBsGH
-5C5v1tb5yAXOHmw8RTURdiqV6l54sqEDG/ZG4KbhOAfEaxM4an9DTjJ81ZM4lEZL/OABM2gdH6t5R3w/
-4grmSvMBnZcY1/9w5emPVlr+16oVbnnLK7rmMQO+lqvPeM9eCK7Zz60VfSctXzHIm3nkQfNpTp+55pex
-YFhytyI+S70k4JfASKCMmqe+2pWUD6yMF3x0c0s5FKTCz6HIGUFFtWR9FIXejKEvXSrLqj3XYu74HSXd
-8UEIu6spkHfHBy3yjo+21Ls6FILv/pRP+a0/CvEvskrr1QJK0H2k2bf6KkUoWnA9Uvi1NRFNK5Lygj9Z
-5o2ld+Fqm7l4ToYnJ0/2Pg3hmqaBcuVjNcZPSvmri9AwGoFDD9KYK4slG4QcoGd3SL1bTs4kjuJ0Sj1G
-7gOf6VAb3h7q+XFEPJbAhRFhXlNSZY1RQ4KHXPRrcI8AxxKRTJZRb+xoEIF+gYND0uv3hVdjpXHvrfMB
-VoSyNKapQDARsWTGa8chb/ou+8wnEB80A3SWh4NMXeelHQ16k/MD2U5hTiRVdfymaGGcGt6iiICGf3/+
-dhTOp2NhOfiuUmYIBRcfI1uDclmBSfDEr9Ldg+sjSrQa1k7EZ5HL7RfSNac0TrQsyBm1xE3KmjT32LK2
-XIMCuespcTf2qNzQciZ+ta1VaxYSva0/ivZ593xwhTZ1Ogg+2GWpMnihikoIIfNh81+NHNiKQkMxeO46
-8SVIZSEZla5oN2hoEEsbLElLpX+2mzbumBJagW/QZot8Zb6XqZQr/GslN16+c4sanCVSZ1FQfXruYCvP
-N67yR+PnXOuwya9URSIzH2gXM4kIplpTJ4MKXLIrrO2RijYBi5AfGJWpRkmgkS8WmLB5rixqnjeyLsqZ
-PeTVsUIvVlsqOwbCmeTHbf9QjAnDw0o1wvuHTQkx1UlF7pIGfUq904TZ+ZfFKffcBsxV8ZlwKQW4CNFS
-FscQVHzPCPWVm6EmY8wSgZeZow/YCHsxbJkKz1qSYBDNfyojO78pGLWsPDi5cqa3EC9dUA8luIb0ZPfl
-9Vw8DNWLW2wNziGwJdAJhgWBiMOPQUpy5nK321nkQ1CEzL3C25bPACkztUo1FUGUsoSfXzLGHE9O+URH
-kxHLlP1AdnYkDpXpLJnG/BSTF2jUK+o63RPaZtLAZwnzTTWmxPNAn0Xx4BcwN2jH7oyhs/ESHVqxXGtS
-JmcVW7VnMD/O3ZxdeojBYchS9ykmHUtggcynLB6ip8geqeBwAbEqHvwC4P7XAupmo3ioGSeePrbEBsir
-6yNIBvwaSLSgIk1UinadqQH0I7EQUceE9zx87BDBDl48mYB/SSCed/SB3TCuEc/NLUUOSm4wtqLs3ipk
-HblYaw4l2rxu71mn56K7iS6bPxitWosPQ97I8+eF09DRAt5Ifv/dvKYXquPhZfMlf2+BKpwlZVcZ111G
-VbKPItubaoGNTrrFW6gzkut1423HtNg5XlpMPWbB+0pOJ1l87b6Z+hLfWnZkvzeZ71gICswvE+I+BFsj
-U/jcAkRZGhtc+rV0TzAGdm17LajfUQq5qj1Lza1F3iNh19OEOvyodHcGg6zu8m/Yy7rT5IkBLjfSKs4X
-7QUKlWTEMnxSgFQg1cAEzAzIt2QnD5aqLT6BEeoVxvewL4dD+dbRPe+fkPZ2HRSBnYaFhkgCskZ2LBRA
-bFzZClXD7W3bvlSXL05gdUjYrzN+PIvAPdmS2MXxVYqN6V0QJ5yuURRP2LrhpGNQZOE6lFkY87/LC0SZ
-lTH/uyzvWmNuQ6JRen+Ft1KtmKPsyNkrG5CLQFI6HNfwbczzIBqzJJB2QXGyVFL5VB/NJ7HUAEt5UBju
-rlm+yIVCQ0VN1vGCZp8HWugLnHq+2Aq2sPzV8b5eP445yK2+fAkXxq1ttVowv+6pfRzXZeKKPsMNqJnf
-tVIyi0KWpoSGCaP+nFh+FMloUFWPZMM4mTSercBkg2lFs3y1WNdhpqyR78twGYoMeF20K3/Id/KpVhQq
-ad/MWxIl8QLMKE6u4zN6y47EJbpasCnsOU0A5UPQalHpYHTrZjZ1i5OG4XwSPFTzlNZz3hJ10mpsbGxs
-2Iwo7BSLJtJ6N6kW6z5pIo39wDWR+U5cSBsZIFgJG6p8Es6MV4HcrS+V174U732psKBq81pNhxrqO8q/
-+LbVJt3IedtaUKdDuhGqUo++pmmnsLKxlygsuuIZWCLtG1e7DnS5Ll5t8+ZlXbIW93Oibl/Qss6YPfil
-rW9uz+Eq1lE/WB2I5zBnxeKVT1rN1DWvjfe8trzoGZ86RPSr74DyUoZVpYFR3II6riuTKFmwHKo6C69O
-orZpJVQVyy5LRh1hybOqOC5IokbezKWq5Wxju+Ur1lisMqTbfnhRZv3U/Yb3716QC9eVqGA82Kyylk5c
-T4XKgCEF023DcBoxFvN/pJ+C1XsLOs+AyTElhrHN5Zoi7PJf8KGK35Pg3YLhz2YPqmmjRXlf4pcGm6Mq
-muXaQRw6krnIK+GuStloMjVSRleV8NK2xOayKsqciVDSfsDIIVjJ4OFcQQE7I46x5XOCeRxLZ4MI13ua
-ZOi9im+BvqyH7KKIQTGdIeRq4WHt6ROqyVs4o6JtNaFIsCIdcxBDwk0HdcXqOKprJVBZTDyMYHXXdwoU
-e8jA9uabg3iKUPWxek6qRNLNOjES86wmY9BcQcJy7a0sb6K5YuXVpQ/QXtCEs0gM0UquJJEXZhCmwP/4
-3yaCTFiERBTuoqorSZ+RhvuJApgL1rVkUGVvf4QEBtGoXAiZuJo8QgR5e/naC4+ldBoGmXF6RxjCkQWj
-WTxLSTKL4KzHl/11YLj1vo+YSLIY+hSsK6RbUWZBYFMuqgnIsWe0myR0Dg/1lP+L4HEMdwyM2BHO9ooB
-SHCW1BHyR0opuExoOQhkTAS4ry4csDES2Rg8HrhH2yCgLUktxiAxzdWRjRnOEkGWyvcJna8OfKZBH0pm
-i64JwLz3gc94g87I8pzH5odP2joHTm+tOjER20yPzQB+IgH5lui0m7vWRZ7swScv9tllHERZN6sG4m4N
-LURewoQ/bNUDDN6H4XA4rJHvSZu8Jp1dZWHyyLek3YF0nmK58CGhVoBvC21tNBIUr+3pHpwZnnjDWNZM
-P5HcAQhElQvla0weips5Z4lkR+1LLpPDgnpBXdNRq4vZfA1zp1sR/A7IWp5oYs2A7jEoDFBZGASNzx43
-nIKb7JKYvbvsf2eYXp3AX0P5j5vrox1+q/JZUjGhSyu45t5dN3pyvZ7R6aIoP0wRRljq0SlTIAREBdSi
-V5J0azd+Jnzlw8tPbCE1iD3Fo1OACAKAimQY868jlRr0GwTlxCaCOErrZEoDjL/SG1mdsMzLvaTr/sWf
-mHgKQDZkoF0o4AcfOFF1ko3hES4AXQCfHVKAyNb50hJGWBpnLAm8PCvUwdDnNdSvoMdMaHLLfPLivYBZ
-1eGDLzRAtQxGkEheGBKobp8JC9mdTGnICUZ87cCGa7pjCbgF2BBHzyTeq03OeZzJB7ZwbtYpowrffRC5
-IoiTgG/o4OMHpSZ0LoInhzPA31ItEoj5mDAEpFKMYgz88F7j2fHh3XW71fpE4H/4okrI8Szw1ZmH/4mc
-wHdZu9VqRCxr+rGX4p/rs1HTG9NpxpKNxjibhKrdrTa0u9VukXeA36bw8C4FqD1LyEnEpQ/EbLUut9qt
-9WTSFK564jj98OP14dXZJ/IjTFFPIPv0pTg4W1am5PUgDWnkQycglE0vC1P2ayr/1xxW7/rq9BMh74Pb
-YMr8gL4mvRbIRK+t+u3FfkmfLGrcy5qNOBk1+V/NXuszjfzPvfZngUn02dMtfOj1Tz4Rq0d49TvEhcD7
-ekxXogPJms8n/G9/5qGAETKhEdkS+8UwrsPf/F/eZFqX/yDrp7h61yN2vxi5UO88avJl8KuMtM1tZlIz
-eXddjm8IuyWRwTwCDy+/xzmBp+6CJJuBu6fxPPlNM49ZkIdMVMV1Dhwor+E4MLWzkd5CveGbyXxFNWci
-X+mKckkTOHNoxgikygJkQ/0ITNMM09zaAIeY7pjhJ66kMwk+L1C7pjRNZSqXeTxLsCRJ4lkGYdsJBV0W
-wv8SBliA2AKQrJv9rLyeNEKk8dGM4nl33bhUn6q66E10G8X30WeFt9+N5uRFiJ2SSexDnFf6Qp2BuV2/
-rmLWK9/zQ5dUMOZ7LMaM1nXVDpEQRyJeCIno9U8+qxGIvs9Elc/CBdKgLktoENrkNQjp0wkzkx8wLneE
-kvxY6tgSe/DYNJOeZQkTugemxYNL5WwCxxxNRuBTq4N9Zf9uEt+PGb4oJHDSIczZVAYKCfbBrgI8lRGU
-Baga7AugeTGjFGjMCI5npszSMKI5TElMH8wPSHEfA4RJNY+YQ4VG5KLfM0GVxHJKvetgwk6DSQCxbJ1W
-q9WSnfUMWICEjWYhTQh7mCYsxZBW08kaxIvEEVsHUBYJ9cYnKm08086VMmQ2iIglmLy1X2eBdxvOSQoZ
-MuQNU7uGP2TYkNk4Xt9kjhWvTfYIAiU2btk8rWr9r9euNSZ0WpWv1rlkPOKCgMp15ePHhwpZE6lRfptS
-v8rgNsP3/m5WrTWyWISFtrdrddKR2Su/1Bq/xEGEwaIysMdrX9IsY0kkl+oVGx0+TKuVD7wPTvMaqXyq
-qKUpFFnkb4AXZYSm8eGL8gcSYVPZUOq+soOcSizc8dQeLkUXwBDl8iA764MgK/IWs4SImt2orBgi8xuH
-DLymjAkvnDJhCMPs09/IhB73cXLLteWX0KRcGSmm7+GaWBiKR7f7GLF+2K+z4I6GKn/lN+QsTjNIip2S
-NONKIiAEy605u49RHkUstTWY9yrMOzcmWLSDuQrTJUp0TahpyD6DLQVpOhPgx+QF9bzAZ1FGwxdkBhCy
-IoeRUB9lSMhAeU2h9ipPV9UAr45opUF0F4d3gHuQVcDoGEQ0mUuIO/M8RZeQnf0gk7qRtYO4RIBvPcAt
-vkNsdfSlx8QIB4MiRLoQT6WWcnWtEk8huJCZCF92fmVslHzqKuDYKuGOPI0LauUx4VI/47uHSDyOYCQH
-hz1ymQCgJ2aQaHecZB0wr91x8wKtjlOA0CMSt06a2/juAxE1AqHMSA7ESc/1pZbDoWxmj1Rm2XB9p2L3
-eUYfpM0Ad+dZpIWBHPT6dT4bdXJ5xg+Y7qXeuiV28D2DR0FsbjYFjdhALfDw9U4JO0Jo8BXFIpkqUoS2
-S0WRX4Gr/es6qXx8eOVV6uSw3+NbYaUGjgC8ler+4Sl8b72s1Ewb2JiJnEvkhdi2Jb0vyCSOApmpU7Nq
-Qh+we6kYkz3SbnU2bT4p8AI2gdSXkJJH4J/fIwghcE4YBO0tKU7EOYxtabnmy1DltUyYF4+i4DfwLmYP
-0zDwArgnAjNzVHMKbiLjCukU8GsjUvS41Wg0jjcMsiZ0muaaPW6RPeK0VfAzK/1Q2a98UgfKcXtJ4ZZZ
-uPOYljdWKZwfKW7fMt7QUs1sCcQ9lHIGTOFkR0izAWhkEodTXi4gukw84x2f8sawGWWX5cL2ahPOBeGx
-2XrotCG/1cPLvOzr6YCmOB2V/Uqd3PRJt987OcnPxylfuMetSnG0O3/+aK9WHC0Vox3mpfT4yiK/Sfr0
-DhDt+C4p1a+Dw16/h6qZrZ9RkQw5iwlCylKEH3h3jS3USQAM/CaKM9yEBSidrZekvE/3FQUzDek7iu0G
-cR4XErvqK6rhCOy80wkjuGiKj/HwWhwMrVZLmmTE5VDnIm7OpqI7UCBW67N3etJ7y7et0g47CzsEVOf4
-Dk1HCPNJyQDSoQOsa3wfrTj4q+4xAW8GBW6nLoHEAM+ybIfysSOh3m1qIyyYWRnlxfckg/iUYcBCP5VK
-uRnKO5gNh/wokAjq0r4rf+ejVQ0q/Tv/zCR//6I8GmeRZ61gqJ9YDTiDTgezoQ421a9V6ItujRcpzLHa
-4KAJBaKJ4n/XZU+OQAPpir5Hcr9oGITZUARt8X/9/ruNVzSNU/megF6OnBHljdFklIrnISecQY5tdWte
-5JyV8mARhEWRBVAEKh8p/3wbh2l/NqwaAzfvS/C9K2/jhYS5JeNxLdty8i3ayuA4kN+uOS0hCTlqMVSR
-5ZJSS0L5BolgZgDDNBvW5VQDQC4XjZWHtw+CtWCCUPKU06GYCNPvkHyvfn5dIpdOHigzCgmDNFs4fN4+
-TUaff2NJrPkgc2tqXnDJ/tD6tPLolezkeSA7MxjB227Yj3emN6ZJ4XOuykc+GwYR8ytGTkdBn1jKsrzF
-n2MGuIiSOWCxiojweyhLk0aTUTSboMlHVhQ4fWBtyhJ+IVmFLQFNrFdlbFkJ2DvOav3MjD47emhY+pPk
-jXqSFo/SsBnAajyJAG+2Ttqtmoqh6BrDjocEWBmkJBMIT2Irlps7zHpDvSlD43ukVdNxNnoLBLLNhJL8
-f2QcsvjFLmrMSNe/o/LKgifSNE5dB5JyToGbBuaYwEmIh0Id45dobG6VyRBFzfmApg2p5EtsbQ97zK0z
-EW3JSMImNICnK0jEhHDZxi5UzMSkhgoPW8aOb7lumMk0lvSxyminjN1eyWZy+5LttSn2Jfn2LRmR3/8V
-AyB/Dbp8mGao6I9mAvRT0sGqHOBXqUePvA7JZJ8+eH4O0byYm8MGgx0X4T91/Fz/nE3Yk1iwtlbKBMOX
-WIw3SAmbTLO5fJkwNFFMqy8N4ittmWlPZCRZSrREy1HhtHwg4kz5dk+taDO61b4DmT3cmfvAHdjk77Jd
-805VrZW3ZIZJ0rtSqCk0q+2pTtQrHNTC9sz0xFkeA7dYccSyHIJcDcHnzHbgWq3qHp/uWhdW/cEASAXL
-iP7Ssq0g+kPbtnjoDx3buqE/bKzERpm7xs1JiwWiqGCfwWmDAzazCzxzcFtyMdeIZqXBRmSe+nCVq6JY
-abARmac+tO0PipUGG5F56kOejath3f2/ZvDKW4WchpYnmD0e/2RtbIVv4JFVPynmrSbS+QTMsviKfs1v
-+0E0ekFS5skT/QO4FuQVbFcKDHxzN+eU5VCPrIEsGokZS6ReN9lkGic0mYPhiY5Q/49nCbytxlHK5Euf
-/FvWlG+0mDpG2qF4yUnsm5o9a6TjYJi9ZXMkgH/+fY9s6u8TltG3bM53cztXg0oK1aBhdpKesYySv/6V
-MP4nb89qcEc36GVJmO+vva3GfHFwUU1GQeTT2mvynlmJ5USaTWVP2uKHejNO+L+3ud7JHjKGdhX5NArJ
-ePihAgBmYFKMZ6NxXTx5TzFlKMXQQkilT0kYZFnI6uSE3NMUXLIAJluktBuxjNwFYB2fUC+VRhThiYsa
-YYqvL6nk+gPZE68LjWEST3ri7bSKL6peSCfTKlOcxYdvskY2OnX4/52trZqCsZo/qq2r+L7Y0DNC0vsg
-88Z8PrhMyxuMR1NGKjqddeW1RgXAFYNZru0bi7DRtTlPOmQazuA6R30/EJfY7U2JDDCAmFDWgGYOWJjR
-n8h3pMXv1y3ymrRrZI282lYuplw2JrG/q+47KOZ8l/n40B58OKuQNSczBryhB7JG5rvP9BDeytSbGg4i
-iScikzfiNKOfNWQEYlFmALgIihJGb0WTBrP8+D4yebUPHEG3O5UZSjFKXJiAURsd2Sn4MJM9ckazcWMS
-RMClwBuTddKuk04NptEcTTfyySR4kCroxFjzaaPAwa9i4cLBz6ZFMZlNwTwaxRLdSOytOCLBh3uagn8j
-TcWLr5O+jw+djcqKpEziO6aIecQmfNU9xr0L6DNdIJpN0s9o5NPEl2QPgkzxFyV6o0PWSudNsR4nzfd5
-8UwxholzahKLq5dqd23PnPCVp9wx6V837V+elTLdCwPvVjAcf/EHofmjXUmYH+QnmXCMJUmcVCvC98U8
-tzHbGO5RdcLyy9BwIJcDNAxR6qAKYiNRnS5pw91LJ0DtaRMPLWdj8TYlb80JS8EYKRJPame/Z2a2cBvc
-+tq8imPeOPRhceWNEy/sOnVcLm+cUzdRvoymUmIbOw1fOW18FmZdDyRgNhSHhMi19rxQT18HqzXT+mUa
-ic3y/HcdRYEm00IhuBSqnRBsI4Uyg9lQyJKzj4ZHwxAGUy8UkAtRbQv5ynxvgP+VoCE54vh3/j9GgLOL
-Pl5McZzoFFaXaKH3Ax8BuUPpcgf62fOKiQJiCCY6LWmp/IMERfhOuWJrFIMc7hvKf8OB4cnMPEpWdEaa
-JdZig/aMTFtyaCk6dhU8xRe7FGMjZQPV6Z2cgzUi2uxEUPb2oGYBPc/MUDPsfgmjl/VfcF2Ti7FASeHt
-r/B2CM+ZsL+A52Cmo9Ok7yBkACftom8i2rSqvVaz187FyIGmhN7ntQYBl1QDetiLJ5jrf2h63MhdToQO
-mEmp5Q7J1ymLFLaETYjaJIN0CmBPeVuVYVa0vCfNvMRqdRomfRYOVUpJEycLiDRN+mDhZ+HwA/8/jePT
-T43jU2l9x4eB/Fct/IXaV58ax1elteGrWRt+VoeYJg3OPeXKwGjijS2vUDMWcBDGiOGs50reSXCHnRrW
-vpxtuqquHJEI0kT/OTBMQrfVvEenhtmwquxxDV+hir5nFfCOmE0GIfMxtgqXksNrqaE3eykF1Uqv167U
-iWEgbdVJu1Y3BiM0BWN0wvRbbdd2rau2oUPkaF5vW1CoLEGP6Sh2eIWq/Cq40pBqnDJ+FhSogYO36iZF
-VTNGZ5BW02/DCzhiVHDyxsEZc/hreTszBgXQQmgL0fEbuORp5Kv1SgIr6gcjScD9O56oiKFAB+AQOohn
-0jvdExfoBeu91z9ZutYBayYn5VzZ1dItXAVMjiSjVEmyNybf7ZHK3ypcL/DAhl35ZyWPkhukYmflWt8S
-6e2fVOolXv9rZb72a8Qbm6F88r9FIu/2O9DR2GJ0/FDfNQd0SRM6gSA6n4XBhKmBaMzgPH0WfmCufgAO
-iSrCoBDHAEYZCm6vZEp9PwyiirzMrDIaZ5Tsc+MpO3efux6zOYknQQbHkToO4YHTQqlPZ9NpOEchFv9B
-qxCdKnw0rAuS4+uXIr+5NLVMaXplMv8cUCfnmc7iJtxyppKrjWePmYxzEdChqv9pU/HImdB+At5YcdKu
-LgqZXgnrpP0JHoPHdjRzCZ+Jyefv5R/P90jltcl0ZS/Nrdxy+kuWryYsN5SyZW1UyI0gf7Y2MpZmVW9c
-M+juPeK49Ma5QyAPy9BskptIeX5bT5w67mlAg5DEM7EkVpAJPNLc57CJ6nAbTDHQzNBXZ1EWhFqvKfPP
-Puz3iHTL/obsszC0PbPN67eGTqCeN5vMQpoZ4Td6+//Q+tQg5IzeMpLOEiYCmtCrB7BzlFdNFbzH85xQ
-Xjc1qRQr+BDtRijo+imeQTQ4Jq5Hf2xeAK8YELcML6+DOfHGDB4sdA4z9YytlNgxVfnGAR4yn6zHfWs4
-Gdoe5BNU5GlEiq7pplnknkHifujpmYhCsLz3zfiJASMJWwcCfB0bs8CV0Y2cpdzgFZdSEkejGKw2iWJY
-g1hZDCt3GkjjwWPMF9v/hD6QnJf+smtGFoTIEi2LSxWRR+rbhpRbKnez+vGhPfj48Xcu27XmqlqMaxfT
-O3BFWB/gl/Yn8VJ3QDErZE5FNinLacnnsQriiRPIA1AXIUu5ODx02BC6skXMGoxWHOwwsEGcZFeMpnFk
-XKvkGhXHwnclURTytmU0woebxTEJ42iE9kW7LUcnAE10MayC6bRS4+fHerukaTYZMJ+LFsZa2D3kGjK6
-0vwm62oavnNEJZaNKJgwrjuzh2mQMB+7dTVqDk83oQ82aZQN41G1skDcXyMFgWKibsylpwoOKJ2pcBfK
-F7AxGA01y3GWaA8T60ZVzByRk5q1/EorFSPz9ipiZo1wUjiy9BE5g3AajL/LxkF0i4jXUujcR2dVLQCi
-b4x5JsBA7JWSuyTqwaB0rXBCl1wFDbasOSaUEFLN3Td1DX7lhHsFyDn5nnTgcc8yCuLkmDY2eWsU55U6
-0EQW2cgPWarAH3u9NoRkgXtTr3/C/+fd9VZHhnqVWONkH2ZoPzwueICD5dq4sefEcI34wKt8+sCrKD/Q
-56KYaTlyx0jVciuNF9CvH5AUZT5lZI1UgChcXj/0L84buGEGw3mVf6iVWzIUyYY7BwZwPZW8E6jt/zHk
-ZQJvEjRT0Mljn5HvuLi8HFY02HIhetNYhyd8Sd0GIp6bjIWiMwX7QZCKw2cwyxqNhqijqg5FlLiUBjA+
-C2pQDiB8kPcwijNH/G5dNoVrvQJmoQz9EwSyME6BiqX1Wfo9IT/M0kyGJcprpaYLDAni5Rme0FmS0Cgj
-VQiArHx8eNWq1OqkCqGQ/E8f/rw8w7+YikzkjVW7l6LUsFJD620Yo+44yz2C85NZmoeDLFXRn6opHQXJ
-eygo125h4S0WI4lfk9ZDxbWhwLI1gspbdlS5W5iEnDcov6iLd58Peg3jqv6Uu2mwDDw9zQwGwo3d9B3y
-mXzoFe+Dd/GtwDuXb6lZTPpnzaszWeZQ3p0wTTrwE8aMameHrJO3wmeGdHFrO+P9VLtntQYhRZigBlbc
-JOvkBDL+iPInV2c18a3NW+2zyG+KZyBS7V8tbq7TIuukO8viCQDpnbN7yFNSPT0/U1cl4yLIl8LMivEU
-w6P8UhOEAkYI94fSjZdfic77J2e5RydPog9mhucUvkztkcpmxUIWNz0FkR9n+EQirV/m/V+20WmVN8KZ
-0KNJEtARQ0dad2MlG6V0msnt4yarQLrO1DYJ+2IR0ZBLZCLul4Zo5oO5lwkjRts1Dw57V/3rFYWSixAX
-h+k0lG4a6JfHRTUl1YPDXu+tkjYhxR+efyIHLA1G4Fl104eYUWzZDABNyXFr/XgDGumen9VwW4VgTgMv
-CIGfJuLUxt82yDppb3SI8IRCqeekXJyatPCF8eHhE+lP4jgbk2o/jO9rpA/uPFC+37PKb5F1csUw3Qoi
-QmGhc7PQNlknF0kwCox+L8wCL8k6eZ/QqXAaU4W6781SO4I2LmPrCZsymmmWdq/Moq8ES/kyFv4zP5K/
-kp9IHEmfE0AZUTXaLTnwcXzP9cpwQBNSTR7uMt0s7gyAXrofBhHs/GJqqzTLttsto+yOIOEyCTD95IQM
-GfOB2MujI6PkK9k1y/ClAp3uAKtgOINHx4SxCGse/qhrdjj3gWBJxcFh77p3aHBioyU5wYuhW5ZjZBtb
-goZD0A8gB+k6+DHCKSb2lrRQbUdV4wJ6zW6zJI6CBz2D14dvdfFN2CYBDWKnJeTxzJTSzbagdhInrNqu
-kWHwQKopQ6AGBnCBmOBDV5GrR1B+jivuikGIMQQF9ewVxMk6v+oZTNrcFG1ccy36IiJnNOHCus8wJA4L
-GaK+fm8Lqy60LecSpOQ0Ho3w7Up8fik6ukkZ6YYZS2C593F+91XEA66abVHY3Elu2XxKUYjOzU1km7e8
-T71bmiTxPTqpsshPwYiEmWZ5nX1dp91qtcRBt2iFIJIrupQ1CKkW45Nrqr22Mbo3QRhkjNhOwbprPmu8
-WI+FITmLxcltlc13dnDVPdZ9bVicLGtDFd80N4Sj2JulJ1ET/veCX/GVRzOW3rJl6vBBoB5i68aMt1uw
-cYj9MYvJIM6yeAIuTNmcxLOMa8/Wqmm32u2SKnzWkOm5GhtyW9Y+VS8mLKMveJU6irVWp3W13KqGHDOo
-igkULX7AdMMMJvl8NjmNvVvp+Saa2JYyctjvISoGuCWLJvjl8ZbNdfGXJp8PDk81mAnzQSVeFxJ8wMAA
-a1V+VeiL01bS1abcscEFNWWhcDfnc8lVDK6njYPROOR8QTUK67VFvRsB4dM7Pbncv+heHehGdOHc7nKT
-jFjkzcl9EPnxPZnQiI5YQsYBxNfzOzQq5uvHJEgVootubsNuLqFBasBPi1ZXaWj1jaTd2uS7dJ/eKbgb
-mprwDKLQq/JCqLCW91QnXshoArA5hhoSZPgO2CBVTLaNsJZ+kFJwUxjM8dIUZOwkGgf8XqP3d5GX7Bvz
-OjMZBJHITsYg3i6VnAOOcCphtKjZiQkOEDbJakpiIa4jtjrVG2xqYebxpjdfmppUu7WlTlSWqYaa/B8e
-naqjkot5rl7bOOb7s2hR0Y5R9M3lopIbZqO9iwVFt1tG0ZCNqDcnMt6AMHi24cun+mO7fbWtt57ttjHc
-d9edTstRS12DYJ/lJQd8B2ZcSZ7SVCjbUn/mra2TUszUuhQU8Vo7nGWzBMfByV8vuYaV3ZEODntLr0jS
-dd8zgNnR+bZdeY2QaaC0Wy+PxaANLUY9GQAHnZS7WG8YzV+cnuXdrMtA+8xH38IVDAGxEWLje9CxXpOd
-luk0bVeBtfsmnjDtk+9o9d01nlhXbAQY97MwrMsU/ljlS+kot/Qo+c3AycWUZUK5gmuEcWt0t7mt27wo
-bREvHbkrrbu9l7q97vvSBvUlZWmD7Y5oEW8G+ak1QMNq5VxHOYLLxtIOOwab+SWgbAzY5jvEF1ra6mZL
-tBrF61x/cLa6AFxyyQLY3FqheS0bj+D/tjGj+8uWrtKSufqR7iudeSn9XIsW/YhwiFi624PTvkL70b3b
-kXhVw24n12wxnAKBgF4vinZbSGNnMY3gYZbQUfq1dELYx5PJbEtWcu3XLQmwB11EF6hYrzA97faqbb5l
-8zRL4tuVZn1jewW5VeLFdXWQLIHwvEoHr5wdID6GOrj0j89Lup4m7C6IZ2k3zICC92OaWedHKdHOmmQv
-Z2cuD2BUlXaX92UWhydf4FNF1/xiuzNpT63HD3glEp6tMEZnT2VH6DK2KggcsvAo3XxpxQZxvXe1DVSp
-7iudhlyNFu2WXxx0R0ZIskIQsFuT0gyK+hqq6Wt4bWgsEu3S5t1cXjJQp+qjWyv4yj2lcZNYEbBfLfo1
-Oo7vVmtTMGnfrTy7SVKFL3nZBTObCxVb/ly52A5vmtId5nhix5QZtnmFipWOaYLXQIf3Nz+S8okh8Nma
-YvYe9/OIeAm0MQG+mNk4wlDHpTv6FfDT+BRAJQKKiWEi8mJ44cwXl9F8TAdYFVQKATQ6puCtDbEeEh4U
-wkFowtBHu0HItUQhlmDC8nrbawt8X15avqzh9Yg3okZ/2O8RT43QdNLDl9hJxCZxFHjwlEsgpClVvgFU
-OsppKHOFti2sEchBuFzja7HEQva1tz7mbRkC1mCEgVkGxTSai0HxtlRQlU/82FPo6OaE9nptsrdgChUA
-M6L/JUxQrIVGeQmCd9wh2jyMHjjTFvXQ65+Q6gIn/Voxcwli4WoSBmwURNg/uFZ+qODDDb/oZvSWIWhV
-LFwETaB4ixX9k4WEXvR7pHqBGV6iEekjmLJ65380lZ8qdTKMuWov8+4o/xERbw0AQM8g+VxiYM9nWF7E
-7/ev+cj2D09zw7lYwndwPSkQ/X7MAPgRvk7gIo+pAexEMdLIlOsTatmdns/CkFTPb07VS21/8Qtsr9f+
-UPn40GpVPhn7DMltNIfRr7MgmZPq4fnftbtsQqN0EmSERuk9S8CVdMLSlI6YuVil92axFB85YGmAu22E
-cESC+3WSxuSeAeSC3iDxwHCPYAtGkNs7DSAkPqXK97dW0shLiw0mBIvGrQmiEW8nB7Wor1nV/b7i0Vl8
-Z2Fzi+0J02ZEJhiVRF+qy1zqiBpLlUcx1JnAW06dcw6DyOG4jWLAlOWS5vGOSga3s3xwSOYpG2Z5B+w3
-cRL8FkcZDck1HZDqm+tlgwQfzowOSJrFU0SZwg8Y0YVDQeM2Gc4SLvfgICxqoOiLnL0yjDoMorzzlhzd
-q+WjG8bJPU38azroZ/E0N4GnQcTIETxqnh7VrPMRnAU8OkvBbg/eEPD6CSnyMINAZKSjahAIZ1IuFLAw
-814UjhHQlUYw4TTmaH/Hkizw5NS801OjnlgQYOL0qKTrgb14DIoMVSdOJoJBR0eP7sF78uCkB4bEMqv2
-rmzRc6wtIVyxHSKzQHr85eQpaxPahqqtnN/QOBhm5GKWkWr/olYn9JYSeILCDy1SPe23a7aLBjlu55Kz
-BBE5zp8tkkZWSqOAWmpXHBSdRKTaPykhqFUgqPUIgobLCGrZBKkT4yIi1R8vzlXnpeZztQwt2J8TWeB7
-J13t9uKjQJMxHHI6DGn+gwnZWExIj0YeC0m119WsOBkS2PD8Gca8K7VeOxCb7sSQZmMyYX5AMxbODaXl
-mcjgzHVW9sC8mTGKkwwBk8SeBm9QSWL552A0hgC1KFMb2rkjJe8eq5wp7xgBBwyl6Rckv44F+LEHOgIE
-esYywZNWIwoQ8urqx2UOV4Hl0GXBkH1ZzeXZXvgYcFv5vpJb8LNBmgXZLGOk2r/ZL9sRe93zEuZR56bL
-eWpoXnhrrB72eyXHRnuweA508Dr/cNjvFUq4A2ENIMqqGYkgIkIxQsFyxjeRN3R02WG/VxJdhu0ZXUqU
-DUlprSQYwBVGlvdmF1AjsqkcWgM811cPShXBl8OyldskO8Sd+UdCtgeJMwNPnQxYGN83Gg0DVMZnD6R6
-cn6ghOc0uOWaEygLdbyR3kpUqh+VkuikeWeT02xdBT9UDpafa7wrx7ELgaagFFXPD09LCBzMMuLHLI0q
-GaG+Dydsifq5s+Ug7/Bpx65bbT2I76PFaqt8F+6zjGuw/ZLZ39lxkPpmJVKlbml/GOXGUMuDtKLPoZCH
-qxNbv5lN4a5Qrr3s+A56z1a4ymC/p24B6KO1CLWFDqn2+x19qwTnEn6xPe4YLmmcrzbUhM4uY0CbLzxl
-HcNjjuGdLz5aLeI3OPEbLuI3/nzihw7iLxYTf8DuAo9pLAW0R1QPen3jkBHoYJQc9Po6MBGvHtjAumxA
-uzwGkUxF2niCutMTeWd1SU7XwWHv6u/9fl3CXmUiU1DK2ER6owzCEtF91XKw53LxiVYaS1uIVyrgTblj
-1XZz+eMTEI7LJM7QNbqbMEqq/cuuYv9Srw05vG3H8N4tnv1DjFnJd3/4lO5fOrp/v2TlyPFLsetf9B/f
-sWsH/XGlJasXpAEdUu33Tuqosx4c9k70eSnuhCp568lBg5CLQRrDCQ+OyvFQ2CmJVyHVg27Jpv+KOkj+
-efkmaqPHIXre9+3djpdTFxcho1R7/RMrDD1kVGRCncRpVkwqTTRgSsloBo7RfPiqdeWGNJDBwSUABvLz
-okXZ65/kl6AjkF/HnogIKnXV4Ttg86Lfa16eNbuXPeLFkwmN/FSYyJRdWsKImRbpZ5jZEHcsmTdWBmcF
-mQgToylGe+kXAqv/WNqu8hME+GsLNp6KyJtXMoeeYw4/fly8ihwmc+AGhIUpHvYkixIWYmlhPYlVdUxf
-WEKZS+H49BXSVbinXDjvKdo3YAFTzXoW1ImB76Cuz/c0H3hrBpGpN85FFxXOV7Ob9yrez+dqm8QgMHAr
-5ixb0JdqgldvqPsZTebF+P0PrU8NyCpbbf539aO/VtutNr6p/VdTvFpiTPNck1esTvZ4yx86n3bNN1l9
-fbuA6xsv0v7kQNDKvTLn4vyiOxoGPrno95xhoEVqajUD5nDRle4id6WDx1tvTs7E00L18uzxh5ZLz/zv
-f6cuYgZLiJz06hypdi97jx+iSxv9/O8cIoCzPEDM4U0ELgyQyReQpBEqMGUkBtdHCv600zhNgwGkecXX
-o6WKuQhlUz0dgVsv3AFewl3eSj9tBM2lpNp/2WtfSwdi1cKxbmFnaQs7RgvWf+f7gGCuaaZpOpswAn0S
-Gt7TeVo+wflRnQpnZQgp9GJETeNbXMjuWEja+TGcLS7fyZc/X1x+o/gQzQWu01pZuIT0lBZdyUy1mjPI
-IlcQeTS8hqG3+NDBF2S8KoAa7FxfrCSHqID+pVJ8AcakBKaI/gVCA3klEZbQDYNRBLFf1/xyBfGBp+dF
-iToKwjDNRW7j83eF6yOgNoXBgCWQJHkwJ3dZxlKFwCci+C1KNgQlfjwbhGx9DGE5BE0/WTwlYxoOgaCD
-N6fmIvkLhF6W1RQxQuWVt0Rl9FdZh9zv+MYG8Zjvc8W37b5yxQ/eF4x8IJt/+fcIpjCf7ijbae4NLAjD
-auVQYb88WtpA0v6PIWn13IOOMFw2yAq3f3gDMBdH1SiP8lIzBOb/kL+JTeKkf0F2drZerbcLBntd+FgU
-RszcQjk4AMNQeETgVNt+Ecwbx0XI2CVPNS5Z+D//Nll4bkJH8j+OKwpf+pHIIMhUebtx426Ma0/dxWw7
-WV9FqFX7hlUKZ7ZKLlMxtYXnxCqEc5vrt2aUzr+GViFGxyz9jVG6s7T0mlF6Y2np9cWUbNh0NxZTkivd
-XEyJLA2P+ToQ/xLkXKywljwWROCjnhF+vQNL/UFC7+Hqp1ZaF/SqgK/dt0E08uMJqd6oKOZ9/RVkISVV
-Ea0vS8A+Psu8sfi7R+IE9uejIIqCVP58xX9JWKTK/V39QHo0on5AZVzVW7JOjhnXHcQPP5F1cpLRUBc5
-5J1sQ1hVcs9GAY2aB9To7WfOyan5yxte4yX/+Z75+uc9+CVI0+J+8iftJFWHfl1z/PaN47c1x2/rjt8a
-jt+aZTsYYpz8S840gX7muGuWA4IWiKrmEL4K13BvzBXs8ixUNQtdVOF8VCsFT+xlWbW88afdoqe+arFW
-Ib//TtSf644OlmTiWtLBN3YHDUcHS7J3Lelgze6g6ehgScYvs4NnxBHSUIqKkkOSk6YCe2fke+AL6YlN
-1kjlxWtbGS9ivq12mu1T71Y+tx0c9va19fXd9WYHvZ1n02U6Un7xby8xcNM7ZoJbFJ/0oZVyH0RX6ICd
-VhiS7y3votwTsMTh/4vlBnZPE9/g3pHJvU77qdx7tZh7JcARl92CPx20trfccu+KdX2LLe8V4efO+X03
-tLouuvJB1999ZdcCzs9UvpSHWxjfswR93Lw4iTCjGEKqLLYFKWfGQJixE+bFoyj4Dd2b0Q/3XuZaGE9P
-eUe8n/3ZqBdPplTHsxOMZRdqen70R4un8WgWhiIBdvXqpPACD02U+woauPj5p2zxweDaGZvEyRxgzZqz
-iP/PyuYyICN0nLOTxaMz/Os6pHra79Rs9zrwfMq/mNO06GIHnUXL/Os6tn+d0fsG733D1Xv+ybu093hZ
-7xulvXfq5Aou/JyIq1WpuHJR8Xs5FVePoKLjpMI1E04qviyjonwm2gYVbScV7VWp+OcyKnIOoBi5TAIv
-jkhEJxhWInA5siALmfECBnsCwkKb8B2qmB3rAElEF+mPjkB+3t41b85l93fRvYyEjr0WJVkWcFozYdQn
-XhzGCZnSkGWZs6nNpX6M3WSUEi+eQNQCTXU0VQVAe9u7yWjQJo1Gg+zCD+f8h/OKkfADwWHTaRgod/aU
-TQJOWyQAJVlCMwYJoWajsYDvDhKVJtKN4fyh9akBrVYru8JuBFm0aJD0IAm7kW/ehMpvQvI5oehzBl0i
-f6QFP1uYrNiosJvLMtpNEjone+QDgglzJa4qKTrHWJs90to1//5Wk7tL1tb0F+s2wvtEtSM3pA9GU9+Q
-jkQJVpXgSg0vXYWyZI1YoMdGJ9/tWXyREMJafc2CaMbydUVfkE/AehesfF8hE0ajFNB/MW1pghHpCMNu
-eKqjwBrp/Yk9kDAY4BSkjWQ0uI5/bLerJq0f9DA0ZLJNog6MtOYN81MYXFgjlV1UwVVNhb6heWAo42WE
-PrTb13Gv369aLZUTVjYesmd04UzyhwMxp2upy8anTRik3cIvcRDBouJMQDjTAlSoEfeSjulUbak6yUmg
-EVOFV7XEgomTCXkh0hzzunsYifaiLh6/RGAaqlsI/PhaWdpaZJ3sc6UG/2yTdXKyvs/o5JlCE72JfJaE
-QcRy+h+FNyvAxfXZ+pB6WUxSmcVSJNFFtTC45mphB7ant+K+Bq4N4F0OudfglnHTvzICCeAmh8SLTGMy
-L3gxSggzUCnW5TfmrSXnzOJtUTyNmyyuNmrNoLZrwt67gTp7sEC5kogJES76PbLVgs7wEuo8xUgRhFbi
-8QiA9QIkT1kstEF1FZlybfu7wqfG/mH3bBGQyld3cHN+cHh1enJ+uEog9pNHcXrRe+uEPsUTHL1RiBcG
-U7w4Kf8v6nORtpR55hN/BpCnKfNmSZBBUDa4LiIuVoOQLknYJM4YodPpM8hLQTHgTqRxHsTZmNwngQjG
-BSKEyCoiiAcywtKURVlAw3AOLdFbhqlK5vEsISlL01z8sm7Apxl9Sq7PZ5AxrySLIy6czleoNIrA3cH2
-5jonUikyxB4BtjQ1NzvpH4UAdMIJS8R+/6hrQqw29X1ALiWkH0SeyMqtAbODKGMj4Y8Eb5k/QkIRyC3N
-25sQGmICmJRO2HI1SbrKfPCmaavd2djc2n756RvwmWnm9gRrJYuGYbb2hF7aoFk8UItaVuZFXEeOF0/n
-whEi7kkWWGlVoWY+6SyAOlcHIY1ua2aoQfWk96bgx9g/+VD522PU8gDa70+pZ+nkAU1GmDKv5rJC3ExJ
-tXdz4+y++5jucf3fTB/R90F8H/HeD5y97z++d4gZWL1/afyq9m6OnCT0Hk8C3A4fQcM+9W4lEftOIg4e
-TwSE/K5OgxEc0js/reUNTGFwy8wpq0NGnamlLU3iO6bwGiBaP9LokbwxeMGnXFtG4Nr8KA//4Nl+ZLyn
-GN4l35thsxbsuFzMjpvpn8KMoz904T2NFdqK0R2kcQiBcb03RX9rTu/xI80HVvdOssl6PielnCERNMXX
-y6WTmDdPIka2W06OK9FN/r985fbCscj9x4hlqvZEKH5+VCfLNWfPtA/kR8DFQBbg17hhwwvpZFqF3+qk
-XS9CjzEW9YPfWANccKABdfkP8M4fkG+x0V0SrK25kfeLYfo5rfAw4aptEEFKmJDOSfXwoI7w9u6D4Qfb
-kgu/ff/DKk+mQp9wqhNW8izy++9YELI35hIBqqExTvk+C+P7aj6JgKzcLslHAFW7g1giRjmqdkqqGshQ
-jlobdrJSJt4C/NhLSUrniISDhosXyHl4JMJYvxcyn1ElDAGsA2GwZHMQuSsmKeUaHrkNwlC5QwsEd34B
-ZGySkmQmsaHKB+AUBPT2OjwVUlAM6+Qz/tYlBW//BVLgmMjrGI/9BVKwoC6c1o+VAqjLDykHI4WmeQqQ
-xNUTN/9OH69bQoOr6BUiJFcQcOAm4OwxBPjQ4mMJ6Bk69oHQsZ9pHBAMpYBI9VQBkoAZDHLyhGyY1TVG
-E7XOeBm86xrZkog318g4pSuNTICzc7W979ba+4/p/S7DBlfT20XnqLf3cXcm3TCNSeUkCrKAZkwDmwsc
-z0wA3lecWWpwP5rSpODizodyvXgouTgO0xz+7R5pu25ucryr3hSudJoWAYE1ZDSbJUwBoMGDBuJ653Nv
-Souv4U33gXxHLlNyrbzckwkNw3mdvIA3zxcSHUokmMa+GuQErBnCWR4sGqKcylA6CMIgm+vMpYpMwLCb
-TDH1LyV+MAQ7Q6aolFGyMBDe1kT4vWex7ZJ/mYq0MqYLW4y5sFgmLtJNeJ8K6YCFKZlBrMyYPVCfecGE
-ypQVbV3z1xlL5o+p21mxV/BDFXU2Vu5P1VotDoML6XfXSyJA4Vgzt6LDkuv+j49ZudbB84T7pqV07ruV
-zp//X1U6B2KU5Vqnvt6oG4W+57y5dN9z/udfcM+5wuxB8FyobqOjhE7HgWelfn5c/Donf7DEowkSY2C0
-un4eJNXLJJjQZE4OdMiy7UMpb8Zjmvho9ASDocgTTCqY9AlMfl3MOeljRqZnEOLIeVlBzTPIJMI+7LVe
-nCSQJFk0RwXMv8qDFYOZ8RtyD7COIeLMjBmplPKlAoeNA7+w4KjiOm2eO0Lufv/dGYi3QGdcGGRcsJm7
-J6XPvDjyv35aKg6+86ZWYr3Fd5gxm/G8odV4/90S5i9n4Het3c7W9m4rH6kNhhzHGn9Xssb9R65x2SKu
-9av4ftWFboCZcDVUob5pC8ebd5ca5gI8PtLVzSA5zBtpGjGAs+iA9CBEr3q9X8Qj4uVHf96KaDZF5xIh
-kOvXqFuX3hnFbt4V+2oVXOvyF6eSnjeKPcNrg8QnLO20G4ai39RxjPSZTNPYLyIBchaOl+A5OQ43t0pb
-PO2MLIuFUQef6uD0WKQ4D46M8DmYTdA5gu//3CGIJCiPGMEZ8wNKevF0TqpnKLi53+o6tGIYeCYWQ8o0
-oIAEfcKjNoj4sVqWSTPPlMBlfQiWgJaCYo7ScuWWlvDfJy3GYlooLsLbE/NOugXmzx3FIoEpGYRhyzaP
-0GMNPXni8NYKgxT9KmSIdB0AcObS4IUAxGgaEK3CyYkZRggmcBK+YbINcSds6FshaUln5Kq4kalg0DbZ
-j0OZWYt0yBENVF5XsoFhNh6pRnG0Dhc1VXFTO4uo6luYGJJU6XTKaJLyw4Q3ryq9JCcRIEjJH3b4D5iL
-pU6CBmvUyTjwfRblIqLIK9JLYgAm53pA9bB31l3f3JGfOx01wEjgLg9icMZIyJCPSBXcAHU2KBtWZxO+
-z/JD62yRfsaoP+d1MjIQ+S9VtZfitLxjWCDAYarvO+SdPUrMz+YYaecVKtwLRrvRktBTbIRZGNH7K4vJ
-fkjRaZkXa5cWu1ID2+iUFjqWPuK82EZpsZ8YF1FZbnMBaTM57RtbpaXO6IhFGZUFt0sL9uYq+mvjZWmp
-9+MgU72+Ki0m7RRVgRsQqmg2ZDVXZhexerNdWkyzerNTWshk9eZGaTGL1ZubC0hTrN7cKi1ls3pzu7Sg
-werNl6WlTFZvviotVmS1umaKtUiquDxrAh1hTO8YmQQPTLma4UUO0kWbvmeDGBPdjaJ4wtalioXeOA93
-mOaRAvK/CmTg2i6gE/CO4oi8D26DKT/neTvVcZZNXzebLGrcy98bcTJq8r+a/KT7jDAOn8HwL/fbozgh
-7e11HLGiOLeb801eb9CvFqxoBL82pe1V+cIWpbXQvSpf36KsKXuvype5KG2J4KsFq12SrSTxVfmiF4Vt
-gXxVvvZFeUMuX5VvAaKwIZ7t1oJVXWB3u1W+uPPsbrfK13iR3e1W+VJ3sLvdWrDi8+xut8oXvovd7Vb5
-+i+wu90q3wby7JYrYmdnncQJ6WytujIgv/Iu2SK75LJ0ci+x5KZd0kXXpVptnBqu9Ytv1c7m+iDIaqsS
-1CG75IrskmOyS/ZLCUtGg+pVnRzXyX5Nk1is6yI1V1fY4Bh6HAK8m9zbUuWmSF5wheeF8BeGP2C/G8B0
-vGgQchLxhtJ4wnS2EfQe4WUxqVmQYgJZcBRM0JpDU9EIv7gw8LADsGLeGnaTwv+uYylMrpLFMW7SECWi
-osQEkSnL4LnLiyeQeGMoDELw9oXCYeaizSv/kxXxi0cs62xtVwMTDKzsLYcEZI10XGaFABz+AWphK4df
-LLJWGZ5/BRs1NFsnrZry7jXJu05mrMcH/Agit5YQ2XETKcMbEoch3SISi41Kim3YxQYlxTaxmMmZChdr
-cJkna6RC6vyfI/3PAf9nraLYBC/oWZakq0SX6Cd1N+skc6FBMyaw4Hv9xIuj870fuWFwQj66f0s2WnbU
-uXiKb5nx1A5iXc/37WIdWJYyIrWsXqdYD65KSytuFCuK+9SympvFmuqmtbTylmOccNtcVvFljfwDkeXV
-3dMmHn9e2s6O0Q5e5hwt4Yelbb0qDibNkuCWSePA0tlzTJ+YdhEN7J5a66Oj2UWTu6zukuldVn3BBC+r
-+tJBtZrVZZV3nJXVRC6rvsJU2k08y+Me4Jaw1bKCvwRaHD8amwUlAfKsmahY8ZC0t+2oRd2UrMCP185W
-rhjkpBF5/YI7kSV/OBeFgogMZxCmIBv7dUbDYBgwH9SUpE5GdTKoQWhSI7ehfUs2HLzV2lIfg8Mhyo6s
-k42WChNzbDpWS80mOQqSNCPemHm36F6gNTpIuCZg78V/fH/O5GFL9vJnr14tgEOhCj7HxJR2wtDyYcCH
-/lXv89Xx/u6CGmJ1Qx9GYmrCz/c9smXk/cynZMSnBTVoNZtGAXgxxyGi9mNSAoFznJ8wLMvb1IzRMajp
-2D9B/e/kWBdEO+J/uZjHJQz0zIyn5aLgWHELZ+Pg8Kh7c3pdJl3fkk2HmOo1lxPTzUViuvmfJqauYSwW
-U2Oz+f9i6mSgV5qYdxUhKoqlbMp1Lny3R161yF//CsL37R55ZRx1S/bTVy2yRnZ2y5ptt8x2261Cw6Ur
-gNeEliUHgHRx9LHsAC18aTGlxZEiFSW65vZ3L9TbV5SIesWoWrAcrqfiOY5IuBR0NHuks9USyA7hKcF1
-/Fkqc4pXD/pXJU+CW2TdLtwgVywFG+jF2xq/c/f6J6RF4H6+TdZlk8Vn+MurGvmQxPe7Hrj7fFINiTYS
-sks8cuUYU/RoL0f5qL21uoNJK6qs+FK+XTEvTkl877rkaW+HGr/dWhH65eVlwhRdZblfB9xK43szcp3/
-+8rhK3MgfCInArDexIwfMMIiyLtJ7gJKlDA9UvyiP1b8tsljREpaxL83hIm0t3QTl/iKDQajWaqLt7kE
-k2rCqD+vkTh5JsFm5ec2/8xHj0XAotYxGr45eFtotIONIhYP83m76gu0J37Hxoy181au/2KLL8kuaZNd
-0oL/H5HqeZxkY9KdsCTwaGTA5EJeBZpmkJ1JRaymaBEkWUzeXW/yTZTMppjQ1mdRnDG9+cBIVXMAuvi2
-1Qb/JuZxbVsYFrc2NOWnsQcJDPKEb5ENEqmv9I4GIcX3xKFwvmX+ehDVVXeKVVswzPNYVq5jCk2n8H3/
-9I3i/601vXSLaj9i4/vwfbu98t7XeVTDnUc0vP0oiju77d3W7up79tbGY5rfUg1b+RyFuyBEfCsQMXgV
-9AIfINzh8T6LyZj/DW4zMW44Ogm/8EefpbzkfKrP93eAI3Gn4VAREEcsXMOl/JzdsaTQheE5/oZ/CtD7
-3o4xAI1CAgiI7d5wG+8CCLuz6WeYrDGK7ej3UXDHorpghcquKJ5J6+J4CVLEY3+0P+530yUOufFQPycI
-v37w1Lq+cjrePJ8+HmotjYfZlQNu7Yr9OmOpAIhHnyVIDXW2WnbNPGn/VTLU3A5XVszUJoto9YiNePo0
-yl4s6fI0pj45PTxIoZvTVXohBOFGcnDbNCV3QZLNaIjtxXcsCek8iEbyfUXNdTAkNJpDTmmaFHPwcbp/
-XYFTAskmm4cCbL13w1WRd9dbnZYB84zgM+g1gxAuxmqzPxSclDqgQIP3jVlzw6ypDI3SA0HXsT7lh0h+
-XQkuZnEgnw7gM8P5yuIyn4JxUtoGMKCqnRhXj+77E8hwOssWo0b/KFyZJ7Nk819LSoEtLvQgCcpuLiiE
-DaLJyOUUDOZhIx08Zn/jWr1yFRQL8jFp4GC7WmHdY4gbX3lXbARXCTg09t0+p8lTMZkkUhwG/l7HU4nJ
-1vpEvs9BurU+1Um7VSPrbfJaPXrqyvtoLhf128X6bVmfmA0UZvbdNY4dx11VlNWtfhZhIigcgBa+DToQ
-eQsusYjx/rh5/D5Zkk0aURMNf9UgIlfMy2g0moU0EXkEDw57ve5Vt/aovv9rSd8AbSxkvcoVgEb/p37N
-0VD6VHBj6OGP4WO6eCyIVEkmNAqmKt4NXlz8jNepSxwO/r/sIWNRGsRR+shVmS3z/Mb3rhVm84rP5uM6
-/68lvfdXj2B9pDVkhY75BhpEo/UBZ/EdvyvKvC/77/KayGq9kqXMxmVaEGBXY7OvwM7mw5vQZBRExdGd
-PXl0syX7Qjydl+wDV926sHogZPdjxehuWSZTMK4dBSE/0gQJQrE8PLp6ZG8fK/fLphEvICoZ6KU28sBC
-Ofz79WX36on3kYdlkgsnuFqvcjs+5BuEuIR1e4ePHPM3S3o9CgBN2zG3R1fdWt1Gs3/c3C7p+S77nAUh
-A0i0KtU2gPPuxZh6t9DnTXTOsjfUuwWfuWrKGBE+uBHLeKn74DZoePEEXXDfGU0O5dYTRMNYgSbbV6Mh
-TXArhisPqQLuAqFkFM6nYyAAwxDhb+da/u3paAESLEA7HC2+W+SvFkZsWd+iuyqSRqLkovsifkFEzVpj
-uWNZJ5+Y3/kOUwD15dw/QIy7/BDan5bC1TSbkLpYjMMVHbekT6mj2RAvuIdISymaVTEd9mHv8PTqaYv5
-o5j6JSH4zpV1KFdW64kra0nXYieRIz68A9dO2EBOD5863H+stnXKTvWTAphwTi+f2u/vi/sVuDNoEcZB
-nvS+at8iX5a9twDOyyVJrV4PvrLXf7p75av74uaqd0iOTk4PX2OB5i9pE/7x+S77rK58nyd02vgl5VX4
-iY3et1WvRjqtdgceL3rjJJ4Eswm56JPuLBvHSdqAjEZQNgVzX3LHZ6LZJDcpQ20tSIlI2+AJU+covmNJ
-hNs1Jfv9g3W094SBx6JUOCZ7NCIDxlsagvOAwCw+PekdnvcPyTAIWePZs8osxay2XlbZffYsDAaNJPPZ
-tFoB8AaAIy9E1E3olMSDX5inElP8DfY78g/89QuM9viUF5zypQ7KdxBNZ5mJgZnFJJ5l9o/qtQdauFIt
-SIhVOsviCYWo6XBOvIRRh+QaWW7M0yGiE1Yno3BCpwJClQ+M8BU7jpMMEfbRTB2kdiKbOj8eh7MQPvts
-MBuNBCg871lsk1B/D5oBU5Rqno9FuRlnMfEgDDmWfthBChwN+A0hzWgYMpgtSOpgtg9ZG5SL89e2fpVv
-/cpsnZ8QyCl9DKBBGX+1tOL/2NlvTJM4i7P5VKQVMqXBlIOmQgLPLNIbkjlQmOyh8Cg42VF4y+Yp2SMX
-uBj4X1VdvtaY0GlVdXjLjDzO0gn748eHClkTSCm/TanPizU4R3qxz7pZtVVrZLF4y2lvq/TK6MIs+kpA
-7Ng9uWKjw4dptQKvbUidwEBHCPRPlTqpjESOAdinJtNZJkZ9ZY4akOTwcUii82KiEYl9yYUMRg/hYNmY
-BQk56++LpDKCsgS59g/MBC0IGsbJIfXGVWMmLNYAZ5NbNid7IpF8g0tQT/AEi9ssIn8lrYedVs1KgQ29
-f4CWPsm3TJiXD9CCyHv1pTEIIgTyrencC1DLPbPJnzSzdZHNITe5ScnkJosnV28Yiso0U8kYBI1pljQS
-Ng2px6pKlEoxL1VD3rhG/iHbMNjqjT/l2Wnz16Ds6rGUJU+kLFmRMhNswNy3BHK3LwC4AAhRbVh1vDX5
-bMoiAO2Rod/gTQBPoNfFvKhlm9WETlO5WgQpCKNznIcC4isV9kpxG7vL2q1WI2JZ04+9tHmXdTqt9WTS
-zLjq3VnfbIyzSbikZ5kRhouas1QV2F8RuESVupL4yseH7VbldeXjrLPlbVfqsHv8D1n/jvgBncSRb5Rr
-i3KvOqIc5eVGCZuvD+IHo2AHC262XomCA15w3MyMMhuyjCfKeLzMsDk0ymzKMr4o4/MyXjMxymzJMlSU
-YbxMaLWzDWVarUFLlBnCANkoYcwo9lIWa4tiI15srblulNkR3XU2RZkxLxM1Q6PMK0nSQJQJeJk7a/hU
-8LK9I8r8wsugG/o6qJRG4YEsLOm/5YWzeFoo6YmSiquhLBmyoVnQl03KcUyM/nNlmSi7IRuNgMVBxNYh
-tN4oOsSiGwM5GzEvmno0autSL1uylGTQVJbaMEpJcWvJUf8qS20ZpTqyLUlcIku9NEptyFJSklJZ6pVR
-alMyRbaVwUDZMFvPTEl5KYRuS0nBjBeEyciV3Ja8kyXvDD7bRV/KRmXv93Lu7HI7ki9yGT6AeAk8onVM
-OaJKC2HsbEsC5jimNF1nv86oIbcvqSy6JYr+JtY3zVhSKI1C2drw5PT8g5eeBkYRTzYoi/wOiyXOCo35
-YunRDVHyC7ApCbIgHa9P+SXIKM3kQn0pSv8T1nOc6SNYpprEFp68+26ttvt2V9p9xXDs3bezUXlN7LH/
-xT12c1w3fQKZgvlw6iSKJYrJsiNKwvQvI3WWVuroMG5c3meZ93RObq/Gyc2VyPM5KU/hoy6/2ZLlB0yU
-/xsvv9HcNEptDUSp9oZcbR94qUrwS4WEwQheZkgVHY5GMUsRNo83Oxx+XzMa8lR3cgv6yBtqNztGIV8W
-ein3gE/m2iYDmjyzl6AY8o65BrP7mC+G1F6JWHJ721yKQ3sJCgI9cw22m5v2yhOFNs2lR72ZCJIyhVRk
-qX6yyLxcTWR6OWynYomtlYRqiOTaYqUEoOVtWgLQrZDZJKSzzDXH/rY5x5ULR1nFbm/LnGrebgIuRqqk
-4rnvmTyvzFSrti6HhdkrU5mrsIqYJJf4sE1TfCq0SK4WoKElQJXYUVYNjW2ZksTbtYemxWlYNrSCTGFa
-86eK1M5qInW1msAALX/UNsRa5jbEmTVK6B1zbUZakf2AeoclL2qq2EtLCr0K8ZgfhCF1iSF9aYphKtxf
-0vlkEIek6sezQchIWnPLzytLfpSsucTnlSU+MzlKl/TsWNLDikVZYf8D8VksOjIj/pNl6NVqMvT3R8gQ
-8QRRtjA9TTiYfVLRCvGCxJtNhiF7+GoxYdTarVhJ42pmmDxc/xuPTau8a98ablr7VlxS4T9I+IaD/N6V
-Y4kphMcsmXyF7LVbqwnf29XMAUBMmcypHeFvq+4If/5h6Vt6UeXGcQT++cfa0FKQcodVTjj8oSkcLP2N
-ZcWdCZABv0Yq2qtJxU8rSUWA1PxR59rXiNGfcM6p7eJTmV5k7EW2DjUr7rqGsLXywpbfMLSsdfKy9kds
-Q8xWoQKzqCls53Fyz0YBjZoH9KvU83ZnNak7XKqfb68kl5Ei3KdFRV0LnNp7/pbbe5wb1ba9UR3qa51z
-r9rJ71VplsS37A9S7P+7dFczFHv7gKSLt8BtWyqZa3iGZO7kJTM/vD9Vue9Pv1IiN1aTyJ9Xkrd06hCz
-f88+SNuWlD6vIChqxnynkLYtIT2vkCwIfaeMDoaWjH5vNOwSp4G1yeXmW0tR25KiqNC/IUQvLSHKbd2W
-bNwz/6tkY8WHlJdLd6s3q0kP0lu6Sb2yNqnD4jH0H2l5WGmD+t9peejfB2n6dPFb0ZK8t6JwBWlatjEp
-reUvZVrLE++VrywxLF60/hOulLr8sKBDfbZ1qD/m9vkfcMdY6QIK3mEMndEa1PerFXSHozM/iJsDFoaV
-OqngX/FotDugKdverNSfVa47fnRz3+111X8H41/fb72Ff54d3R3+8tP+m9FRZ7DxQ0B/PMMiP/VequJv
-vH38R2+zQtaeVbo3r6Kf22dd879NGs76o0P4N0tPNg57G83Cfzu3B/7k1fynSfjbm793u92j8RQa9I5H
-s+uNH6KT44fpT+HPd97kh6k33//h5ODk/uzg9v78t+4WdnN4JBt4e/PDwbvRIQ7r4Ojs5Ox9t/XD/juk
-sNv9e7e7P/qhd3tx2/n5h7f0/U3cH29Nfrg66fcnYXh2cx/8HNwE3s1PP23ePzyMx7/8cvDm+Pj44uzk
-4Or2iNfu9rpvu5MLaDBe+/kHmm5u/fwwin6J3o4u3ocXF2+90f7m9Grz4PaH+7ubyU+d7Un29udkkG5O
-f/j76Pz932+63e5J9++Ho/H46qrf7x0fHR2/PYEGT3766aef4tF4/PAwn/eOo+jNydu3vwaj0Sie/1/K
-vry5USXb8//+FHX9Rz87VG0BAi3l5+hI9kWA2AUTEy/YBIh9kUDcV999ArDrVt2+M/NaEdg4SX558uRZ
-8mTKJx8EQerksap4SZZveVmi6HabJBBEccejp2ta2g+w6VybBmLO52GcAcdrURTsSZbD0Pf3KK+kkgUU
-EE1MUyLbcXCcICYKaIETXNf2p4Y4UklpA0xMjGb+4myqqvwM2Kr6sVVHCdLU0z4ZVGo8qyJk6iYFm9Mn
-MOFzkJ/PQTFdsJNzpndjYefGmR7CmcEBNWOGc+ZrBpxuVuzmcthMFxRJjGICAuBAAFfZ8a6CyyVMfUxk
-lyNjzm1BhKcT9YAAfJpwVVpLfJU7dZPn3gzY5UnT5Ztjm4zHNnpQcd1P0oDPgz99BLzKa+evr9xxstz8
-cc2APxf83y6FuXJChAMQ4WDYUP6woVLV5NJhw7V4v4z6AwAwA069M5KR9q8q6486648j648DG1A6n1Gj
-dKD6EwFgB58IjgC3kI0DUR1pXx35ifdGstH9q3leNGXcnH1oc56Yb/47H5tdRnpiDcEETuXUM2AEojFl
-qGUwlvbtUolJEnwKsAIAl8QYQbgQ34yjnsr5zYpqVfOgPcObfFvc3NzON6dFUyb580TUQbFhHJNCyH0r
-ygPC9fcYT/J1VvKFkhcnOVQFD98iFVRV4xgXRQF2DGMxvr/HKqgq2TrI7BlwQrYCwsXqBKP5bhzL3Mgf
-IXwzNc/foxg2NfQh/LW59ceWwlBnGMeycHPkhhEm4vv7Hebw423RFKWIf9KUBSDKCAW2cTBbMDVG8CtT
-2Fx0sVHGjpW4ShidLbQTWcmR6EdVhW+la+oIM2BtS4oRp0V11ojJBM1jCQiKonmOsw3DSH8YAIZhBI5z
-Q9+PyroWNC1JGkE4nkSxbdt23z9mwMd2JMdr07SiqCh9P3QSLxzJs2nmstSFbuhvUUxjblkXOK6wtQwj
-LatKA5CDf1gcAk9TimIY2zBmwJmCKn48krwoOU74s8ztCf1EqRv1T9dprw4qlZgqlZxVMYF1cYQXA2vS
-5jnIYSfbnL0ONQOEPV/gzeYCo/CFRp3Mcv71Ij4s9twixXGcrcysmQFjR02uJMsWnCQrUY7/4OOHTqgb
-yhgwyn84VKrtuFQPuHa8cJC+FiFIlWhDVyVjMANINeEZEBqczBidABrNDILNDD47GeI5mbULDpbnHDbr
-4NfrEMAfpj8CChFxY65xSa5x1w8XgEKJhnMa0wAOOLOWEpHA7RKZQ6+aw6WWw+Wh4xSJU1e5s6tqlxMW
-MzYrEfXhQiOXq4tFsLm6SLimSDi0TBynSpy6vrl8+6h3ze3fuVK8XMSGKIEyO5zgwc+XqfGU+eCBz5Fm
-SgMxmlnak5SvwFw67LhWv4gfPBQPkC4ezLNK030kzYCfHuxz6tDzQNR2lD8GVGqYXAebHAybXGeayuH/
-Z4AWsfnpA+uqBI0aDvHENNITS6+KYjsxTgh+hRPeFbYJks15YMWy4sYDnlDVAEaSTCWu0JQZUNH8w43n
-sL4fR5TmStpQxQiFMglFI9IX8jNVZDKIJ92mAE1NnRkeJEsx7FHW/DxSRXQgHMR4kOkyKJVz0jQt7bI4
-GgXNyqn8dC2Ek6b5eZrh8TGpkJTKZb5RZiVnJ/kktqyS4gauTCrFcKIRlVXsqNpibZIiT+mjIIaGb6Ql
-VmGDPl5TjrV1QVTcIBpAVamDPiYFy3K6qISWHx1wfJqeceIkZSRQkghyKGPxKRThA5QF6YiJkDYomTZI
-9DioGTxIEowZ0KgrsGGq5mOQTNhUTWRwJNOzM12VJP2iGqYq0aZqmhDmLKNsemY3jmqGmKq5UR0T9pwM
-GZyViTnwZpQkayoLJhAThjHncD443dmJfjSkq+rS0OgsowzvbNg4q6ZhmpllqqZ1djLrEpjmwTlow1RZ
-khDVNE3PMZGL2ZmOc0DPqnk2gwy5BKalOivrENLoxTksqqfGMwXwwYSnynbgZLYXwJtHXOV5Vpd1UudJ
-Utd1Xnc5Ujc17JW3pC7rRmjzsG7qUWjrVdPkY7OoXpLUTZ3UbfKo6+TRtPW4q5Ox6eqkvtUboW0Ir6nv
-TXfbH/vH2NwKom7qbdPelnq3etMMBdvcrvh+EZukOFb1vb7V+faW75pbwYpdDvnltqnvSXPsG1Job+vm
-lqygQKNV83wOMnpzNRHTOSDwJcAw74BaD0+/rWfA5nIX/PC2he4RNiJ7/+Y5HXuUbsxu1a323iiMh1jm
-tpmD1Yjn1nXj3Op6dXt4yZ1s5I4wTPNwdsxsMwbBostqcNuMoWwdQqmsm/qWNMf7RNWV9Yd8799G4J+D
-Frlm93PhCR2xga+Pk8+PyN0bCnYn305I4Mp3EhTsDHjtJw2gZg2g9hUoNaoBJUCBAhgu9A1jAPWg4RBF
-H2tOUA1RsWMfwoRBfRgGVdWcoxtCa0cmhy0Tzgf/0Km0KgVH14wojCsTF9wtY8AZ37SuZ9g3KKpwR3A1
-xICyqq59z7DKXrUH8MC2ajqmKV95iqXlabdMliBMwLZESqa8ULmKoUFlZ7YD/3CJK5TSXO0qmgHlVTc3
-ZKV02gmiZxsWgg5wPEwNpVTW8YJvf7hRBOqGqrwOCZ6OacEXvKhNrWUS72JOYkwN5basaWndZQ6vjfxD
-nxuyZVlL06qreEEXGANOq2Xm0NqmZuX9AFeD5pHW1C1e8F1Ny/tuyCpPP5owRZfHpVt515lO49YbxqCq
-hncNW4PqoVOdxruy+TLHTquqcRXNgvJqUB3vmmyYPJMayfUsN0cHLK48T99YeSEJTeAZRjn1qLp63sbM
-i/udExb+ZRKfzIBusmHgXOhKR7e0pB4yh3c9ks2RrBZlR9fsNK8Gh9euxNRQ195c13LzfsCqSkt25xwp
-pFa6eIZV98ugDFWla7uzZRXy6XYxLQBIEAERKBzgHZdUUt4AsyGlGM5oMd5V8cdIpjVhHEVZCys/HQhB
-y8yRopZIivfOE3erIbAxgrAyOm3ro29a7iOtJt4cFQDKD0OKpwg/KJRAmikPKGUqY7hJMIHtVGpCLjMH
-PElpRlS42rAnEapU9TqNNsOKspKUjr3HcIHIKYMqa8E2FC1Ns0qZ5imlP3l4TyIMC39EEA8+olEDGFM0
-OrUl2nYU0MpDiBlKp8qitinFim5dEDiauz3TI13VjafbCki6OLAf2uPMwHSVN75mW0m7uAAgjwAoZNtT
-XCkxSgZ4wAIK2OWgXsdrVlDMUZTDyJdANGDxgyKThik4UVaiyJyoJwiGGsl0moF9uNGb7cdTgCgwJEVx
-XOXomqJEcUzzwlYjKIriquqsKEqUxBnNTb6pnvt6xFWHlYnUxScXSwA2/aCQA4N2TdnSURnBt6OM57WJ
-Ooqq+PrMiUqSVoNpY/iWMTK6aTlenxQBDmJccLUNY6WS0Pim7W7RZX5YBU7SkOczTNdiF7quC5WThjSj
-lwIg9TTeEz0YOlpKfJvQSsghDJACH0QEkdKMPPHdsMtywLBhGWWCKOicPcmCa9tLFDBNQKcwQhbFj8hg
-qOI5tGA/owX013DjM4KYAZcwIgWAqPrJd7c0nfqooJbUNO3lQD3Nt3Hasst+GOLIIz7WG0TD1pay+Op9
-lM2Aou/anw8+FydE3/6XBYv/SdliHOiuabyzZeW3bgod3C0Cw1nVNAoO7n3JgtOeZeBlPIlHP/OQAigA
-+JXMWVmWFTuIObvaCho1A04iVBqSIGtRFtO4IGjZqKeVULmaYaW148O8JiRWlmWTC9ANKy3nhvGHlcEZ
-X0/EyFGSdZLqLtZmi8AIRXOCe7assu2GDBe0GoERRhAl29GMvO6GIE4akkmhjG+PUze3jziTHNcdp663
-neCdLbt8LFHAJCKam8NwLhylwHGJnoy8AoCISnrfds3qeCKg8EN4CQoHQCUWKRAVJYpiYxKZLTErxbJu
-Y6iCGNp+sCynUFeSLUpuqRzzYMDiAaKW4EaRb/6nVlFG2hQsq8z1MhonJq1apnMUx5W2qmlJGkyVhbly
-WfH2VDnPMpoXBNeilnqKpiV5ls2gH3Gfo2gLKL/4FMGdHnBc6ajKXJnmhYnSpbKqaVEaz4PlLgDcJ+jc
-kDFNkUm8JwAgTktYwQsngct7CPU50mCunBYRgAQA+EFcbx+F9SBIuuA0S0M1xY/x5BjTWnLN2NYyOcY3
-bNhRhkpVdSO/L4AFI4iGsQpsqBz67cMw07JM7aPm1w84aHt8ClIIAHqKoMbIADiNX7mJqhgoFRCIvpfA
-cAYKheHcEi8TUS8MqDpCeUoCnKAe4KEmdCRSsVxHLp/IEW6fGZLDUSBebQRK+nsptoA+BwPMcUqEEww3
-KJSRcsRiHCgEQ9EIPt1EipdTKyOjJQxFAQBsBEAaQRGXCK5so/K1XykUT6lpLOgU9rHydAR4BPYgIhR6
-Bky4hHPK7bVfieSPukDJfl5CJcBHK2AJFgkQnxwk0AFQbLn/eCIzy5Kpl5ZzVJb7O5RUo4d0FbcHHYyy
-nu7QoDjevLBvPTfKnUSq0AASvJYaNHvNbc6G3vNRGjpBAbM2OpbXGXCzWdcHku7uGyOB19d82GWE0Z06
-eL9u7ctBTCPQAkbFg00kMie0NVY1cbLxx8FswMau+bK1NhayDi9wssTL69vGj/YOs8Z2V2NVnXKPoXgj
-Si+Fy20OpHeqhyBwqtgT1ZzwsUbIUkNMH8M+4cpbhAX8HZZt9uBiB3+1UMiE99rfOciZDsjrcV2zY6de
-rhZlDfzaTZhwvJbx7cC2hBMouOAlLGR2xnqT77GMZBB9vRpSx7eL84paAN16vK8HPZJLnWZWw06TagWV
-ViUAuDbczHO/2/EB5uW5nt94Nz3vMOjAslDK2MIdqKeqv8gaOD20E/CRRWzSIiR7HGF7APhMIW0Bw/Zb
-aXdgeOI6oKtq65JGgOzO2l19nI98yhGY7cDX4x3LKjl29BFqV2Re0HCwzL6qrinErUFGnAXD53jvs3oA
-rbXci2QQDP7D6sUp4myczUXSLo43CondCOiqO9VdIZpHObRkBknNu2EvXa6QE7+OUyLDXFqVUf3I2CfH
-54zN3RbhuIw19MrSWfGw1oiO8buUabX4bOl7CcLM1YZYM6eah5XASlrmtBhYamTrM6fhJy4pLNLodlzI
-39cXTO5GfKj00XGUFWFTMXu+1AzqAlzJkh3C8LGElvx9FZ99UAF+ih2sGXDPII0Lzqhk+AAvzG63I0YH
-4Ktjy/pmuFLQeKXgcB+vnYbXT4OGB9w+j/QQBHqryCVtUEV0wFnfPMXDDKipqp0KlsOfZdo+bU0UUE1S
-8SV1PUcA6XnVYTVqyHky39MQ2EbUuY0wF3VacOS6RsR3q0u8M4Tj3baWiD7oWrKnL3A+2qMG03tEgmNE
-fnRIuNvisBJokAZaJYmOJ1GOeO3Aq8Qups9gmxrtkZYEEvMB5uvqPdKWNdiTv6Gzw2GwNoqerEVW3ZNU
-vrWKu2sqQO/VWhWuj1454E0T3yIVAYLenpRQ8dMGyBEpx55OaY/mrGAQMQOmUnUOOutq7SxkvTGu4Xlj
-HLCIC9zsooIS5LVBJX2FbWIi8Ik+i9DdxfcuY5YrIohcPI13/urikxG9WuTw0pPnyxhGx5N/tPkWAF4B
-jX6+FvH6dmYemzvMppvK3K43HdbcrD182VbNxRMRZWNJ5uOwx3uj84g+puNlfmjdTuF9K4dr1HFpxfAj
-oTFbNAgvYwzrgAQwScWYtzEz0qWIHvNW3umSCmW76ThwCzd33pGTeBO5m2y7WJvNSbtwaXO8Qyeg7ben
-U3DcRfvSR4QulGhBGAU1jOU7EvElfcRP59w930+4wh0jviwgz7Pwdmxsx7Z7areEtxlyXd31wNiZVYrA
-x8SAFPd6Sh89AGztGQS0tltbDjw03OJytaMgn0ggFJRrPSL3jgnEJNjGa7DnQnwGlNJqux5aAAiHOlI2
-l64ed26rjLAkbTPu0uHrLSdaV4uX5Pqki2EA8od73VItpOBpxleJZllsoSBEaS/bH4wF8m4FRUcFpwUC
-LypEMQzFWcNd3DkkzhsVbdl7ZNygpV82+BYpz7saxx5QeQL39aUcWATrzTzi2MtivgZabvZZ6Ph4DQvD
-xrkT9QGP3O3mAIYbeztJx/UOtgkapR49X9UsDc7bMw21V9O7Aam434Oj39wudi+LyyJGQrrbBKDbPbAB
-wLe5hItnP9LJPaupNY/e+z1xBRlBnQABtOy8Bqf+JPNCdhgmN3rKi/COhFa12ZyjxdH3G/J+Gbf5g0k3
-5eO0t3WhJeT7CCJwVBKohP2tNLaIjJwiJEYBwfEgAswJcqUCGyCcjMwLu7sheve4LI7eV7mbDR5KjNPr
-7K6poOsisNVPnuWAQ+QajWMBgwJgRQ7oTtmsm/2OHQyjdnIcwnPjJmbllb6yHRyRy+ZCUdxv573I5e1V
-qzErHbWR0baITHGZfLtYVjgOVnXf0nhERryZdZczbXcSAEVtQINSkpB9TM4l5tOYsgSPKFHYcIcfQeoQ
-MsBB7KVrIK7WoNcIIsgsAIDo01dtV/benjHJ8R6SiXMfC69DOhr1jlgZSLAtZPu9voRmFMAJ53671Aeb
-0PFO7E2gGBTomU7KulFz2TsgbWKjD0fzWicqWMkOEOPRr6lI9YHcl0R0D/Sh1dnjEjxS9HZVyh6yOgFu
-7ycnxT9Hu0q2V8froGh35HLN6dt1g0ZMP25gaO0xwnbcDJG+3+/CMreMI+WSAYT2bLjs69lDcPUjE9GG
-u9YXkFk6Z16tU5XAgKas85tZAqM970AEFBGXXLMHIAO4Ouhr+JRf6mOtqRLpxWcPWnTZC5sK33gH9Frd
-LMq54gSJyH5wpitCoCKCvPj0Sez3AADS6HU0K4wcg7K8bypRjE8KJ1y3N4jaXxrkvkRSeBGJUsBljdMm
-0dWNs+st2ALajFbdeO6N4nzc6Pyxcq+uJQDUnDfaE/pS85EAHGh/bjptEwydItn+Mp073W2K2RPZvVFU
-PsLDKu4LyeKvHVPn1dakTtodD3cUnhibOuI9BfQkegz3RyCSYsx6EgAgi4RVR7fYYg9X9oP3Dw+i4N1N
-MxxPt4wphr49mwe6TZEUPSUtAVhiT6e9x1CHiJ9XSYpH2l4hIqBF53iMynEvruiP/eWkt/GIG1YjmxA4
-EECGC1JFw6184CvTf1DJwW1gzM7YqLl12OXEF2nAb+9U75wegFVwjiKNJhN9HIBlQRIRgAYp9Yrv1ZZC
-Ae90YgHILVPIdm9nMufcu9GQgmsT4rtLIqYWBxE5jm8BBwR/sweHsqXoTNcYilgM7Mr3QpUkIFetjmwt
-narMF9a77XEokKbK68e5tXlLTda0Mm8NK+lBJICceI2CK4AkyLauyvJ064IVtET0RHiItgkVJPY5MnMF
-cOgK7duUwqkEz0pJ2QoJtBZ0BVKu1uU6jMkKhLezUIpXyrwosjM20OOAwvUxEpEP1RvuniPX/uBs+W3a
-ZM0VfSDXAwgigRy2TMG35jH2fBS51dgeXZU3LZDwqiQSwmSbcWWNBliTZEfugf6xe7uJoUwkABh9+r7S
-VhdNk9LQtjK98jZofkHUS17XfCiGaRaz4HLbmSWgABEBUAlq2h1XQqqQltjby8zBmL/vQQhttYeGuDrA
-xrVW8H4zoJgfdnV6VajHbcMc8K20UrcYZNR72UyiXS/nW+tWQKGLZgJ5Kl2Pb2fArXusIy8+jdUdFVQ0
-kckk6/d7p7J3NczJou8BDcjA7HKfKErPb1IGFRhtt3aKNjUtVaIPPKQarCAXyyg/HgcL7Mn79jgFY6pI
-KAZAmSbpLqaIZL5zYTfKRl/fHXTHIzYb+znujtdL8LiZCBYdwdj4kL9EU8v+MhFvT8FUwDysK22hD82/
-upaL5IxfXtjBGsJeAMcos/DqaNz7Pl0ZcoyFEvMw5BZeU/SqcprVLcDkcQlvmd4OAMAjpMKQY6JFwF47
-ZibnSUZFbLFlNrLf29eR293lK9xth3ZAtU2G24ctZSg4vQUcDvKTzaKncrE2NlHyAJBWuJId3kl262G3
-XT/Y3XG8HETsPKpCQZ/yeyi2Saawvfnx3YfNUSH3J2KKKcnIhZTbFT9+uNEx5FF366wp1cSBkQGKrO+l
-uMMVHNxAdBvpmjt2+ZXdCAHaX4TSK+QYbMY9VlulesoPcV/KbEkCkC1i4/UkANiWZsHtfMnYcnMJka5j
-DmeDDMHBzm0CV6Dy1Kxhhdhz9xsx8bvyDKAQAoKIObWRd3sTeKyiLzs+J+4klfphlPxNhsjhTgfmEUj4
-7aLvxfnbHcyooydtP92X1JFhPeTmEn1P3WHKiukmMVIPuMBBduvLssnlFLpz9qxxg/dWdTVc0RHU6y5w
-IH69joxQDyqT6gFwZLqVBgFcFS0CK4CfSnvPX7EH0l/DPXxtz/56WVnSZcXSSdvBc5BKZFKb/Q0gEn+Y
-KKJwAXQHs2kv7RqR4IMoH2Dt0AR7itUdthgJTLTzi0IB4rGmcf9jyRSQAPPRKEHHvQqkdp1vaelslgfS
-RLc8A+M4eUvrrF+bLsbsFO/GmBq3QlzHLY9nvgnMKzJmxMHBFOpjo5Ae15gyRqaziPu6PPvDg9haF/22
-gg5muPZ32y2PmjpgDDoHGLwygSQka+VUnno7skEKdjAms6gGLbsVmECy9+vhIIr1Xj/R/qYpBadg5FKH
-vZyLzcjfnYG4fFlMBBZsekq/idxqlCs+WAkBcvHRRJO3yGXZ8bkfVnl5vyVwb0gncIWwg7SRzWFM0cjc
-bU45tac5gNBYrtabHXX3d9v78Xyh0YbEDJ4HPbqNre3RITysXbYyj8GwSsfJHuKr+PI4YKvDFnOI42mH
-b9ZmprPEnaSETon1HBUSIgIU8JqLZ+mntp2IPldhCHdmU472YhwKFO5J2A3tLhUubS9ha+18kiGeLGJ5
-jWauUTbdfd2iMHJ5eOG6kKWg8EuCLXAzS24QoTC4lZ3sNSb0i3HIkeM6bW4ncavsyhHt7qTyWHnOhh3l
-ZBVJLEBJh4rA+1z5P/728pkX7i+++eeXhe9268Dtwv/4+uU/unDo1lXmJsX8pb9b+IV3iy8b+Auy+YZB
-39DDFwSCd1/+AWEQ9D9BnxMSZGW0vodNm5TFv7YBv27hfwvpr0mdyPoHBP9j8z8Bi5JuzVKA/FeYKOm+
-NOH9H3PyzS9znT/g/vbHSdPPL79/j35KThaFneoWQZkv6bDffyQ0cl9+/8hm1M9JrV/95lF15Z9feHZf
-vr/9gI+f3a/eV/9r+PL73W2+BO9F2H85i0e266qPPJFvwWtZzMchzQeNL+z5o9mX34PX+emcQPT9/deX
-X0lZov7+92cEgn57D16Xk5H+GT6/fHv+zKGfldHzU98kXfilvfl+2LZPL1/95wm1rcqiDfVw6F5eXr5P
-hFRh8fx0kjX96av79Tfo5S147ZMuJpowCIsucbP2/TfoLZhPtXn2Xr7/wch06enL73OuJ//dfZtvkndv
-uYne/Td37r6RFN0eNI37eN4gL8tT/y/Y+Oa9Pz29Xcrm2X+H3vz/dF+9RxcelxOm/dXqxVu9/1VSMvd/
-+f/7AzZ+97rSffY+/vTef4OXO/f96en7D9qzz1H6GizjdHn//fvb5bUN20nU/ysJ3t3X+O3yGg5d4767
-r9Hb5TVwO/fdn3+9XV6XM8Lad//18nZ5bcp+up1+vfnvvCZL88G3RZRcHs+Xl7f42X1NVlOTP4uXu9Sc
-JXbq/9OSxO/pt/f3STTLyxf3nz+GNWyasnl+uhXhUIV+FwbzeT9Loqw5dWXz5XOAv315Wn0CvHwNnl9e
-voXPv0+Ef3Nn+r+/fP8avHz/W/qTHswS84v0Lyz858K/1bv7lyLmu8vRB0GphUXw9PI1n7N6fXVfflaL
-fGL4y++/vP/5xltyeXZfvZcubsp+QT0tKb3+60t+a7svXricGvy01HQ/j6386QX8drmEzR/1w7zqHnP9
-37xfq88JkudqRflzVXcSFuhtkowF8unrwjHv+9efdLN4ntXkX8vgl+8/a0exdHgi4I++ebfo25c/2Oa5
-fjrnGylv3Zdf+v305v33f/8Fs2cZ+FL6cw7Z4Isuk/K3L7FbBFn4z6eXpQ/w1GvoP3/i0yzh/rv76r65
-syK8TcPhv3z/QyGqT2We+9+GnZaMEwcu37yvk1B/83/p8S+kfdT+w9j8ZdUP8f2sXBbUVPA0ce0HFfXC
-tJmGyQQ+ff39Jyz/T+1ONT4bffuy9PXb09dFQz/7/uYtgm3oNLx9Xp69vC0N/b/onMF/JvJvT7diPqU2
-DH7Sz7wMbln4978vv1/DoSqbrv37359/LXj//fot/f7yFpT+bc6C6wbBnJr4mLRdWITN8xMpi0RZdFNZ
-6QZh8PQzcXN27Pcfb0dhRy3pdPEHFzw/feaIXnTpN3cRty/U0pUfGd3D5Z0vSfDHyUdBGX4c3zUkbbcA
-PJGc+fTbu/talEEouXn4K95H00umnJ+xPpXP/RIk97cZ9Wn1E8rb8i8xHycaaV3ZuFE4e4jJ23/8/SqG
-edk83qYue+9//CPNZy+enz7ef5rGtiw+y9XJZf7sRX9IlTvZsl8kJw0f68mhfRwF/oHw7emrO/u/SV6e
-3V8s9Z8EvphkgyjzKgsnQ+FOAjIRHL57r0n5Wt3a+Pnl6+L80+fpZ/T1b0/rp68fONTkVl7ewteyMHUh
-fCz/pfnuvoU/HR83//lzB9tk/NlA/4tB/XPdyaV7L2/VczDffH/7pXYcZln5o/N/5uTzy8zfvzqnBfrl
-gbkcDv/821z8keb288jJ50nVgq/hn9sOQr9s3C4popnn3utHweQFv7+8/e3/BAAA//8+uZ06YxkIAA==
-`,
-	},
-
-	"/index.html": {
-		local:   "static/index.html",
-		size:    530,
-		modtime: 1485035869,
-		compressed: `
-H4sIAAAJbogA/2SRQY8UIRCF7/yKkvtOOwcvLc1lV+PFaMxePDJQM00WKALFpsdO/3fDTK+aeCJ5j6q8
-95V69/Tt8fnn908wcwxaqLcHjdNCseeAemYsESKmpoa7IlTla0Dga8ZJMi482FqlFgdLMZrkYBUA2Tjn
-02WED3mB4/u8fBQAJyoOywjHvECl4B2cgrEv3bKtVCojZPKJsdx+G/tyKdSSe7AUuunTjMVzN8+U+OFs
-og/X//Xqf+E/6ib+ZBtnesVyS9iTPzi0VAx7SiO05LAEn7BPqOHWUgs17DxO5K6dzlF/ee5MnjASfL2B
-mY9aCHWmEsHYvmySAy5oG6OEiDyTm2SmyhK8m+RufaYSpRYq6x8tgYG3iCDEuhaTLgiHx7tWt00on3Lj
-HXttp+hZQjIRJ7lPSng1oeEk1/WwbRJsMLX+dbVYV0yurxp61t5tLzXcT/87AAD//7BIUmESAgAA
-`,
-	},
-
-	"/": {
-		isDir: true,
-		local: "static",
-	},
-}
@@ -1,54 +1,75 @@
-// we don't care about file modification timestamps but do want deterministic builds
-//go:generate esc -pkg=$GOPACKAGE -o=static.go -prefix=static -modtime=1485035869 static
 package main
 
 import (
 	"flag"
 	"fmt"
 	"net/http"
-	"os"
+	"regexp"
 	"strings"
 
 	"github.com/evanj/hterm"
 )
 
-const gopathRelativeStaticDir = "src/github.com/evanj/hterm/cmd/htermshell/static"
-
 func main() {
 	addr := flag.String("addr", "localhost:8080", "Listening address e.g. :8080 for global")
 	cmd := flag.String("cmd", "bash -l", "Command to run (no shell variable expansion)")
-	gopathStatic := flag.Bool("gopathStatic", false, "Open static resources from $GOPATH")
+	staticDir := flag.String("staticDir", "",
+		"Serve static resources from this directory instead of the compiled in files (e.g. assets/static)")
+	macroFile := flag.String("macroFile", "", "Enable macros, stored in this JSON file")
+	maxPaste := flag.Int("maxPasteBytes", 0, "Reject pastes larger than this many bytes (0 for no limit)")
+	inputRate := flag.Int("inputBytesPerSecond", 0, "Limit the rate of input to each session (0 for no limit)")
+	redact := flag.Bool("redact", false, "Redact secrets like AWS keys and bearer tokens from output")
+	redactPattern := flag.String("redactPattern", "", "Also redact output matching this regular expression")
+	maxSessions := flag.Int("maxSessions", 0, "The most sessions running at once (0 for no limit)")
+	maxUserSessions := flag.Int("maxSessionsPerUser", 0, "The most sessions running at once for each user (0 for no limit)")
+	maxNewSessions := flag.Int("maxNewSessionsPerMinute", 0,
+		"The most sessions each client can start in a minute (0 for no limit)")
+	idleTimeout := flag.Duration("idleTimeout", 0,
+		"Close sessions that have not been read for this long, e.g. 10m (0 to keep them until they exit)")
 
 	flag.Parse()
 
 	starter := hterm.NewSubprocessStarter(strings.Split(*cmd, " "))
-	s := hterm.NewServer(starter)
-
-	// Use the "real" http.FileSystem since we don't want to depend on the current working directory
-	var fs http.FileSystem
-	if *gopathStatic {
-		gopath := ""
-		for _, env := range os.Environ() {
-			const gopathVar = "GOPATH="
-			if strings.HasPrefix(env, gopathVar) {
-				gopath = env[len(gopathVar):]
-				break
-			}
-		}
-		if gopath == "" {
-			panic("Could not find GOPATH env var to locate static resources")
+	options := []hterm.HandlerOption{hterm.WithStaticDir(*staticDir)}
+	if *macroFile != "" {
+		store, err := hterm.NewFileMacroStore(*macroFile)
+		if err != nil {
+			panic(err)
 		}
-		fs = http.Dir(gopath + "/" + gopathRelativeStaticDir)
-	} else {
-		fs = FS(false)
+		options = append(options, hterm.WithServerOptions(hterm.WithMacroStore(store)))
+	}
+	if *maxPaste > 0 {
+		options = append(options, hterm.WithServerOptions(hterm.WithInputFilter(
+			func(req *hterm.StartRequest) hterm.InputFilter { return hterm.NewPasteLimiter(*maxPaste) })))
+	}
+	if *inputRate > 0 {
+		options = append(options, hterm.WithServerOptions(hterm.WithInputFilter(
+			func(req *hterm.StartRequest) hterm.InputFilter { return hterm.NewRateLimiter(*inputRate, *inputRate) })))
+	}
+	var patterns []*regexp.Regexp
+	if *redact {
+		patterns = append(patterns, hterm.DefaultRedactionPatterns...)
+	}
+	if *redactPattern != "" {
+		patterns = append(patterns, regexp.MustCompile(*redactPattern))
+	}
+	if len(patterns) > 0 {
+		options = append(options, hterm.WithServerOptions(hterm.WithOutputFilter(
+			func(req *hterm.StartRequest) hterm.OutputFilter { return hterm.NewRedactor(patterns...) })))
+	}
+	options = append(options, hterm.WithServerOptions(hterm.WithLimits(hterm.Limits{
+		MaxSessions:             *maxSessions,
+		MaxSessionsPerPrincipal: *maxUserSessions,
+		MaxNewSessionsPerMinute: *maxNewSessions,
+		IdleTimeout:             *idleTimeout,
+	})))
+	handler, err := hterm.NewHandler(hterm.AdaptSessionStarter(starter), options...)
+	if err != nil {
+		panic(err)
 	}
-
-	staticHandler := http.FileServer(fs)
-	http.Handle("/", staticHandler)
-	s.RegisterHandlers("/", http.DefaultServeMux)
 
 	fmt.Printf("Listening on http://%s/\n", *addr)
-	err := http.ListenAndServe(*addr, nil)
+	err = http.ListenAndServe(*addr, handler)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,278 @@
+package hterm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Docker Engine API version used for all requests. 1.24 is the oldest version that
+// supports everything used here, so this works with old and new daemons.
+const dockerAPIVersion = "v1.24"
+
+// The host is ignored since all connections go to the Unix socket, but it must be valid.
+const dockerBaseURL = "http://docker/" + dockerAPIVersion
+
+// the longest a session waits for the daemon to start, resize or remove its container, so a
+// stuck daemon does not block sessions forever
+const dockerTimeout = 30 * time.Second
+
+type dockerStarter struct {
+	socketPath string
+	images     map[string]string
+	command    []string
+	client     *http.Client
+}
+
+// NewDockerStarter returns a ContextSessionStarter that runs each session in a new container with
+// a tty, using the Docker Engine API on the Unix socket socketPath (usually
+// /var/run/docker.sock). The request's Extra["image"] selects the image: it must be one of the
+// keys in images, and the value is the image reference that is run. If command is not empty, it
+// replaces the image's default command. The container is removed when the session is closed.
+// Starting fails if the daemon does not respond within 30 seconds, or if ctx is cancelled.
+func NewDockerStarter(socketPath string, images map[string]string, command []string) ContextSessionStarter {
+	dial := func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, "unix", socketPath)
+	}
+	client := &http.Client{Transport: &http.Transport{DialContext: dial}}
+	return &dockerStarter{socketPath, images, command, client}
+}
+
+// Subset of the request for POST /containers/create
+type dockerCreateRequest struct {
+	Image        string
+	Cmd          []string `json:",omitempty"`
+	Tty          bool
+	OpenStdin    bool
+	AttachStdin  bool
+	AttachStdout bool
+	AttachStderr bool
+}
+
+type dockerCreateResponse struct {
+	Id string
+}
+
+type dockerErrorResponse struct {
+	Message string `json:"message"`
+}
+
+func (d *dockerStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	// validate the image: the client controls extra
+	name := req.Extra["image"]
+	image, ok := d.images[name]
+	if !ok {
+		return nil, errors.New("invalid image: " + name)
+	}
+	ctx, cancel := context.WithTimeout(ctx, dockerTimeout)
+	defer cancel()
+
+	create := &dockerCreateRequest{
+		Image:        image,
+		Cmd:          d.command,
+		Tty:          true,
+		OpenStdin:    true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+	}
+	created := &dockerCreateResponse{}
+	err := d.call(ctx, http.MethodPost, "/containers/create", create, created)
+	if err != nil {
+		return nil, err
+	}
+	container := &dockerContainer{starter: d, id: created.Id}
+
+	// attach before starting so we don't miss any output
+	err = container.attach(ctx)
+	if err != nil {
+		container.remove()
+		return nil, err
+	}
+	err = d.call(ctx, http.MethodPost, "/containers/"+container.id+"/start", nil, nil)
+	if err != nil {
+		container.Close()
+		return nil, err
+	}
+	// the tty can only be resized once the container is running
+	if req.Size != (Size{}) {
+		err = container.resize(ctx, req.Size)
+		if err != nil {
+			container.Close()
+			return nil, err
+		}
+	}
+	return container, nil
+}
+
+// call sends a request to the Docker API, JSON encoding request if it is not nil, and JSON
+// decoding the response into response if it is not nil. It fails when ctx is done.
+func (d *dockerStarter) call(ctx context.Context, method string, path string, request interface{},
+	response interface{}) error {
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, dockerBaseURL+path, body)
+	if err != nil {
+		return err
+	}
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	err2 := resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if err2 != nil {
+		return err2
+	}
+
+	if resp.StatusCode/100 != 2 {
+		message := &dockerErrorResponse{}
+		if json.Unmarshal(data, message) != nil || message.Message == "" {
+			message.Message = string(data)
+		}
+		return fmt.Errorf("docker %s %s: %s: %s", method, path, resp.Status, message.Message)
+	}
+	if response != nil {
+		return json.Unmarshal(data, response)
+	}
+	return nil
+}
+
+// dockerContainer is the stream for a session attached to a container's tty.
+type dockerContainer struct {
+	starter *dockerStarter
+	id      string
+	conn    net.Conn
+	// reads from conn, and may contain bytes that arrived with the attach response
+	reader    *bufio.Reader
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// attach connects to the container's tty. The connection is "hijacked" by the daemon: after the
+// HTTP response, the connection carries the raw tty stream in both directions. It fails when ctx
+// is done before the daemon responds.
+func (c *dockerContainer) attach(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "unix", c.starter.socketPath)
+	if err != nil {
+		return err
+	}
+	// interrupt the request and response when ctx is done; the connection is used after that
+	attached := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Unix(1, 0))
+		case <-attached:
+		}
+	}()
+	err = c.handshake(conn)
+	close(attached)
+	<-stopped
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	return nil
+}
+
+// handshake sends the attach request on conn and reads the response.
+func (c *dockerContainer) handshake(conn net.Conn) error {
+	req, err := http.NewRequest(http.MethodPost,
+		dockerBaseURL+"/containers/"+c.id+"/attach?stream=1&stdin=1&stdout=1&stderr=1", nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "tcp")
+	err = req.Write(conn)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return err
+	}
+	// older daemons respond with 200 instead of 101 Switching Protocols
+	if resp.StatusCode != http.StatusSwitchingProtocols && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("docker attach %s: %s", c.id, resp.Status)
+	}
+	c.conn = conn
+	c.reader = reader
+	return nil
+}
+
+func (c *dockerContainer) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
+
+func (c *dockerContainer) Write(p []byte) (int, error) {
+	return c.conn.Write(p)
+}
+
+// Resize implements Resizer.
+func (c *dockerContainer) Resize(size Size) error {
+	ctx, cancel := context.WithTimeout(context.Background(), dockerTimeout)
+	defer cancel()
+	return c.resize(ctx, size)
+}
+
+func (c *dockerContainer) resize(ctx context.Context, size Size) error {
+	params := url.Values{}
+	params.Set("h", strconv.Itoa(size.Rows))
+	params.Set("w", strconv.Itoa(size.Columns))
+	return c.starter.call(ctx, http.MethodPost, "/containers/"+c.id+"/resize?"+params.Encode(), nil, nil)
+}
+
+// Close disconnects from the container and removes it.
+func (c *dockerContainer) Close() error {
+	c.closeOnce.Do(func() {
+		err := c.conn.Close()
+		c.closeErr = c.remove()
+		if c.closeErr == nil {
+			c.closeErr = err
+		}
+	})
+	return c.closeErr
+}
+
+// remove kills and deletes the container. It has its own timeout, since it is called when the
+// session is closed, or when starting failed because the start's context is done.
+func (c *dockerContainer) remove() error {
+	ctx, cancel := context.WithTimeout(context.Background(), dockerTimeout)
+	defer cancel()
+	return c.starter.call(ctx, http.MethodDelete, "/containers/"+c.id+"?force=1", nil, nil)
+}
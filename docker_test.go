@@ -0,0 +1,200 @@
+package hterm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDocker implements the subset of the Docker Engine API used by dockerStarter. Attached
+// containers echo their input.
+type fakeDocker struct {
+	mu       sync.Mutex
+	created  []*dockerCreateRequest
+	requests []string
+	// requests for this path never get a response, like a stuck daemon
+	stallPath string
+}
+
+func (f *fakeDocker) record(r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+}
+
+func (f *fakeDocker) recorded() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.requests...)
+}
+
+func (f *fakeDocker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.record(r)
+	const prefix = "/" + dockerAPIVersion + "/containers/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		http.NotFound(w, r)
+		return
+	}
+	path := r.URL.Path[len(prefix):]
+	if path == f.stallPath {
+		<-r.Context().Done()
+		return
+	}
+	switch {
+	case path == "create":
+		create := &dockerCreateRequest{}
+		err := json.NewDecoder(r.Body).Decode(create)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		f.created = append(f.created, create)
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"Id": "c1"}`))
+
+	case path == "c1/attach":
+		conn, buffered, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+		buffered.WriteString("HTTP/1.1 101 UPGRADED\r\n" +
+			"Content-Type: application/vnd.docker.raw-stream\r\n" +
+			"Connection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+		buffered.Flush()
+		io.Copy(conn, buffered)
+
+	case path == "c1/start":
+		w.WriteHeader(http.StatusNoContent)
+	case path == "c1/resize":
+		w.WriteHeader(http.StatusOK)
+	case path == "c1" && r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, `{"message": "no such container"}`, http.StatusNotFound)
+	}
+}
+
+func startFakeDocker(t *testing.T) (*fakeDocker, string) {
+	dir, err := ioutil.TempDir("", "docker_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	socketPath := filepath.Join(dir, "docker.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake := &fakeDocker{}
+	server := &http.Server{Handler: fake}
+	go server.Serve(listener)
+	t.Cleanup(func() {
+		server.Close()
+		os.RemoveAll(dir)
+	})
+	return fake, socketPath
+}
+
+func TestDockerStarter(t *testing.T) {
+	fake, socketPath := startFakeDocker(t)
+	starter := NewDockerStarter(socketPath, map[string]string{"shell": "busybox:latest"}, []string{"sh"})
+
+	_, err := starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"image": "busybox:latest"}})
+	if err == nil || !strings.Contains(err.Error(), "invalid image") {
+		t.Error("expected invalid image error:", err)
+	}
+	if len(fake.recorded()) != 0 {
+		t.Error("must not call docker for invalid images", fake.recorded())
+	}
+
+	stream, err := starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"image": "shell"},
+		Size: Size{Columns: 100, Rows: 40}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := fake.created[0]
+	if created.Image != "busybox:latest" || !created.Tty || !created.OpenStdin ||
+		len(created.Cmd) != 1 || created.Cmd[0] != "sh" {
+		t.Errorf("unexpected create request: %#v", created)
+	}
+
+	_, err = stream.Write([]byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := make([]byte, 5)
+	_, err = io.ReadFull(stream, buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buffer) != "hello" {
+		t.Error("unexpected echo:", string(buffer))
+	}
+
+	err = stream.(Resizer).Resize(Size{Columns: 80, Rows: 24})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = stream.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"POST /v1.24/containers/create?",
+		"POST /v1.24/containers/c1/attach?stream=1&stdin=1&stdout=1&stderr=1",
+		"POST /v1.24/containers/c1/start?",
+		"POST /v1.24/containers/c1/resize?h=40&w=100",
+		"POST /v1.24/containers/c1/resize?h=24&w=80",
+		"DELETE /v1.24/containers/c1?force=1",
+	}
+	requests := fake.recorded()
+	if strings.Join(requests, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected requests:\n%s\nexpected:\n%s",
+			strings.Join(requests, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestDockerStarterError(t *testing.T) {
+	_, socketPath := startFakeDocker(t)
+	starter := NewDockerStarter(socketPath, map[string]string{"shell": "busybox:latest"}, nil)
+	container := &dockerContainer{starter: starter.(*dockerStarter), id: "missing"}
+	err := container.remove()
+	if err == nil || !strings.Contains(err.Error(), "no such container") {
+		t.Error("expected docker error message:", err)
+	}
+}
+
+func TestDockerStarterCancel(t *testing.T) {
+	for _, stallPath := range []string{"create", "c1/attach"} {
+		fake, socketPath := startFakeDocker(t)
+		fake.stallPath = stallPath
+		starter := NewDockerStarter(socketPath, map[string]string{"shell": "busybox:latest"}, nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		start := time.Now()
+		_, err := starter.StartContext(ctx, &StartRequest{Extra: map[string]string{"image": "shell"}})
+		cancel()
+		if err == nil || time.Since(start) > 5*time.Second {
+			t.Error(stallPath, "starting must stop when the context is done:", err, time.Since(start))
+		}
+		if stallPath == "c1/attach" {
+			// the container was created, so it must be removed
+			recorded := fake.recorded()
+			if recorded[len(recorded)-1] != "DELETE /v1.24/containers/c1?force=1" {
+				t.Error("expected the container to be removed:", recorded)
+			}
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Package expect scripts terminal sessions: it waits for output matching regular expressions
+// and sends input, recording a transcript. It can run a setup script before handing a session to
+// a person with NewStarter.
+package expect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/evanj/hterm"
+)
+
+// ErrTimeout is returned by Expect if the output does not match before the timeout.
+var ErrTimeout = errors.New("expect: timeout")
+
+// Entry is part of a transcript: output from the session, or input sent to it.
+type Entry struct {
+	Input bool
+	Data  string
+}
+
+// chunk is the result of a Read from the stream.
+type chunk struct {
+	data []byte
+	err  error
+}
+
+// Session runs a script against a session stream. It must not be used concurrently.
+type Session struct {
+	stream io.ReadWriteCloser
+	// receives output from the goroutine reading stream
+	chunks <-chan chunk
+	// output that has not been matched yet
+	buffer []byte
+	// the error that ended the output, after the buffer is consumed
+	err        error
+	transcript []Entry
+}
+
+// New returns a Session that reads and writes stream, which may be a stream returned by a
+// SessionStarter or a client.Session.
+func New(stream io.ReadWriteCloser) *Session {
+	chunks := make(chan chunk)
+	go func() {
+		for {
+			buffer := make([]byte, 4096)
+			n, err := stream.Read(buffer)
+			if n > 0 {
+				chunks <- chunk{buffer[:n], nil}
+			}
+			if err != nil {
+				chunks <- chunk{nil, err}
+				close(chunks)
+				return
+			}
+		}
+	}()
+	return &Session{stream: stream, chunks: chunks}
+}
+
+// Spawn starts a session with starter and returns a Session for it. Use
+// hterm.AdaptSessionStarter for a SessionStarter.
+func Spawn(ctx context.Context, starter hterm.ContextSessionStarter, req *hterm.StartRequest) (*Session, error) {
+	stream, err := starter.StartContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return New(stream), nil
+}
+
+func (s *Session) record(input bool, data string) {
+	last := len(s.transcript) - 1
+	if last >= 0 && s.transcript[last].Input == input {
+		s.transcript[last].Data += data
+		return
+	}
+	s.transcript = append(s.transcript, Entry{input, data})
+}
+
+// receive adds a chunk of output to the buffer.
+func (s *Session) receive(c chunk) {
+	if c.err != nil {
+		s.err = c.err
+		return
+	}
+	s.buffer = append(s.buffer, c.data...)
+	s.record(false, string(c.data))
+}
+
+// Expect waits until the unmatched output matches re, and returns the match and its submatches.
+// The output up to the end of the match is consumed. It returns an error wrapping ErrTimeout if
+// there is no match before timeout, or the read error (e.g. io.EOF) if the session ends.
+func (s *Session) Expect(re *regexp.Regexp, timeout time.Duration) ([]string, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		loc := re.FindSubmatchIndex(s.buffer)
+		if loc != nil {
+			match := make([]string, len(loc)/2)
+			for i := range match {
+				if loc[2*i] >= 0 {
+					match[i] = string(s.buffer[loc[2*i]:loc[2*i+1]])
+				}
+			}
+			s.buffer = s.buffer[loc[1]:]
+			return match, nil
+		}
+		if s.err != nil {
+			return nil, fmt.Errorf("expect: waiting for %s: %w; unmatched output: %q", re, s.err, s.buffer)
+		}
+
+		select {
+		case c := <-s.chunks:
+			s.receive(c)
+		case <-timer.C:
+			return nil, fmt.Errorf("%w waiting for %s; unmatched output: %q", ErrTimeout, re, s.buffer)
+		}
+	}
+}
+
+// Send writes input to the session.
+func (s *Session) Send(input string) error {
+	s.record(true, input)
+	_, err := s.stream.Write([]byte(input))
+	return err
+}
+
+// SendLine writes input followed by a carriage return, which is what the Enter key sends.
+func (s *Session) SendLine(input string) error {
+	return s.Send(input + "\r")
+}
+
+// Transcript returns the output received and the input sent, in order. Consecutive output or
+// input is combined into one entry.
+func (s *Session) Transcript() []Entry {
+	return append([]Entry(nil), s.transcript...)
+}
+
+// Close closes the session's stream.
+func (s *Session) Close() error {
+	err := s.stream.Close()
+	go drain(s.chunks)
+	return err
+}
+
+// drain discards output so the goroutine reading the closed stream exits.
+func drain(chunks <-chan chunk) {
+	for range chunks {
+	}
+}
+
+// Release returns the session's stream, so it can be handed to a person. Output that was
+// received but not matched is read first. It passes through Resize and SendBreak. The Session
+// must not be used afterwards.
+func (s *Session) Release() io.ReadWriteCloser {
+	return &releasedStream{s.stream, s.chunks, s.buffer, s.err}
+}
+
+type releasedStream struct {
+	io.ReadWriteCloser
+	chunks <-chan chunk
+	buffer []byte
+	err    error
+}
+
+func (r *releasedStream) Read(p []byte) (int, error) {
+	for len(r.buffer) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		c := <-r.chunks
+		r.buffer, r.err = c.data, c.err
+	}
+	n := copy(p, r.buffer)
+	r.buffer = r.buffer[n:]
+	return n, nil
+}
+
+func (r *releasedStream) Close() error {
+	err := r.ReadWriteCloser.Close()
+	go drain(r.chunks)
+	return err
+}
+
+// Resize implements hterm.Resizer.
+func (r *releasedStream) Resize(size hterm.Size) error {
+	return hterm.ResizeStream(r.ReadWriteCloser, size)
+}
+
+// SendBreak implements hterm.Breaker.
+func (r *releasedStream) SendBreak() error {
+	breaker, ok := r.ReadWriteCloser.(hterm.Breaker)
+	if !ok {
+		return errors.New("session does not support sending a break")
+	}
+	return breaker.SendBreak()
+}
+
+type setupStarter struct {
+	starter hterm.ContextSessionStarter
+	setup   func(ctx context.Context, req *hterm.StartRequest, s *Session) error
+}
+
+// NewStarter returns a starter that runs setup on each new session before it is returned. If
+// setup returns an error, the session is closed and the error is returned to the client.
+func NewStarter(starter hterm.ContextSessionStarter,
+	setup func(ctx context.Context, req *hterm.StartRequest, s *Session) error) hterm.ContextSessionStarter {
+	return &setupStarter{starter, setup}
+}
+
+func (s *setupStarter) StartContext(ctx context.Context, req *hterm.StartRequest) (io.ReadWriteCloser, error) {
+	session, err := Spawn(ctx, s.starter, req)
+	if err != nil {
+		return nil, err
+	}
+	err = s.setup(ctx, req, session)
+	if err != nil {
+		session.Close()
+		return nil, err
+	}
+	return session.Release(), nil
+}
@@ -0,0 +1,101 @@
+package expect
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evanj/hterm"
+)
+
+const script = `printf 'name? '; read name; echo "hello $name"; read line; echo "got $line"`
+
+func TestExpect(t *testing.T) {
+	starter := hterm.AdaptSessionStarter(hterm.NewSubprocessStarter([]string{"sh", "-c", script}))
+	s, err := Spawn(context.Background(), starter, &hterm.StartRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	_, err = s.Expect(regexp.MustCompile(`name\? $`), 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.SendLine("world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	match, err := s.Expect(regexp.MustCompile(`hello (\w+)`), 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if match[0] != "hello world" || match[1] != "world" {
+		t.Errorf("unexpected match: %#v", match)
+	}
+
+	_, err = s.Expect(regexp.MustCompile(`never`), 10*time.Millisecond)
+	if !errors.Is(err, ErrTimeout) {
+		t.Error("expected timeout:", err)
+	}
+
+	transcript := s.Transcript()
+	if len(transcript) != 3 || transcript[0].Data != "name? " || !transcript[1].Input ||
+		transcript[1].Data != "world\r" || !strings.Contains(transcript[2].Data, "hello world") {
+		t.Errorf("unexpected transcript: %#v", transcript)
+	}
+
+	// the session ends: Expect returns the read error
+	err = s.SendLine("bye")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Expect(regexp.MustCompile(`never`), 5*time.Second)
+	if err == nil || errors.Is(err, ErrTimeout) || !strings.Contains(err.Error(), "got bye") {
+		t.Error("expected the session to end:", err)
+	}
+}
+
+func TestStarter(t *testing.T) {
+	base := hterm.AdaptSessionStarter(hterm.NewSubprocessStarter([]string{"sh", "-c", script}))
+	starter := NewStarter(base, func(ctx context.Context, req *hterm.StartRequest, s *Session) error {
+		_, err := s.Expect(regexp.MustCompile(`name\? `), 5*time.Second)
+		if err != nil {
+			return err
+		}
+		return s.SendLine(req.Extra["name"])
+	})
+
+	stream, err := starter.StartContext(context.Background(),
+		&hterm.StartRequest{Extra: map[string]string{"name": "setup"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = hterm.ResizeStream(stream, hterm.Size{Columns: 100, Rows: 30})
+	if err != nil {
+		t.Error("the released stream must be resizable:", err)
+	}
+	_, err = stream.Write([]byte("person\r"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// reads until the program exits
+	output, _ := ioutil.ReadAll(stream)
+	if !strings.Contains(string(output), "hello setup") || !strings.Contains(string(output), "got person") {
+		t.Errorf("unexpected output after setup: %#v", string(output))
+	}
+	stream.Close()
+
+	failing := NewStarter(base, func(ctx context.Context, req *hterm.StartRequest, s *Session) error {
+		_, err := s.Expect(regexp.MustCompile(`never`), 10*time.Millisecond)
+		return err
+	})
+	_, err = failing.StartContext(context.Background(), &hterm.StartRequest{})
+	if !errors.Is(err, ErrTimeout) {
+		t.Error("expected the setup error:", err)
+	}
+}
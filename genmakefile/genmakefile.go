@@ -208,11 +208,17 @@ func main() {
 			[]string{"LIBDOT_SEARCH_PATH=$(pwd) build/libapps/libdot/bin/concat.sh -i build/libapps/hterm/concat/hterm_all.concat -o $@"}},
 
 		// originally I wanted all build outputs in build, but these ones don't work that way
-		// combine all js into a single file
-		&staticTarget{"../cmd/htermshell/static/htermshell.js", []string{buildOutput("js/hterm_all.js"), buildOutput("js/htermshell.js")}, []string{},
-			[]string{"cat $^ > $@"}},
-		&staticTarget{"../cmd/htermmenu/static/htermmenu.js", []string{buildOutput("js/hterm_all.js"), buildOutput("js/htermmenu.js")}, []string{},
-			[]string{"cat $^ > $@"}},
+		// the hterm library is shared by all commands; each command has its own compiled js
+		&staticTarget{"../assets/static/shared/hterm_all.js", []string{buildOutput("js/hterm_all.js")}, []string{},
+			[]string{"cp $< $@"}},
+		&staticTarget{"../assets/static/htermshell/htermshell.js", []string{buildOutput("js/htermshell.js")}, []string{},
+			[]string{"cp $< $@"}},
+		&staticTarget{"../assets/static/htermshell/htermgroup.js", []string{buildOutput("js/htermgroup.js")}, []string{},
+			[]string{"cp $< $@"}},
+		&staticTarget{"../assets/static/shared/htermwatch.js", []string{buildOutput("js/htermwatch.js")}, []string{},
+			[]string{"cp $< $@"}},
+		&staticTarget{"../assets/static/htermmenu/htermmenu.js", []string{buildOutput("js/htermmenu.js")}, []string{},
+			[]string{"cp $< $@"}},
 	}
 
 	jsFiles := map[string]*jsDependencies{
@@ -222,6 +228,12 @@ func main() {
 		"js/htermshell.js": &jsDependencies{[]string{
 			"js/consolechannel.js"}, []string{"js/hterm_externs.js", "js/node_externs.js"}},
 
+		"js/htermgroup.js": &jsDependencies{[]string{
+			"js/consolechannel.js"}, []string{"js/hterm_externs.js", "js/node_externs.js"}},
+
+		"js/htermwatch.js": &jsDependencies{[]string{
+			"js/consolechannel.js"}, []string{"js/hterm_externs.js", "js/node_externs.js"}},
+
 		"js/htermmenu.js": &jsDependencies{[]string{"js/consolechannel.js"},
 			[]string{"js/htermmenu_externs.js", "js/hterm_externs.js"}},
 
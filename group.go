@@ -0,0 +1,112 @@
+package hterm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+)
+
+// groupKey identifies a group of sessions. Groups belong to the principal that created them, so
+// a user can only broadcast to their own sessions.
+type groupKey struct {
+	principal string
+	name      string
+}
+
+// joinGroupHandler adds the session to the request's group, leaving its current group. Writes
+// to the session with broadcast set are sent to every session in the group.
+func (s *Server) joinGroupHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	if request.Group == "" {
+		return errors.New("joinGroup request missing required group")
+	}
+	key := groupKey{PrincipalFromContext(r.Context()), request.Group}
+	log.Printf("session %s joining group %s", session.id, key.name)
+
+	s.mu.Lock()
+	if s.sessions[session.id] != session {
+		// closed concurrently: closeSession already removed it from its group
+		s.mu.Unlock()
+		return errSessionFinished
+	}
+	s.removeFromGroupLocked(session)
+	if s.groups[key] == nil {
+		s.groups[key] = map[*sessionState]struct{}{}
+	}
+	s.groups[key][session] = struct{}{}
+	session.group = key
+	s.mu.Unlock()
+
+	w.Write(jsonEmptyObject)
+	return nil
+}
+
+// leaveGroupHandler removes the session from its group, if it is in one. It does not start the
+// session.
+func (s *Server) leaveGroupHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	if session == nil {
+		return errSessionNotFound
+	}
+	log.Printf("session %s leaving its group", session.id)
+	s.mu.Lock()
+	s.removeFromGroupLocked(session)
+	s.mu.Unlock()
+	w.Write(jsonEmptyObject)
+	return nil
+}
+
+// removeFromGroupLocked removes session from its group. s.mu must be held.
+func (s *Server) removeFromGroupLocked(session *sessionState) {
+	members := s.groups[session.group]
+	if members == nil {
+		return
+	}
+	delete(members, session)
+	if len(members) == 0 {
+		delete(s.groups, session.group)
+	}
+	session.group = groupKey{}
+}
+
+// groupMembers returns the sessions in session's group, including session.
+func (s *Server) groupMembers(session *sessionState) ([]*sessionState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	members := s.groups[session.group]
+	if members == nil {
+		return nil, errors.New("session is not in a group")
+	}
+	out := make([]*sessionState, 0, len(members))
+	for member := range members {
+		out = append(out, member)
+	}
+	return out, nil
+}
+
+// broadcast writes data to every session in members concurrently, so one slow session does not
+// delay the others. Each session's input filters apply. It returns an error if any write fails.
+func broadcast(ctx context.Context, members []*sessionState, data []byte) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(members))
+	for i, member := range members {
+		wg.Add(1)
+		go func(i int, member *sessionState) {
+			defer wg.Done()
+			err := member.writeInput(ctx, data)
+			if err != nil {
+				errs[i] = fmt.Errorf("session %s: %w", member.id, err)
+			}
+		}(i, member)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
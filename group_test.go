@@ -0,0 +1,100 @@
+package hterm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServerGroups(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter)
+	mux := newTestMux(server)
+
+	for _, id := range []string{"s1", "s2", "s3"} {
+		w := post(t, mux, "/joinGroup", `{"session_id": "`+id+`", "group": "g"}`)
+		if w.Code != http.StatusOK {
+			t.Fatal(w.Code, w.Body.String())
+		}
+	}
+	w := post(t, mux, "/write", `{"session_id": "s1", "data": "ls\r", "broadcast": true}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	// writes without broadcast only go to one session
+	w = post(t, mux, "/write", `{"session_id": "s2", "data": "x"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+
+	// members can leave mid-stream, or finish
+	w = post(t, mux, "/leaveGroup", `{"session_id": "s2"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	w = post(t, mux, "/close", `{"session_id": "s3"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	w = post(t, mux, "/write", `{"session_id": "s1", "data": "pwd\r", "broadcast": true}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+
+	expected := []string{"ls\rpwd\r", "ls\rx", "ls\r"}
+	for i, stream := range starter.streams {
+		if stream.written() != expected[i] {
+			t.Errorf("session %d: unexpected input %q; expected %q", i, stream.written(), expected[i])
+		}
+	}
+
+	// a session that is not in a group cannot broadcast
+	w = post(t, mux, "/write", `{"session_id": "s2", "data": "x", "broadcast": true}`)
+	if w.Code != http.StatusInternalServerError {
+		t.Error("expected an error broadcasting outside a group:", w.Code)
+	}
+	w = post(t, mux, "/joinGroup", `{"session_id": "s2"}`)
+	if w.Code != http.StatusInternalServerError {
+		t.Error("expected an error without a group:", w.Code)
+	}
+	w = post(t, mux, "/leaveGroup", `{"session_id": "unknown"}`)
+	if w.Code != http.StatusNotFound || len(starter.streams) != 3 {
+		t.Error("leaveGroup must not start a session:", w.Code, len(starter.streams))
+	}
+
+	// the empty group is removed
+	post(t, mux, "/leaveGroup", `{"session_id": "s1"}`)
+	server.mu.Lock()
+	groups := len(server.groups)
+	server.mu.Unlock()
+	if groups != 0 {
+		t.Error("expected no groups:", groups)
+	}
+}
+
+func TestServerGroupsPerPrincipal(t *testing.T) {
+	starter := &fakeStarter{}
+	mux := newTestMux(NewContextServer(starter))
+
+	// post authenticates as alice; bob's session with the same group name is in another group
+	w := post(t, mux, "/joinGroup", `{"session_id": "s1", "group": "g"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	r := httptest.NewRequest(http.MethodPost, "/joinGroup", strings.NewReader(`{"session_id": "s2", "group": "g"}`))
+	r = r.WithContext(WithPrincipal(r.Context(), "bob"))
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+
+	w = post(t, mux, "/write", `{"session_id": "s1", "data": "x", "broadcast": true}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if starter.streams[0].written() != "x" || starter.streams[1].written() != "" {
+		t.Error("broadcast must only reach the principal's group")
+	}
+}
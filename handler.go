@@ -0,0 +1,88 @@
+package hterm
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"strings"
+
+	"github.com/evanj/hterm/assets"
+)
+
+// Handler serves the terminal page, its Javascript, and the session endpoints. The page uses
+// relative URLs, so the handler can be mounted at any prefix.
+type Handler struct {
+	mux *http.ServeMux
+}
+
+type handlerConfig struct {
+	prefix    string
+	staticDir string
+	files     fs.FS
+	server    []ServerOption
+}
+
+// HandlerOption configures a Handler.
+type HandlerOption func(*handlerConfig)
+
+// WithPrefix sets the path the handler is mounted at, which must end with /. The default is /.
+// Requests for the prefix without the trailing / are redirected, so the relative URLs work.
+func WithPrefix(prefix string) HandlerOption {
+	return func(c *handlerConfig) {
+		c.prefix = prefix
+	}
+}
+
+// WithStaticDir serves the frontend from dir, which has the same layout as assets/static, instead
+// of the compiled in files. This is useful when editing the frontend.
+func WithStaticDir(dir string) HandlerOption {
+	return func(c *handlerConfig) {
+		c.staticDir = dir
+	}
+}
+
+// WithFiles serves the frontend from files, which must contain index.html and the Javascript it
+// loads. It replaces the default terminal page.
+func WithFiles(files fs.FS) HandlerOption {
+	return func(c *handlerConfig) {
+		c.files = files
+	}
+}
+
+// WithServerOptions configures the Server that handles the session endpoints.
+func WithServerOptions(options ...ServerOption) HandlerOption {
+	return func(c *handlerConfig) {
+		c.server = append(c.server, options...)
+	}
+}
+
+// NewHandler returns a Handler that starts sessions with starter.
+func NewHandler(starter ContextSessionStarter, options ...HandlerOption) (*Handler, error) {
+	config := &handlerConfig{prefix: "/"}
+	for _, option := range options {
+		option(config)
+	}
+	if !strings.HasPrefix(config.prefix, "/") || !strings.HasSuffix(config.prefix, "/") {
+		return nil, errors.New("prefix must start and end with /")
+	}
+
+	files := config.files
+	if files == nil {
+		var err error
+		files, err = assets.FS("htermshell", config.staticDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// ServeMux redirects the prefix without the trailing /
+	h := &Handler{http.NewServeMux()}
+	h.mux.Handle(config.prefix, http.StripPrefix(config.prefix[:len(config.prefix)-1],
+		http.FileServer(http.FS(files))))
+	NewContextServer(starter, config.server...).RegisterHandlers(config.prefix, h.mux)
+	return h, nil
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
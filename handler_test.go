@@ -0,0 +1,64 @@
+package hterm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestHandler(t *testing.T) {
+	starter := &fakeStarter{}
+	handler, err := NewHandler(starter, WithPrefix("/console/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/console/", handler)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+	w := get("/console/")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `<script src="hterm_all.js"`) {
+		t.Errorf("unexpected index: %d %s", w.Code, w.Body.String())
+	}
+	for _, path := range []string{"/console/hterm_all.js", "/console/htermshell.js", "/console/group.html",
+		"/console/htermgroup.js", "/console/watch.html", "/console/htermwatch.js"} {
+		w = get(path)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: unexpected status %d", path, w.Code)
+		}
+	}
+	w = get("/console")
+	if w.Code/100 != 3 || w.Header().Get("Location") != "/console/" {
+		t.Errorf("expected redirect: %d %s", w.Code, w.Header().Get("Location"))
+	}
+
+	w = post(t, mux, "/console/write", `{"session_id": "s1", "data": "hello"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if len(starter.streams) != 1 || starter.streams[0].written() != "hello" {
+		t.Error("expected the write to start a session")
+	}
+
+	files := fstest.MapFS{"index.html": &fstest.MapFile{Data: []byte("custom")}}
+	handler, err = NewHandler(starter, WithFiles(files))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Body.String() != "custom" {
+		t.Errorf("expected custom index: %s", w.Body.String())
+	}
+
+	_, err = NewHandler(starter, WithPrefix("/console"))
+	if err == nil {
+		t.Error("expected an error for a prefix without a trailing /")
+	}
+}
@@ -0,0 +1,96 @@
+package hterm
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/kr/pty"
+)
+
+// InProcessFunc is an interactive program that runs in the server process. rw is the terminal:
+// it is a tty, so the kernel's line discipline handles echo, line editing and Ctrl-C. resize
+// receives the initial size if the client sent it, then the new size when the terminal is
+// resized; only the latest size is kept if the function does not receive it. ctx is cancelled
+// when the session is closed. The session ends when the function returns.
+type InProcessFunc func(ctx context.Context, rw io.ReadWriter, resize <-chan Size)
+
+type inProcessStarter struct {
+	f InProcessFunc
+}
+
+// NewInProcessStarter returns a ContextSessionStarter that runs f in a new goroutine for each
+// session, connected to a new pty that starts at the client's initial size.
+func NewInProcessStarter(f InProcessFunc) ContextSessionStarter {
+	return &inProcessStarter{f}
+}
+
+func (s *inProcessStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	master, tty, err := pty.Open()
+	if err != nil {
+		return nil, err
+	}
+	if req.Size != (Size{}) {
+		err = setSize(master, req.Size)
+		if err != nil {
+			master.Close()
+			tty.Close()
+			return nil, err
+		}
+	}
+	// the session outlives ctx, which is only for starting it
+	ctx, cancel := context.WithCancel(context.Background())
+	session := &inProcessSession{File: master, cancel: cancel, resize: make(chan Size, 1)}
+	if req.Size != (Size{}) {
+		session.resize <- req.Size
+	}
+	go func() {
+		s.f(ctx, tty, session.resize)
+		// the session reads EOF or EIO from master once the tty is closed
+		tty.Close()
+	}()
+	return session, nil
+}
+
+type inProcessSession struct {
+	*os.File
+	cancel context.CancelFunc
+
+	// protects resize so Resize does not race with Close
+	mu     sync.Mutex
+	resize chan Size
+	closed bool
+}
+
+// Resize implements Resizer by resizing the pty, then notifying the function.
+func (s *inProcessSession) Resize(size Size) error {
+	err := setSize(s.File, size)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	// replace any size the function has not received yet
+	select {
+	case <-s.resize:
+	default:
+	}
+	s.resize <- size
+	return nil
+}
+
+// Close cancels the function's context and closes the pty.
+func (s *inProcessSession) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		s.cancel()
+	}
+	s.mu.Unlock()
+	return s.File.Close()
+}
@@ -0,0 +1,77 @@
+package hterm
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInProcessStarter(t *testing.T) {
+	sizes := make(chan Size)
+	done := make(chan struct{})
+	app := func(ctx context.Context, rw io.ReadWriter, resize <-chan Size) {
+		defer close(done)
+		fmt.Fprint(rw, "name? ")
+		line, err := bufio.NewReader(rw).ReadString('\n')
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		fmt.Fprintf(rw, "hello %s", line)
+
+		sizes <- <-resize
+		<-ctx.Done()
+	}
+
+	stream, err := NewInProcessStarter(app).StartContext(context.Background(), &StartRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := bufio.NewReader(stream)
+	readUntil := func(suffix string) string {
+		out := ""
+		for !strings.HasSuffix(out, suffix) {
+			b, err := reader.ReadByte()
+			if err != nil {
+				t.Fatalf("read %#v: %s", out, err.Error())
+			}
+			out += string(b)
+		}
+		return out
+	}
+
+	readUntil("name? ")
+	_, err = stream.Write([]byte("gopher\r"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the line discipline echoes the input and translates CR to NL
+	out := readUntil("hello gopher\r\n")
+	if out != "gopher\r\nhello gopher\r\n" {
+		t.Errorf("unexpected output: %#v", out)
+	}
+
+	err = stream.(Resizer).Resize(Size{Columns: 100, Rows: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := <-sizes
+	if size != (Size{Columns: 100, Rows: 40}) {
+		t.Error("unexpected size:", size)
+	}
+
+	// closing cancels the context
+	err = stream.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the function to return")
+	}
+}
@@ -0,0 +1,149 @@
+package hterm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// InputFilter inspects input from the client before it is written to a session. Each session
+// has its own filters, created by the functions passed to WithInputFilter.
+type InputFilter interface {
+	// FilterInput returns the data to write to the session, which may be rewritten. It may
+	// block to delay the input, but must return when ctx is cancelled. It returns an error
+	// created with RejectInput to discard the input and show the reason on the terminal.
+	FilterInput(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// InputFilterFunc adapts a function to an InputFilter.
+type InputFilterFunc func(ctx context.Context, data []byte) ([]byte, error)
+
+// FilterInput calls f.
+func (f InputFilterFunc) FilterInput(ctx context.Context, data []byte) ([]byte, error) {
+	return f(ctx, data)
+}
+
+// InputRejectedError is returned by filters that reject input.
+type InputRejectedError struct {
+	Reason string
+}
+
+func (e *InputRejectedError) Error() string {
+	return "input rejected: " + e.Reason
+}
+
+// RejectInput returns an error that rejects the input for reason, which is shown to the user.
+func RejectInput(reason string) error {
+	return &InputRejectedError{reason}
+}
+
+// WithInputFilter adds a filter to each session: newFilter is called when a session starts,
+// with the request that started it. It may return nil to not filter the session. Filters are
+// applied in the order they were added, to all input: writes, macros and broadcasts.
+func WithInputFilter(newFilter func(req *StartRequest) InputFilter) ServerOption {
+	return func(s *Server) {
+		s.inputFilters = append(s.inputFilters, newFilter)
+	}
+}
+
+// writeInput filters data then writes it to the session. If a filter rejects it, a notice is
+// shown on the terminal.
+func (session *sessionState) writeInput(ctx context.Context, data []byte) error {
+	for _, filter := range session.filters {
+		var err error
+		data, err = filter.FilterInput(ctx, data)
+		if err != nil {
+			var rejected *InputRejectedError
+			if errors.As(err, &rejected) {
+				session.notice(rejected.Error())
+			}
+			return err
+		}
+		if len(data) == 0 {
+			return nil
+		}
+	}
+	_, err := session.stream.Write(data)
+	return err
+}
+
+// inputWriter is an io.Writer that filters input to a session.
+type inputWriter struct {
+	ctx     context.Context
+	session *sessionState
+}
+
+func (w *inputWriter) Write(p []byte) (int, error) {
+	err := w.session.writeInput(w.ctx, p)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+type rateLimiter struct {
+	bytesPerSecond float64
+	burst          float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns an InputFilter that delays input so it is written at no more than
+// bytesPerSecond on average, allowing bursts of burst bytes. Input larger than burst is written
+// after the time it would take at the limit. If bytesPerSecond or burst is not positive, input
+// is not limited.
+func NewRateLimiter(bytesPerSecond int, burst int) InputFilter {
+	if bytesPerSecond <= 0 || burst <= 0 {
+		return InputFilterFunc(func(ctx context.Context, data []byte) ([]byte, error) {
+			return data, nil
+		})
+	}
+	return &rateLimiter{bytesPerSecond: float64(bytesPerSecond), burst: float64(burst),
+		tokens: float64(burst), last: time.Now()}
+}
+
+func (r *rateLimiter) FilterInput(ctx context.Context, data []byte) ([]byte, error) {
+	// take the tokens now, so concurrent writes wait in order; the count may become negative
+	r.mu.Lock()
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.bytesPerSecond
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+	r.tokens -= float64(len(data))
+	wait := time.Duration(-r.tokens / r.bytesPerSecond * float64(time.Second))
+	r.mu.Unlock()
+
+	if wait <= 0 {
+		return data, nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return data, nil
+	case <-ctx.Done():
+		// the input was not written: return its tokens
+		r.mu.Lock()
+		r.tokens += float64(len(data))
+		r.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// NewPasteLimiter returns an InputFilter that rejects writes larger than maxBytes. Keystrokes
+// are written a few bytes at a time, so large writes are pastes.
+func NewPasteLimiter(maxBytes int) InputFilter {
+	return InputFilterFunc(func(ctx context.Context, data []byte) ([]byte, error) {
+		if len(data) > maxBytes {
+			return nil, RejectInput(fmt.Sprintf("paste of %d bytes is larger than the limit of %d bytes",
+				len(data), maxBytes))
+		}
+		return data, nil
+	})
+}
@@ -0,0 +1,123 @@
+package hterm
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPasteLimiter(t *testing.T) {
+	filter := NewPasteLimiter(4)
+	data, err := filter.FilterInput(context.Background(), []byte("abcd"))
+	if err != nil || string(data) != "abcd" {
+		t.Error("unexpected result:", string(data), err)
+	}
+	_, err = filter.FilterInput(context.Background(), []byte("abcde"))
+	if _, ok := err.(*InputRejectedError); !ok {
+		t.Error("expected the paste to be rejected:", err)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	filter := NewRateLimiter(1000, 10)
+	start := time.Now()
+	_, err := filter.FilterInput(context.Background(), []byte("0123456789"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) > 5*time.Millisecond {
+		t.Error("the burst must not be delayed:", time.Since(start))
+	}
+	// 20 bytes at 1000 bytes/second
+	_, err = filter.FilterInput(context.Background(), bytes.Repeat([]byte("x"), 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) < 15*time.Millisecond {
+		t.Error("expected a delay:", time.Since(start))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = filter.FilterInput(ctx, bytes.Repeat([]byte("x"), 1000))
+	if err != context.Canceled {
+		t.Error("expected the delay to be cancelled:", err)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	for _, limits := range [][2]int{{0, 10}, {-1, 10}, {1000, 0}, {1000, -1}} {
+		filter := NewRateLimiter(limits[0], limits[1])
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			data, err := filter.FilterInput(context.Background(), bytes.Repeat([]byte("x"), 1000))
+			if err != nil || len(data) != 1000 {
+				t.Fatal(limits, len(data), err)
+			}
+		}
+		if time.Since(start) > 50*time.Millisecond {
+			t.Error("non-positive limits must not delay input:", limits, time.Since(start))
+		}
+	}
+}
+
+func TestServerInputFilters(t *testing.T) {
+	starter := &fakeStarter{}
+	var requests []*StartRequest
+	blockSuspend := func(req *StartRequest) InputFilter {
+		requests = append(requests, req)
+		return InputFilterFunc(func(ctx context.Context, data []byte) ([]byte, error) {
+			if bytes.IndexByte(data, 0x1a) >= 0 {
+				return nil, RejectInput("Ctrl-Z is not allowed")
+			}
+			return bytes.ToUpper(data), nil
+		})
+	}
+	noFilter := func(req *StartRequest) InputFilter { return nil }
+	pasteLimit := func(req *StartRequest) InputFilter { return NewPasteLimiter(8) }
+	store := NewMemoryMacroStore()
+	store.SaveMacro("alice", &Macro{Name: "m", Steps: []string{"a", "\x1ab"}, Delay: time.Millisecond})
+	mux := newTestMux(NewContextServer(starter, WithInputFilter(blockSuspend), WithInputFilter(noFilter),
+		WithInputFilter(pasteLimit), WithMacroStore(store)))
+
+	w := post(t, mux, "/write", `{"session_id": "s1", "data": "ls"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if len(requests) != 1 || requests[0].Principal != "alice" {
+		t.Error("filters must be created with the start request")
+	}
+
+	rejected := []string{
+		`{"session_id": "s1", "data": "\u001a"}`,
+		`{"session_id": "s1", "data": "a long paste"}`,
+		`{"session_id": "s1", "macro": "m"}`,
+	}
+	for _, request := range rejected {
+		w = post(t, mux, "/write", request)
+		if w.Code != http.StatusForbidden || !strings.Contains(w.Body.String(), "input rejected") {
+			t.Errorf("%s: expected the input to be rejected: %d %s", request, w.Code, w.Body.String())
+		}
+	}
+	if starter.streams[0].written() != "LSA" {
+		t.Errorf("unexpected input: %q", starter.streams[0].written())
+	}
+
+	// the rejections are shown on the terminal
+	w = post(t, mux, "/read", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK || strings.Count(w.Body.String(), "input rejected") != 3 ||
+		!strings.Contains(w.Body.String(), "Ctrl-Z is not allowed") {
+		t.Error("expected notices:", w.Code, w.Body.String())
+	}
+
+	// broadcasts use each member's filters
+	post(t, mux, "/joinGroup", `{"session_id": "s1", "group": "g"}`)
+	post(t, mux, "/joinGroup", `{"session_id": "s2", "group": "g"}`)
+	w = post(t, mux, "/write", `{"session_id": "s2", "data": "\u001a", "broadcast": true}`)
+	if w.Code != http.StatusForbidden {
+		t.Error("expected the broadcast to be rejected:", w.Code, w.Body.String())
+	}
+}
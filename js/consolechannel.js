@@ -3,9 +3,26 @@
 /** @const */
 var consolechannel = {};
 
-/** @typedef {{data: (string|undefined), columns: (number|undefined), rows: (number|undefined)}} */
+/**
+@typedef {{data: (string|undefined), broadcast: (boolean|undefined), group: (string|undefined),
+  share: (string|undefined), ttlSeconds: (number|undefined), shareId: (string|undefined)}}
+*/
 consolechannel.PartialRequest;
-/** @typedef {{data: string}} */
+
+/**
+A read-only link to the session. url is relative to the channel's url.
+@typedef {{id: string, url: string, expires: string}}
+*/
+consolechannel.ShareLink;
+/** @typedef {{principal: string, remoteAddr: string, joined: string, lastSeen: string}} */
+consolechannel.Viewer;
+/** @typedef {{id: string, expires: string, viewers: !Array<!consolechannel.Viewer>}} */
+consolechannel.ShareStatus;
+
+/**
+@typedef {{data: string, share: ?consolechannel.ShareLink,
+  shares: !Array<!consolechannel.ShareStatus>}}
+*/
 consolechannel.ResponseUnion;
 
 /** @record */
@@ -18,11 +35,12 @@ consolechannel.Environment = function() {};
 consolechannel.Environment.prototype.getRandomValues = function(typedArray) {};
 
 /**
-Sends an HTTP POST to url with body requestSerialized.
+Sends an HTTP POST to url with body requestSerialized. onError is called with the HTTP status
+and the response body, which is the error message.
 @param {string} url
 @param {string} requestSerialized
 @param {function(string)} onSuccess
-@param {function()} onError
+@param {function(number, string)} onError
 */
 consolechannel.Environment.prototype.post = function(url, requestSerialized, onSuccess, onError) {};
 
@@ -44,7 +62,7 @@ consolechannel.BrowserEnvironment.prototype.post = function(url, requestSerializ
       return;
     }
     if (request.status != 200) {
-      onError();
+      onError(request.status, request.responseText);
       return;
     }
 
@@ -85,10 +103,25 @@ consolechannel.Channel = function(env, url, extra) {
   /** @type {string} */
   this.session_id_ = btoa(s);
 
+  // the terminal size: sent with every request so the session starts at the right size
+  /** @type {number} */
+  this.columns_ = 0;
+  /** @type {number} */
+  this.rows_ = 0;
+  // the size of a character cell in pixels, or 0 if unknown
+  /** @type {number} */
+  this.cellWidth_ = 0;
+  /** @type {number} */
+  this.cellHeight_ = 0;
+
   /** @type {boolean} */
   this.writePending_ = false;
   /** @type {string} */
   this.writeBuffer_ = "";
+
+  // true while the session is in a group: writes are sent to every session in the group
+  /** @type {boolean} */
+  this.broadcast_ = false;
 };
 
 /**
@@ -96,18 +129,27 @@ consolechannel.Channel = function(env, url, extra) {
 @param {string} path
 @param {!consolechannel.PartialRequest} struct
 @param {function(!consolechannel.ResponseUnion)} onSuccess
-@param {function()} onError
+@param {function(number, string)} onError
 */
 consolechannel.Channel.prototype.postStruct_ = function(path, struct, onSuccess, onError) {
   var jsonDict = {};
   // common
   jsonDict["session_id"] = this.session_id_
   jsonDict["extra"] = this.extra_;
+  jsonDict["columns"] = this.columns_;
+  jsonDict["rows"] = this.rows_;
+  jsonDict["cell_width"] = this.cellWidth_;
+  jsonDict["cell_height"] = this.cellHeight_;
   // write
   jsonDict["data"] = struct.data;
-  // setSize
-  jsonDict["columns"] = struct.columns;
-  jsonDict["rows"] = struct.rows;
+  jsonDict["broadcast"] = struct.broadcast;
+  // joinGroup
+  jsonDict["group"] = struct.group;
+  // watch
+  jsonDict["share"] = struct.share;
+  // createShare and revokeShare
+  jsonDict["ttl_seconds"] = struct.ttlSeconds;
+  jsonDict["share_id"] = struct.shareId;
   var serialized = JSON.stringify(jsonDict)
 
   /** @param {string} responseSerialized */
@@ -116,10 +158,24 @@ consolechannel.Channel.prototype.postStruct_ = function(path, struct, onSuccess,
     var raw = JSON.parse(responseSerialized);
     if (typeof raw !== "object") {
       console.error("unexpected type from server response: " + typeof raw);
-      onError();
+      onError(0, "unexpected response from server");
       return
     }
-    var struct = {data: raw["data"]};
+    var struct = {data: raw["data"], share: null, shares: []};
+    // createShare
+    if (typeof raw["token"] === "string") {
+      struct.share = {id: raw["id"], url: raw["url"], expires: raw["expires"]};
+    }
+    // listShares
+    if (raw["shares"] instanceof Array) {
+      struct.shares = raw["shares"].map(function(rawShare) {
+        var viewers = rawShare["viewers"].map(function(rawViewer) {
+          return {principal: rawViewer["principal"], remoteAddr: rawViewer["remote_addr"],
+            joined: rawViewer["joined"], lastSeen: rawViewer["last_seen"]};
+        });
+        return {id: rawShare["id"], expires: rawShare["expires"], viewers: viewers};
+      });
+    }
     onSuccess(struct);
   }
 
@@ -165,7 +221,7 @@ consolechannel.Channel.prototype.doSend_ = function(data) {
     self.onWriteComplete_(false);
   };
 
-  var request = {data: data};
+  var request = {data: data, broadcast: this.broadcast_};
   this.postStruct_("write", request, onSuccess, onError);
 };
 
@@ -191,11 +247,15 @@ consolechannel.Channel.prototype.onWriteComplete_ = function(success) {
 };
 
 /**
-Sets the terminal size to rows, cols.
+Sets the terminal size to rows, cols. This should be called before startRead, so the session
+starts at the right size. The optional cell size is the size of a character in pixels, which
+is used by programs that draw images.
 @param {number} columns
 @param {number} rows
+@param {number=} opt_cellWidth
+@param {number=} opt_cellHeight
 */
-consolechannel.Channel.prototype.setSize = function(columns, rows) {
+consolechannel.Channel.prototype.setSize = function(columns, rows, opt_cellWidth, opt_cellHeight) {
   function onError() {
     console.error("setSize onError");
   }
@@ -204,11 +264,72 @@ consolechannel.Channel.prototype.setSize = function(columns, rows) {
     console.log("setSize success");
   }
 
-  var request = {
-    columns: columns,
-    rows: rows
-  };
-  this.postStruct_("setSize", request, onSuccess, onError);
+  this.columns_ = columns;
+  this.rows_ = rows;
+  // the server requires whole pixels, and both dimensions or neither
+  if (opt_cellWidth && opt_cellHeight) {
+    this.cellWidth_ = Math.round(opt_cellWidth);
+    this.cellHeight_ = Math.round(opt_cellHeight);
+  } else {
+    this.cellWidth_ = 0;
+    this.cellHeight_ = 0;
+  }
+  this.postStruct_("setSize", {}, onSuccess, onError);
+};
+
+/**
+Sends a break to the terminal program/server e.g. a serial break to a serial port.
+*/
+consolechannel.Channel.prototype.sendBreak = function() {
+  function onError() {
+    console.error("sendBreak onError");
+  }
+
+  function onSuccess() {
+    console.log("sendBreak success");
+  }
+
+  this.postStruct_("sendBreak", {}, onSuccess, onError);
+};
+
+/**
+Adds the session to group, leaving any group it is in. Once the server has added it, input
+written to the channel is sent to every session in the group, and opt_onJoined is called.
+@param {string} group
+@param {function()=} opt_onJoined
+*/
+consolechannel.Channel.prototype.joinGroup = function(group, opt_onJoined) {
+  var self = this;
+
+  function onError() {
+    console.error("joinGroup onError");
+  }
+
+  function onSuccess() {
+    console.log("joinGroup success");
+    self.broadcast_ = true;
+    if (opt_onJoined) {
+      opt_onJoined();
+    }
+  }
+
+  this.postStruct_("joinGroup", {group: group}, onSuccess, onError);
+};
+
+/**
+Removes the session from its group. Input written afterwards is only sent to this session.
+*/
+consolechannel.Channel.prototype.leaveGroup = function() {
+  function onError() {
+    console.error("leaveGroup onError");
+  }
+
+  function onSuccess() {
+    console.log("leaveGroup success");
+  }
+
+  this.broadcast_ = false;
+  this.postStruct_("leaveGroup", {}, onSuccess, onError);
 };
 
 /**
@@ -221,8 +342,16 @@ consolechannel.Channel.prototype.startRead = function(io) {
   // }
   var self = this;
 
-  function onError() {
-    console.error("read onError");
+  /**
+  @param {number} status
+  @param {string} message
+  */
+  function onError(status, message) {
+    console.error("read onError", status);
+    if (status == 429) {
+      // the server refused to start the session: show the reason
+      io.writeUTF16("\r\n[" + message.trim() + "]\r\n");
+    }
   }
 
   /** @param {!consolechannel.ResponseUnion} struct */
@@ -236,10 +365,140 @@ consolechannel.Channel.prototype.startRead = function(io) {
   this.postStruct_("read", {}, onSuccess, onError)
 };
 
+/**
+Start watching the session shared with share, a token returned by the server's createShare.
+The session's output is written to io. The channel's session id identifies this viewer.
+@param {!hterm.Terminal.IO} io
+@param {string} share
+*/
+consolechannel.Channel.prototype.startWatch = function(io, share) {
+  var self = this;
+
+  function onError() {
+    console.error("watch onError");
+    io.writeUTF16("\r\n[the shared session ended, or the link expired]\r\n");
+  }
+
+  /** @param {!consolechannel.ResponseUnion} struct */
+  function onSuccess(struct) {
+    io.writeUTF16(struct.data);
+    self.startWatch(io, share);
+  }
+
+  this.postStruct_("watch", {share: share}, onSuccess, onError);
+};
+
+/**
+Creates a read-only link to the session that expires after ttlSeconds, or the server's default
+if it is 0, and calls onCreated with it.
+@param {number} ttlSeconds
+@param {function(!consolechannel.ShareLink)} onCreated
+*/
+consolechannel.Channel.prototype.createShare = function(ttlSeconds, onCreated) {
+  function onError() {
+    console.error("createShare onError");
+  }
+
+  /** @param {!consolechannel.ResponseUnion} struct */
+  function onSuccess(struct) {
+    if (struct.share != null) {
+      onCreated(struct.share);
+    }
+  }
+
+  this.postStruct_("createShare", {ttlSeconds: ttlSeconds}, onSuccess, onError);
+};
+
+/**
+Calls onList with the session's share links that have not expired, and who is watching them.
+@param {function(!Array<!consolechannel.ShareStatus>)} onList
+*/
+consolechannel.Channel.prototype.listShares = function(onList) {
+  function onError() {
+    console.error("listShares onError");
+  }
+
+  /** @param {!consolechannel.ResponseUnion} struct */
+  function onSuccess(struct) {
+    onList(struct.shares);
+  }
+
+  this.postStruct_("listShares", {}, onSuccess, onError);
+};
+
+/**
+Revokes the share link with id, disconnecting its viewers, then calls onRevoked.
+@param {string} id
+@param {function()} onRevoked
+*/
+consolechannel.Channel.prototype.revokeShare = function(id, onRevoked) {
+  function onError() {
+    console.error("revokeShare onError");
+  }
+
+  function onSuccess() {
+    onRevoked();
+  }
+
+  this.postStruct_("revokeShare", {shareId: id}, onSuccess, onError);
+};
+
+/**
+Makes button join the group typed in name, so typing in the terminal is sent to every session
+in it, then leave it when clicked again.
+@param {!consolechannel.Channel} channel
+@param {!HTMLInputElement} name
+@param {!Element} button
+*/
+consolechannel.installGroupControls = function(channel, name, button) {
+  var joined = false;
+  button.textContent = "Join group";
+  button.addEventListener("click", function() {
+    if (joined) {
+      channel.leaveGroup();
+      joined = false;
+      name.disabled = false;
+      button.textContent = "Join group";
+      return;
+    }
+    var group = name.value.trim();
+    if (group == "") {
+      return;
+    }
+    channel.joinGroup(group, function() {
+      joined = true;
+      name.disabled = true;
+      button.textContent = "Leave group";
+    });
+  });
+};
+
+/**
+Returns the size of terminal's character cells in pixels, which may be fractional. hterm only
+exposes it on its private scroll port, so this measures a line of text in the terminal's font.
+@param {!hterm.Terminal} terminal
+@return {{width: number, height: number}}
+*/
+consolechannel.cellSize = function(terminal) {
+  // rows have the terminal's font, and hterm sets their height to the cell height
+  var row = terminal.getRowNode(terminal.getRowCount() - 1);
+  var ruler = terminal.getDocument().createElement("span");
+  ruler.style.cssText = "position: absolute; visibility: hidden; white-space: pre;";
+  // measure many characters to get the fractional width
+  var length = 100;
+  ruler.textContent = new Array(length + 1).join("X");
+  row.parentNode.appendChild(ruler);
+  var width = ruler.getBoundingClientRect().width / length;
+  row.parentNode.removeChild(ruler);
+  return {width: width, height: row.getBoundingClientRect().height};
+};
+
 // export in order to be required by node
 if (typeof module !== "undefined" && module.exports) {
   // can't just assign consolechannel due to Closure namespace aliasing rules
   module.exports = {
     Channel: consolechannel.Channel,
+    cellSize: consolechannel.cellSize,
+    installGroupControls: consolechannel.installGroupControls,
   };
 }
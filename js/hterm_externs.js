@@ -131,6 +131,43 @@ hterm.Terminal.prototype.onTerminalReady = function() {};
 /** @type {!hterm.Terminal.IO} */
 hterm.Terminal.prototype.io;
 
+/**
+@constructor
+@struct
+@param {number} width
+@param {number} height
+*/
+hterm.Size = function(width, height) {};
+/** @type {number} */
+hterm.Size.prototype.width;
+/** @type {number} */
+hterm.Size.prototype.height;
+
+/**
+The size of the terminal in columns (width) and rows (height).
+@type {!hterm.Size}
+*/
+hterm.Terminal.prototype.screenSize;
+
+/**
+Returns the document that contains the terminal's DOM nodes.
+@return {!HTMLDocument}
+*/
+hterm.Terminal.prototype.getDocument = function() {};
+
+/**
+Returns the x-row element for row index, counting from the start of the scrollback.
+@param {number} index
+@return {!HTMLElement}
+*/
+hterm.Terminal.prototype.getRowNode = function(index) {};
+
+/**
+Returns the number of rows, including the scrollback.
+@return {number}
+*/
+hterm.Terminal.prototype.getRowCount = function() {};
+
 /**
  * Set the cursor position.
  *
@@ -0,0 +1,138 @@
+/** @const */
+var tilesDivId = "tiles";
+/** @const */
+var addButtonId = "add";
+/** @const */
+var groupNameId = "group-name";
+
+// number of sessions to open if the sessions query parameter is not set
+/** @const */
+var defaultSessions = 2;
+
+/**
+Returns a random group name, so each page has its own group.
+@return {string}
+*/
+var randomGroupName = function() {
+  var array = new Uint8Array(8);
+  window.crypto.getRandomValues(array);
+  var s = "";
+  for (var i = 0; i < array.byteLength; i++) {
+    s += ("0" + array[i].toString(16)).slice(-2);
+  }
+  return s;
+};
+
+/**
+Returns the number of sessions to open from the sessions query parameter.
+@return {number}
+*/
+var initialSessions = function() {
+  var match = /[?&]sessions=(\d+)/.exec(window.location.search);
+  if (match == null) {
+    return defaultSessions;
+  }
+  return parseInt(match[1], 10);
+};
+
+/**
+Adds a tile with a new session to parent. Input typed in a tile that is in the group is sent
+to every session in the group; unchecking the box removes the session from the group.
+@param {!Element} parent
+@param {string} group
+@param {number} index
+*/
+var addTile = function(parent, group, index) {
+  var tile = document.createElement("div");
+  tile.className = "tile";
+  var header = document.createElement("label");
+  header.className = "tile-header";
+  var checkbox = document.createElement("input");
+  checkbox.type = "checkbox";
+  checkbox.checked = true;
+  header.appendChild(checkbox);
+  var title = document.createElement("span");
+  title.textContent = "session " + index;
+  header.appendChild(title);
+  tile.appendChild(header);
+  var terminalElement = document.createElement("div");
+  terminalElement.className = "tile-terminal";
+  tile.appendChild(terminalElement);
+  parent.appendChild(tile);
+
+  /** @type{!hterm.Terminal} */
+  var terminal = new hterm.Terminal("tile" + index);
+
+  terminal.onTerminalReady = function() {
+    var io = terminal.io.push();
+    var channel = new consolechannel.Channel(new consolechannel.BrowserEnvironment(), "", {});
+
+    function send(str) {
+      channel.write(str);
+    }
+    io.onVTKeystroke = send;
+    io.sendString = send;
+
+    /**
+    @param {number} columns
+    @param {number} rows
+    */
+    function setSize(columns, rows) {
+      var cellSize = consolechannel.cellSize(terminal);
+      channel.setSize(columns, rows, cellSize.width, cellSize.height);
+    }
+    io.onTerminalResize = setSize;
+
+    terminal.setCursorPosition(0, 0);
+    terminal.setCursorVisible(true);
+    terminal.installKeyboard();
+
+    // send the initial size first: the first request starts the session
+    setSize(terminal.screenSize.width, terminal.screenSize.height);
+    channel.joinGroup(group);
+    channel.startRead(io);
+
+    checkbox.addEventListener("change", function() {
+      if (checkbox.checked) {
+        channel.joinGroup(group);
+      } else {
+        channel.leaveGroup();
+      }
+    });
+  };
+
+  terminal.decorate(/** @type{!HTMLDivElement} */ (terminalElement));
+};
+
+/** called on document load */
+var groupLoaded = function() {
+  var tiles = document.getElementById(tilesDivId);
+  if (tiles == null) {
+    throw new Error("Tiles element id " + tilesDivId + " does not exist");
+  }
+
+  // from https://chromium.googlesource.com/apps/libapps/+/master/hterm/doc/embed.md
+  hterm.defaultStorage = new lib.Storage.Memory();
+
+  var group = randomGroupName();
+  var groupName = document.getElementById(groupNameId);
+  if (groupName != null) {
+    groupName.textContent = group;
+  }
+
+  var count = 0;
+  var sessions = initialSessions();
+  for (; count < sessions; count++) {
+    addTile(tiles, group, count + 1);
+  }
+
+  var addButton = document.getElementById(addButtonId);
+  if (addButton != null) {
+    addButton.addEventListener("click", function() {
+      count++;
+      addTile(tiles, group, count);
+    });
+  }
+};
+
+document.addEventListener('DOMContentLoaded', groupLoaded);
@@ -1,5 +1,9 @@
 /** @const */
 var terminalDivId = "terminal";
+/** @const */
+var groupNameId = "group-name";
+/** @const */
+var groupButtonId = "group-button";
 
 /** called on document load */
 var loaded = function() {
@@ -20,7 +24,7 @@ var loaded = function() {
   terminal.onTerminalReady = function() {
     // Create a new terminal IO object and give it the foreground.
     var io = terminal.io.push();
-    var channel = new consolechannel.Channel(new consolechannel.BrowserEnvironment(), "/", consoleExtra);
+    var channel = new consolechannel.Channel(new consolechannel.BrowserEnvironment(), "", consoleExtra);
 
     function send(str) {
       console.log("key/send from terminal:", str);
@@ -29,12 +33,21 @@ var loaded = function() {
     io.onVTKeystroke = send;
     io.sendString = send;
 
+    /**
+    @param {number} columns
+    @param {number} rows
+    */
+    function setSize(columns, rows) {
+      var cellSize = consolechannel.cellSize(terminal);
+      channel.setSize(columns, rows, cellSize.width, cellSize.height);
+    }
+
     io.onTerminalResize = function(columns, rows) {
       // React to size changes here.
       // Secure Shell pokes at NaCl, which eventually results in
       // some ioctls on the host.
       console.log("onTerminalResize", columns, rows);
-      channel.setSize(columns, rows);
+      setSize(columns, rows);
     };
 
     console.log("hello terminal.onTerminalReady()");
@@ -42,7 +55,14 @@ var loaded = function() {
     terminal.setCursorVisible(true);
     terminal.installKeyboard();
 
+    // send the initial size first: the first request starts the session
+    setSize(terminal.screenSize.width, terminal.screenSize.height);
     channel.startRead(io);
+    var groupName = document.getElementById(groupNameId);
+    var groupButton = document.getElementById(groupButtonId);
+    if (groupName != null && groupButton != null) {
+      consolechannel.installGroupControls(channel, /** @type {!HTMLInputElement} */ (groupName), groupButton);
+    }
   };
 
   console.log("decorating", terminalElement);
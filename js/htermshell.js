@@ -1,5 +1,113 @@
 /** @const */
 var terminalDivId = "terminal";
+/** @const */
+var shareButtonId = "share-button";
+/** @const */
+var sharePanelId = "share-panel";
+/** @const */
+var groupNameId = "group-name";
+/** @const */
+var groupButtonId = "group-button";
+
+// how often the list of share links and viewers is refreshed while it is shown
+/** @const */
+var shareRefreshMS = 5000;
+
+/**
+Returns a new element with tag name and text.
+@param {string} name
+@param {string} text
+@return {!Element}
+*/
+var newElement = function(name, text) {
+  var element = document.createElement(name);
+  element.textContent = text;
+  return element;
+};
+
+/**
+Shows shares in list: each link's expiry and viewers, and a button that revokes it.
+@param {!consolechannel.Channel} channel
+@param {!Element} list
+@param {!Array<!consolechannel.ShareStatus>} shares
+@param {function()} refresh
+*/
+var showShares = function(channel, list, shares, refresh) {
+  list.textContent = "";
+  if (shares.length == 0) {
+    list.appendChild(newElement("p", "No share links."));
+    return;
+  }
+  shares.forEach(function(share) {
+    var item = newElement("li", "Link " + share.id.slice(0, 8) + " expires " +
+        new Date(share.expires).toLocaleString() + " ");
+    var revoke = newElement("button", "Revoke");
+    revoke.addEventListener("click", function() {
+      channel.revokeShare(share.id, refresh);
+    });
+    item.appendChild(revoke);
+
+    var viewers = document.createElement("ul");
+    if (share.viewers.length == 0) {
+      viewers.appendChild(newElement("li", "no viewers"));
+    }
+    share.viewers.forEach(function(viewer) {
+      var who = viewer.principal != "" ? viewer.principal + " (" + viewer.remoteAddr + ")" : viewer.remoteAddr;
+      viewers.appendChild(newElement("li", "watched by " + who + " since " +
+          new Date(viewer.joined).toLocaleTimeString()));
+    });
+    item.appendChild(viewers);
+    list.appendChild(item);
+  });
+};
+
+/**
+Adds controls to create, list and revoke read-only links to the session, if the page has the
+share button and panel.
+@param {!consolechannel.Channel} channel
+*/
+var installShareControls = function(channel) {
+  var button = document.getElementById(shareButtonId);
+  var panel = /** @type {?HTMLElement} */ (document.getElementById(sharePanelId));
+  if (button == null || panel == null) {
+    return;
+  }
+
+  var create = newElement("button", "Create read-only link");
+  var link = /** @type {!HTMLInputElement} */ (document.createElement("input"));
+  link.readOnly = true;
+  link.hidden = true;
+  var list = document.createElement("ul");
+  panel.appendChild(create);
+  panel.appendChild(link);
+  panel.appendChild(list);
+
+  function refresh() {
+    channel.listShares(function(shares) {
+      showShares(channel, list, shares, refresh);
+    });
+  }
+
+  create.addEventListener("click", function() {
+    channel.createShare(0, function(share) {
+      // the token is only returned once: show the link so it can be copied
+      link.value = new URL(share.url, window.location.href).href;
+      link.hidden = false;
+      link.select();
+      refresh();
+    });
+  });
+
+  var timer = 0;
+  button.addEventListener("click", function() {
+    panel.hidden = !panel.hidden;
+    window.clearInterval(timer);
+    if (!panel.hidden) {
+      refresh();
+      timer = window.setInterval(refresh, shareRefreshMS);
+    }
+  });
+};
 
 /** called on document load */
 var loaded = function() {
@@ -20,7 +128,7 @@ var loaded = function() {
   terminal.onTerminalReady = function() {
     // Create a new terminal IO object and give it the foreground.
     var io = terminal.io.push();
-    var channel = new consolechannel.Channel(new consolechannel.BrowserEnvironment(), "/", {});
+    var channel = new consolechannel.Channel(new consolechannel.BrowserEnvironment(), "", {});
 
     function send(str) {
       console.log("key/send from terminal:", str);
@@ -29,12 +137,21 @@ var loaded = function() {
     io.onVTKeystroke = send;
     io.sendString = send;
 
+    /**
+    @param {number} columns
+    @param {number} rows
+    */
+    function setSize(columns, rows) {
+      var cellSize = consolechannel.cellSize(terminal);
+      channel.setSize(columns, rows, cellSize.width, cellSize.height);
+    }
+
     io.onTerminalResize = function(columns, rows) {
       // React to size changes here.
       // Secure Shell pokes at NaCl, which eventually results in
       // some ioctls on the host.
       console.log("onTerminalResize", columns, rows);
-      channel.setSize(columns, rows);
+      setSize(columns, rows);
     };
 
     console.log("hello terminal.onTerminalReady()");
@@ -42,7 +159,15 @@ var loaded = function() {
     terminal.setCursorVisible(true);
     terminal.installKeyboard();
 
+    // send the initial size first: the first request starts the session
+    setSize(terminal.screenSize.width, terminal.screenSize.height);
     channel.startRead(io);
+    installShareControls(channel);
+    var groupName = document.getElementById(groupNameId);
+    var groupButton = document.getElementById(groupButtonId);
+    if (groupName != null && groupButton != null) {
+      consolechannel.installGroupControls(channel, /** @type {!HTMLInputElement} */ (groupName), groupButton);
+    }
   };
 
   console.log("decorating", terminalElement);
@@ -0,0 +1,47 @@
+/** @const */
+var watchDivId = "terminal";
+
+/**
+Returns the share token from the share query parameter, or null if it is missing.
+@return {?string}
+*/
+var shareToken = function() {
+  var match = /[?&]share=([^&]*)/.exec(window.location.search);
+  if (match == null) {
+    return null;
+  }
+  return decodeURIComponent(match[1]);
+};
+
+/** called on document load */
+var watchLoaded = function() {
+  var terminalElement = document.getElementById(watchDivId);
+  if (terminalElement == null) {
+    throw new Error("Terminal element id " + watchDivId + " does not exist");
+  }
+
+  // from https://chromium.googlesource.com/apps/libapps/+/master/hterm/doc/embed.md
+  hterm.defaultStorage = new lib.Storage.Memory();
+
+  /** @type{!hterm.Terminal} */
+  var terminal = new hterm.Terminal("watch");
+
+  terminal.onTerminalReady = function() {
+    var io = terminal.io.push();
+    // viewers can not type into the session: keystrokes are discarded
+    io.onVTKeystroke = function(str) {};
+    io.sendString = function(str) {};
+
+    var share = shareToken();
+    if (share == null) {
+      io.writeUTF16("missing share parameter\r\n");
+      return;
+    }
+    var channel = new consolechannel.Channel(new consolechannel.BrowserEnvironment(), "", {});
+    channel.startWatch(io, share);
+  };
+
+  terminal.decorate(/** @type{!HTMLDivElement} */ (terminalElement));
+};
+
+document.addEventListener('DOMContentLoaded', watchLoaded);
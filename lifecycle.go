@@ -0,0 +1,160 @@
+package hterm
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/evanj/hterm/vt"
+)
+
+// sessionLifecycle is the state of a session. Sessions only move forward through the states;
+// they skip exiting if they are closed while running, and go from starting to closed if the
+// program fails to start.
+type sessionLifecycle int
+
+const (
+	// the session is in Server.sessions, and the request that created it is starting its
+	// program; other requests for it wait until it leaves this state
+	sessionStarting sessionLifecycle = iota
+	// the program is running
+	sessionRunning
+	// the program's output ended, usually because it exited; the client has not read the rest
+	// of the output yet
+	sessionExiting
+	// the session was closed and removed from Server.sessions
+	sessionClosed
+)
+
+var sessionLifecycleNames = [...]string{"starting", "running", "exiting", "closed"}
+
+func (l sessionLifecycle) String() string {
+	return sessionLifecycleNames[l]
+}
+
+// setStateLocked moves session to state, and returns true if it did. It returns false if that
+// is not a transition from the session's current state, which happens when the session is
+// closed concurrently. session.mu must be held.
+func (session *sessionState) setStateLocked(state sessionLifecycle) bool {
+	if state <= session.state || (session.state == sessionStarting && state == sessionExiting) {
+		return false
+	}
+	log.Printf("session %s: %s -> %s", session.id, session.state, state)
+	session.state = state
+	return true
+}
+
+// lookupSession returns the session for req, waiting for it to start if another request is
+// starting it. If it does not exist and start is true, it starts the session: concurrent
+// requests for a new id start a single session, which they all use. If start is false, it
+// returns a nil session if the session does not exist.
+func (s *Server) lookupSession(r *http.Request, req *requestUnion, start bool) (*sessionState, error) {
+	s.mu.Lock()
+	session := s.sessions[req.SessionId]
+	if session != nil {
+		s.mu.Unlock()
+		select {
+		case <-session.started:
+		case <-r.Context().Done():
+			return nil, r.Context().Err()
+		}
+		if session.startErr != nil {
+			return nil, session.startErr
+		}
+		return session, nil
+	}
+	_, finished := s.finished[req.SessionId]
+	if finished {
+		s.mu.Unlock()
+		return nil, errSessionFinished
+	}
+	if !start {
+		s.mu.Unlock()
+		return nil, nil
+	}
+
+	size, err := req.size()
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	principal := PrincipalFromContext(r.Context())
+	client := sessionClient(r.RemoteAddr)
+	startTime := time.Now()
+	err = s.reserveSessionLocked(principal, client, startTime)
+	if err != nil {
+		s.mu.Unlock()
+		return nil, err
+	}
+	// add the session before starting it, so concurrent requests wait for it
+	log.Printf("creating new session id %s", req.SessionId)
+	session = newSessionState(req.SessionId)
+	session.principal = principal
+	session.client = client
+	session.startTime = startTime
+	// NewScreen uses the default size if the size was not sent
+	session.screen = vt.NewScreen(size.Columns, size.Rows)
+	s.sessions[req.SessionId] = session
+	s.mu.Unlock()
+
+	err = s.startSession(r, req, session, size)
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// startSession starts the program for session, which lookupSession just added, then wakes up
+// the requests waiting for it. If it fails, the session is removed so a later request can try
+// again.
+func (s *Server) startSession(r *http.Request, req *requestUnion, session *sessionState, size Size) error {
+	startRequest := &StartRequest{
+		Principal:  session.principal,
+		RemoteAddr: r.RemoteAddr,
+		Header:     r.Header,
+		Cookies:    r.Cookies(),
+		Size:       size,
+		Extra:      req.Extra,
+	}
+	stream, err := s.starter.StartContext(r.Context(), startRequest)
+	if err != nil {
+		s.mu.Lock()
+		if s.sessions[session.id] == session {
+			delete(s.sessions, session.id)
+			s.releaseSessionLocked(session.principal)
+			// a session that failed to start does not count as a new session for the client
+			s.forgetStartLocked(session.client, session.startTime)
+		}
+		s.mu.Unlock()
+
+		session.mu.Lock()
+		session.startErr = err
+		session.setStateLocked(sessionClosed)
+		close(session.done)
+		session.mu.Unlock()
+		close(session.started)
+		return err
+	}
+
+	session.stream = stream
+	for _, newFilter := range s.inputFilters {
+		filter := newFilter(startRequest)
+		if filter != nil {
+			session.filters = append(session.filters, filter)
+		}
+	}
+	for _, newFilter := range s.outputFilters {
+		filter := newFilter(startRequest)
+		if filter != nil {
+			session.outputFilters = append(session.outputFilters, filter)
+		}
+	}
+	// before other requests can close the session
+	s.startIdleTimer(session)
+	session.mu.Lock()
+	session.setStateLocked(sessionRunning)
+	session.mu.Unlock()
+	close(session.started)
+	go pumpOutput(session)
+	return nil
+}
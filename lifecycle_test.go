@@ -0,0 +1,236 @@
+package hterm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// slowStarter starts sessions with starter after a delay, so concurrent requests overlap.
+type slowStarter struct {
+	starter ContextSessionStarter
+	delay   time.Duration
+	calls   int32
+}
+
+func (s *slowStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	atomic.AddInt32(&s.calls, 1)
+	time.Sleep(s.delay)
+	return s.starter.StartContext(ctx, req)
+}
+
+func TestConcurrentSessionStart(t *testing.T) {
+	fake := &fakeStarter{}
+	starter := &slowStarter{starter: fake, delay: 10 * time.Millisecond}
+	server := NewContextServer(starter, WithLimits(Limits{MaxSessionsPerPrincipal: 20}))
+	mux := newTestMux(server)
+
+	// each session gets the requests the page sends when it loads, all at once
+	const sessions = 20
+	requests := []struct{ path, body string }{
+		{"/open", `{"session_id": "%d"}`},
+		{"/setSize", `{"session_id": "%d", "columns": 80, "rows": 24}`},
+		{"/write", `{"session_id": "%d", "data": "x"}`},
+		{"/joinGroup", `{"session_id": "%d", "group": "g"}`},
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < sessions; i++ {
+		for repeat := 0; repeat < 3; repeat++ {
+			for _, request := range requests {
+				wg.Add(1)
+				go func(path string, body string) {
+					defer wg.Done()
+					w := post(t, mux, path, body)
+					if w.Code != http.StatusOK {
+						t.Error(path, w.Code, w.Body.String())
+					}
+				}(request.path, fmt.Sprintf(request.body, i))
+			}
+		}
+	}
+	wg.Wait()
+
+	if calls := atomic.LoadInt32(&starter.calls); calls != sessions {
+		t.Errorf("started %d processes for %d sessions", calls, sessions)
+	}
+	server.mu.Lock()
+	if len(server.sessions) != sessions || server.sessionCount != sessions ||
+		len(server.groups[groupKey{"alice", "g"}]) != sessions {
+		t.Error("unexpected sessions:", len(server.sessions), server.sessionCount)
+	}
+	server.mu.Unlock()
+	for _, stream := range fake.streams {
+		if stream.written() != "xxx" {
+			t.Errorf("each write must be sent to the one session: %#v", stream.written())
+		}
+	}
+}
+
+// blockedFailingStarter fails to start sessions, after the test closes release.
+type blockedFailingStarter struct {
+	calls int32
+	// receives each call to StartContext
+	starting chan struct{}
+	release  chan struct{}
+}
+
+func (f *blockedFailingStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	atomic.AddInt32(&f.calls, 1)
+	f.starting <- struct{}{}
+	<-f.release
+	return nil, errors.New("no ptys")
+}
+
+// waitingContext sends to waiting the first time Done is called, which is when a request starts
+// waiting for the session another request is starting.
+type waitingContext struct {
+	context.Context
+	waiting chan<- struct{}
+	once    sync.Once
+}
+
+func (c *waitingContext) Done() <-chan struct{} {
+	c.once.Do(func() { c.waiting <- struct{}{} })
+	return c.Context.Done()
+}
+
+func TestConcurrentSessionStartFailure(t *testing.T) {
+	starter := &blockedFailingStarter{starting: make(chan struct{}, 2), release: make(chan struct{})}
+	server := NewContextServer(starter)
+	mux := newTestMux(server)
+
+	var wg sync.WaitGroup
+	open := func(ctx context.Context) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w := postContext(ctx, t, mux, "/open", `{"session_id": "s1"}`)
+			if w.Code != http.StatusInternalServerError {
+				t.Error("requests waiting for the session must get its error:", w.Code, w.Body.String())
+			}
+		}()
+	}
+	// fail the start once the other requests are waiting for it
+	open(context.Background())
+	<-starter.starting
+	const waiters = 9
+	waiting := make(chan struct{})
+	for i := 0; i < waiters; i++ {
+		open(&waitingContext{Context: context.Background(), waiting: waiting})
+	}
+	for i := 0; i < waiters; i++ {
+		<-waiting
+	}
+	close(starter.release)
+	wg.Wait()
+	if calls := atomic.LoadInt32(&starter.calls); calls != 1 {
+		t.Error("expected one start:", calls)
+	}
+
+	// the session can be started again
+	post(t, mux, "/open", `{"session_id": "s1"}`)
+	if calls := atomic.LoadInt32(&starter.calls); calls != 2 {
+		t.Error("expected the session to be started again:", calls)
+	}
+	server.mu.Lock()
+	if len(server.sessions) != 0 || server.sessionCount != 0 {
+		t.Error("failed sessions must be forgotten:", len(server.sessions), server.sessionCount)
+	}
+	server.mu.Unlock()
+}
+
+func TestSessionLifecycle(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter)
+	mux := newTestMux(server)
+
+	w := post(t, mux, "/open", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	server.mu.Lock()
+	session := server.sessions["s1"]
+	server.mu.Unlock()
+	state := func() sessionLifecycle {
+		session.mu.Lock()
+		defer session.mu.Unlock()
+		return session.state
+	}
+	if state() != sessionRunning {
+		t.Error("expected running:", state())
+	}
+
+	// the program exits after writing output
+	go func() {
+		starter.streams[0].writer.Write([]byte("bye"))
+		starter.streams[0].writer.Close()
+	}()
+	for deadline := time.Now().Add(5 * time.Second); state() != sessionExiting && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	if state() != sessionExiting {
+		t.Fatal("expected exiting:", state())
+	}
+
+	// the client reads the rest of the output, then the session closes
+	w = post(t, mux, "/read", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK || w.Body.String() != "{\"data\":\"bye\"}\n" {
+		t.Error(w.Code, w.Body.String())
+	}
+	w = post(t, mux, "/read", `{"session_id": "s1"}`)
+	if w.Code != http.StatusGone {
+		t.Error(w.Code, w.Body.String())
+	}
+	if state() != sessionClosed {
+		t.Error("expected closed:", state())
+	}
+	session.mu.Lock()
+	if session.setStateLocked(sessionRunning) {
+		t.Error("closed sessions must not move back")
+	}
+	session.mu.Unlock()
+	w = post(t, mux, "/open", `{"session_id": "s1"}`)
+	if w.Code != http.StatusGone || len(starter.streams) != 1 {
+		t.Error("closed sessions must not be restarted:", w.Code, len(starter.streams))
+	}
+}
+
+func TestCloseSessionDuringRead(t *testing.T) {
+	server := NewContextServer(&fakeStarter{})
+	mux := newTestMux(server)
+	w := post(t, mux, "/open", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	server.mu.Lock()
+	session := server.sessions["s1"]
+	server.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	readDone := make(chan int)
+	go func() {
+		readDone <- postContext(ctx, t, mux, "/read", `{"session_id": "s1"}`).Code
+	}()
+	for reads := 0; reads == 0; {
+		time.Sleep(time.Millisecond)
+		session.mu.Lock()
+		reads = session.reads
+		session.mu.Unlock()
+	}
+
+	w = post(t, mux, "/close", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	code := <-readDone
+	if code != http.StatusGone || ctx.Err() != nil {
+		t.Error("the pending read must return when the session is closed:", code, ctx.Err())
+	}
+}
@@ -0,0 +1,168 @@
+package hterm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultMacroDelay is the delay between the steps of a macro that does not set one.
+const DefaultMacroDelay = 50 * time.Millisecond
+
+// Macro is a named sequence of input that can be played into a session.
+type Macro struct {
+	Name string `json:"name"`
+	// Steps are written to the session in order, with Delay between them.
+	Steps []string `json:"steps"`
+	// Delay is the time between steps in nanoseconds. If it is zero, DefaultMacroDelay is used.
+	Delay time.Duration `json:"delay"`
+}
+
+// MacroStore stores each user's macros. Users are identified by the request's principal, set
+// with WithPrincipal, so unauthenticated requests share the macros of the empty principal.
+type MacroStore interface {
+	// Macro returns principal's macro called name, or nil if it does not exist.
+	Macro(principal string, name string) (*Macro, error)
+	// SaveMacro adds or replaces principal's macro with the same name.
+	SaveMacro(principal string, macro *Macro) error
+}
+
+type memoryMacroStore struct {
+	mu sync.Mutex
+	// maps principal to name to macro
+	macros map[string]map[string]*Macro
+}
+
+// NewMemoryMacroStore returns a MacroStore that keeps macros in memory, until the server exits.
+func NewMemoryMacroStore() MacroStore {
+	return &memoryMacroStore{macros: map[string]map[string]*Macro{}}
+}
+
+func (m *memoryMacroStore) Macro(principal string, name string) (*Macro, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.macros[principal][name], nil
+}
+
+func (m *memoryMacroStore) SaveMacro(principal string, macro *Macro) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.macros[principal] == nil {
+		m.macros[principal] = map[string]*Macro{}
+	}
+	m.macros[principal][macro.Name] = macro
+	return nil
+}
+
+type fileMacroStore struct {
+	path string
+
+	mu     sync.Mutex
+	macros map[string]map[string]*Macro
+}
+
+// NewFileMacroStore returns a MacroStore that keeps macros in the JSON file at path, which maps
+// principals to macro names to macros. The file is created when the first macro is saved.
+func NewFileMacroStore(path string) (MacroStore, error) {
+	f := &fileMacroStore{path: path, macros: map[string]map[string]*Macro{}}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &f.macros)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return f, nil
+}
+
+func (f *fileMacroStore) Macro(principal string, name string) (*Macro, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.macros[principal][name], nil
+}
+
+// SaveMacro writes the file to a temporary file then renames it, so it is never partly written.
+func (f *fileMacroStore) SaveMacro(principal string, macro *Macro) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.macros[principal] == nil {
+		f.macros[principal] = map[string]*Macro{}
+	}
+	previous := f.macros[principal][macro.Name]
+	f.macros[principal][macro.Name] = macro
+
+	err := f.write()
+	if err != nil {
+		// keep the memory consistent with the file
+		if previous == nil {
+			delete(f.macros[principal], macro.Name)
+		} else {
+			f.macros[principal][macro.Name] = previous
+		}
+	}
+	return err
+}
+
+func (f *fileMacroStore) write() error {
+	data, err := json.MarshalIndent(f.macros, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(f.path), filepath.Base(f.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	err2 := tmp.Close()
+	if err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// errMacrosDisabled is returned for macro requests if the server has no MacroStore.
+var errMacrosDisabled = errors.New("macros are not enabled")
+
+// playMacro writes the macro's steps to w, waiting delay between them, or the macro's delay if
+// delay is zero. It stops if ctx is cancelled.
+func playMacro(ctx context.Context, w io.Writer, macro *Macro, delay time.Duration) error {
+	if delay == 0 {
+		delay = macro.Delay
+	}
+	if delay == 0 {
+		delay = DefaultMacroDelay
+	}
+	for i, step := range macro.Steps {
+		if i > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			}
+		}
+		_, err := w.Write([]byte(step))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package hterm
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMacroStores(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "macros.json")
+
+	fileStore, err := NewFileMacroStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, store := range []MacroStore{NewMemoryMacroStore(), fileStore} {
+		macro, err := store.Macro("alice", "m")
+		if macro != nil || err != nil {
+			t.Error("expected no macro:", macro, err)
+		}
+		err = store.SaveMacro("alice", &Macro{Name: "m", Steps: []string{"a"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = store.SaveMacro("alice", &Macro{Name: "m", Steps: []string{"b"}, Delay: time.Second})
+		if err != nil {
+			t.Fatal(err)
+		}
+		macro, err = store.Macro("alice", "m")
+		if err != nil || !reflect.DeepEqual(macro, &Macro{"m", []string{"b"}, time.Second}) {
+			t.Error("unexpected macro:", macro, err)
+		}
+		// macros belong to a principal
+		macro, err = store.Macro("bob", "m")
+		if macro != nil || err != nil {
+			t.Error("expected no macro for another principal:", macro, err)
+		}
+	}
+
+	// the file store keeps macros after a restart
+	fileStore, err = NewFileMacroStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	macro, err := fileStore.Macro("alice", "m")
+	if err != nil || !reflect.DeepEqual(macro, &Macro{"m", []string{"b"}, time.Second}) {
+		t.Error("unexpected macro after reloading:", macro, err)
+	}
+
+	err = ioutil.WriteFile(path, []byte("{"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewFileMacroStore(path)
+	if err == nil {
+		t.Error("expected an error for an invalid file")
+	}
+}
+
+func TestPlayMacro(t *testing.T) {
+	macro := &Macro{Name: "m", Steps: []string{"a", "b", "c"}, Delay: 20 * time.Millisecond}
+	out := &bytes.Buffer{}
+	start := time.Now()
+	err := playMacro(context.Background(), out, macro, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "abc" {
+		t.Error("unexpected output:", out.String())
+	}
+	if time.Since(start) < 40*time.Millisecond {
+		t.Error("expected a delay between steps:", time.Since(start))
+	}
+
+	// cancelling stops the macro
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	out.Reset()
+	err = playMacro(ctx, out, macro, time.Hour)
+	if err != context.Canceled || out.String() != "a" {
+		t.Error("expected the macro to stop:", err, out.String())
+	}
+}
+
+func TestServerMacros(t *testing.T) {
+	// macros are disabled by default
+	mux := newTestMux(NewContextServer(&fakeStarter{}))
+	w := post(t, mux, "/write", `{"session_id": "s1", "macro": "m"}`)
+	if w.Code != http.StatusInternalServerError {
+		t.Error("expected an error without a macro store:", w.Code)
+	}
+
+	starter := &fakeStarter{}
+	store := NewMemoryMacroStore()
+	mux = newTestMux(NewContextServer(starter, WithMacroStore(store)))
+
+	requests := []string{
+		`{"session_id": "s1", "record_macro": "m", "data": "ls"}`,
+		`{"session_id": "s1", "data": "\r"}`,
+		`{"session_id": "s1", "stop_recording": true, "data": "x"}`,
+		`{"session_id": "s1", "macro": "m", "macro_delay_ms": 1, "data": "y"}`,
+	}
+	for _, request := range requests {
+		w = post(t, mux, "/write", request)
+		if w.Code != http.StatusOK {
+			t.Fatal(request, w.Code, w.Body.String())
+		}
+	}
+	if starter.streams[0].written() != "ls\rxls\ry" {
+		t.Errorf("unexpected input: %q", starter.streams[0].written())
+	}
+	// post authenticates as alice
+	macro, err := store.Macro("alice", "m")
+	if err != nil || !reflect.DeepEqual(macro, &Macro{Name: "m", Steps: []string{"ls", "\r"}}) {
+		t.Error("unexpected recorded macro:", macro, err)
+	}
+
+	errors := []string{
+		`{"session_id": "s1", "macro": "unknown"}`,
+		`{"session_id": "s1", "stop_recording": true}`,
+		`{"session_id": "s1", "macro": "m", "macro_delay_ms": -1}`,
+	}
+	for _, request := range errors {
+		w = post(t, mux, "/write", request)
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("%s: expected an error; got %d", request, w.Code)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package hterm
+
+import (
+	"log"
+	"time"
+)
+
+// the most output buffered for a session that is not being read: the session's stream is not
+// read until the client catches up, so the program blocks instead of using unlimited memory
+const maxPendingOutput = 64 * 1024
+
+// signal wakes up a goroutine waiting on c, which must have a buffer of 1, without blocking.
+func signal(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
+
+// the output filters are asked for the output they hold back when the session has not produced
+// output for outputFlushDelay
+const outputFlushDelay = 20 * time.Millisecond
+
+// outputChunk is a read from a session's stream.
+type outputChunk struct {
+	data []byte
+	err  error
+}
+
+// readOutput sends the reads from the session's stream to chunks, until the stream returns an
+// error or the session is closed.
+func readOutput(session *sessionState, chunks chan<- outputChunk) {
+	for {
+		buffer := make([]byte, 4096)
+		n, err := session.stream.Read(buffer)
+		select {
+		case chunks <- outputChunk{buffer[:n], err}:
+		case <-session.done:
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// filterOutput passes data through the session's output filters. If flush is not nil, it is
+// called after each filter, to include the output the filter held back.
+func (session *sessionState) filterOutput(data []byte, flush func(OutputFilter) []byte) []byte {
+	for _, filter := range session.outputFilters {
+		data = filter.FilterOutput(data)
+		if flush != nil {
+			data = append(data, flush(filter)...)
+		}
+	}
+	return data
+}
+
+// pumpOutput reads the session's output until the stream returns an error. The output is
+// filtered, then it updates the screen and the viewers as soon as it is read, and is buffered
+// for readHandler.
+func pumpOutput(session *sessionState) {
+	chunks := make(chan outputChunk)
+	go readOutput(session, chunks)
+
+	flushTimer := time.NewTimer(outputFlushDelay)
+	flushTimer.Stop()
+	defer flushTimer.Stop()
+	for {
+		var data []byte
+		var err error
+		select {
+		case chunk := <-chunks:
+			err = chunk.err
+			var flush func(OutputFilter) []byte
+			if err != nil {
+				flush = OutputFilter.Flush
+			}
+			data = session.filterOutput(chunk.data, flush)
+			if len(session.outputFilters) > 0 && err == nil {
+				flushTimer.Stop()
+				flushTimer = time.NewTimer(outputFlushDelay)
+			}
+		case <-flushTimer.C:
+			data = session.filterOutput(nil, OutputFilter.FlushIdle)
+		case <-session.done:
+			return
+		}
+
+		session.mu.Lock()
+		if len(data) > 0 {
+			session.screen.Write(data)
+			fanOutLocked(session, data)
+			session.output = append(session.output, data...)
+		}
+		if err != nil {
+			// a pty returns an error instead of EOF when the process exits
+			log.Printf("session %s: output finished: %s", session.id, err.Error())
+			session.outputErr = err
+			session.setStateLocked(sessionExiting)
+		}
+		session.mu.Unlock()
+		signal(session.outputReady)
+		if err != nil {
+			return
+		}
+
+		for {
+			session.mu.Lock()
+			full := len(session.output) >= maxPendingOutput
+			session.mu.Unlock()
+			if !full {
+				break
+			}
+			select {
+			case <-session.outputSpace:
+			case <-session.done:
+				return
+			}
+		}
+	}
+}
+
+// notice shows message on the client's terminal, on a line of its own. It is only sent to the
+// client: it is not part of the session's output, so the screen and viewers do not see it.
+func (session *sessionState) notice(message string) {
+	session.mu.Lock()
+	session.output = append(session.output, "\r\n\x1b[7m["+message+"]\x1b[0m\r\n"...)
+	session.mu.Unlock()
+	signal(session.outputReady)
+}
@@ -0,0 +1,168 @@
+package hterm
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"time"
+)
+
+// Limits caps the sessions a Server starts, so clients can not use all the server's processes
+// and terminals by sending requests with new session ids. Zero fields are not limited.
+type Limits struct {
+	// the most sessions running at once
+	MaxSessions int
+	// the most sessions running at once for each principal; requests without a principal all
+	// count as the empty principal
+	MaxSessionsPerPrincipal int
+	// the most sessions started in a minute by each client, identified by its IP address
+	MaxNewSessionsPerMinute int
+	// sessions are closed when their client has not read their output for this long, so
+	// sessions abandoned by clients that went away without closing them do not count forever
+	IdleTimeout time.Duration
+}
+
+// WithLimits limits the sessions the server starts. Requests that would start a session over
+// the limits fail with 429 Too Many Requests, and the message is shown on the terminal.
+func WithLimits(limits Limits) ServerOption {
+	return func(s *Server) {
+		s.limits = limits
+	}
+}
+
+// LimitError is returned when starting a session would exceed the server's Limits.
+type LimitError struct {
+	Reason string
+}
+
+func (e *LimitError) Error() string {
+	return "cannot start a new session: " + e.Reason
+}
+
+// the period of Limits.MaxNewSessionsPerMinute
+const newSessionsPeriod = time.Minute
+
+// sessionClient returns the client that sent a request from remoteAddr: its IP address.
+func sessionClient(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+// reserveSessionLocked counts a session that principal is starting from client, or returns a
+// LimitError if it would exceed the limits. s.mu must be held. The session must be released
+// with releaseSessionLocked if it does not start, or when it is closed.
+func (s *Server) reserveSessionLocked(principal string, client string, now time.Time) error {
+	if s.limits.MaxSessions > 0 && s.sessionCount >= s.limits.MaxSessions {
+		return &LimitError{fmt.Sprintf("the server is running the maximum of %d sessions",
+			s.limits.MaxSessions)}
+	}
+	if s.limits.MaxSessionsPerPrincipal > 0 &&
+		s.principalSessions[principal] >= s.limits.MaxSessionsPerPrincipal {
+		return &LimitError{fmt.Sprintf("you are running the maximum of %d sessions",
+			s.limits.MaxSessionsPerPrincipal)}
+	}
+	if s.limits.MaxNewSessionsPerMinute > 0 {
+		// forget the starts that are too old to count, for all clients
+		for c, starts := range s.clientStarts {
+			for len(starts) > 0 && now.Sub(starts[0]) >= newSessionsPeriod {
+				starts = starts[1:]
+			}
+			if len(starts) == 0 {
+				delete(s.clientStarts, c)
+			} else {
+				s.clientStarts[c] = starts
+			}
+		}
+		if len(s.clientStarts[client]) >= s.limits.MaxNewSessionsPerMinute {
+			return &LimitError{fmt.Sprintf("started the maximum of %d sessions in the last minute; try again later",
+				s.limits.MaxNewSessionsPerMinute)}
+		}
+		s.clientStarts[client] = append(s.clientStarts[client], now)
+	}
+
+	s.sessionCount++
+	s.principalSessions[principal]++
+	return nil
+}
+
+// releaseSessionLocked stops counting a session reserved by reserveSessionLocked. s.mu must be
+// held.
+func (s *Server) releaseSessionLocked(principal string) {
+	s.sessionCount--
+	s.principalSessions[principal]--
+	if s.principalSessions[principal] == 0 {
+		delete(s.principalSessions, principal)
+	}
+}
+
+// forgetStartLocked removes a start recorded by reserveSessionLocked for client at start, if it
+// is still counted. s.mu must be held.
+func (s *Server) forgetStartLocked(client string, start time.Time) {
+	starts := s.clientStarts[client]
+	for i := range starts {
+		if starts[i].Equal(start) {
+			starts = append(starts[:i:i], starts[i+1:]...)
+			break
+		}
+	}
+	if len(starts) == 0 {
+		delete(s.clientStarts, client)
+	} else {
+		s.clientStarts[client] = starts
+	}
+}
+
+// startIdleTimer closes session when its client has not read it for Limits.IdleTimeout. It is
+// called when the session starts.
+func (s *Server) startIdleTimer(session *sessionState) {
+	if s.limits.IdleTimeout <= 0 {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.lastRead = time.Now()
+	session.idleTimer = time.AfterFunc(s.limits.IdleTimeout, func() { s.closeIfIdle(session) })
+}
+
+// closeIfIdle closes session if it is idle, or checks again when it could next be idle.
+func (s *Server) closeIfIdle(session *sessionState) {
+	session.mu.Lock()
+	if session.state == sessionClosed {
+		session.mu.Unlock()
+		return
+	}
+	// a read that is waiting for output keeps the session, however long it waits
+	wait := s.limits.IdleTimeout
+	if session.reads == 0 {
+		wait -= time.Since(session.lastRead)
+	}
+	if wait > 0 {
+		session.idleTimer.Reset(wait)
+		session.mu.Unlock()
+		return
+	}
+	session.mu.Unlock()
+
+	log.Printf("session %s: closing: not read for %s", session.id, s.limits.IdleTimeout)
+	err := s.closeSession(session)
+	if err != nil {
+		log.Printf("session %s: error closing idle session: %s", session.id, err.Error())
+	}
+}
+
+// beginRead records that a client is reading session, until endRead is called.
+func (session *sessionState) beginRead() {
+	session.mu.Lock()
+	session.reads++
+	session.mu.Unlock()
+}
+
+func (session *sessionState) endRead() {
+	session.mu.Lock()
+	session.reads--
+	session.lastRead = time.Now()
+	session.mu.Unlock()
+}
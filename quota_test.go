@@ -0,0 +1,186 @@
+package hterm
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// postFrom sends a JSON request to the server from principal at remoteAddr.
+func postFrom(handler http.Handler, principal string, remoteAddr string, path string,
+	body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	r.RemoteAddr = remoteAddr
+	r = r.WithContext(WithPrincipal(r.Context(), principal))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestServerLimits(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter, WithLimits(Limits{MaxSessions: 3, MaxSessionsPerPrincipal: 2}))
+	mux := newTestMux(server)
+
+	for _, id := range []string{"a1", "a2"} {
+		w := postFrom(mux, "alice", "192.0.2.1:1234", "/open", `{"session_id": "`+id+`"}`)
+		if w.Code != http.StatusOK {
+			t.Fatal(w.Code, w.Body.String())
+		}
+	}
+	w := postFrom(mux, "alice", "192.0.2.1:1234", "/open", `{"session_id": "a3"}`)
+	if w.Code != http.StatusTooManyRequests || !strings.Contains(w.Body.String(), "maximum of 2 sessions") {
+		t.Error("expected the principal limit:", w.Code, w.Body.String())
+	}
+	// existing sessions are not limited
+	w = postFrom(mux, "alice", "192.0.2.1:1234", "/write", `{"session_id": "a1", "data": "x"}`)
+	if w.Code != http.StatusOK {
+		t.Error(w.Code, w.Body.String())
+	}
+
+	w = postFrom(mux, "bob", "192.0.2.2:1234", "/open", `{"session_id": "b1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	w = postFrom(mux, "carol", "192.0.2.3:1234", "/open", `{"session_id": "c1"}`)
+	if w.Code != http.StatusTooManyRequests || !strings.Contains(w.Body.String(), "maximum of 3 sessions") {
+		t.Error("expected the server limit:", w.Code, w.Body.String())
+	}
+	if len(starter.streams) != 3 {
+		t.Error("sessions over the limit must not be started:", len(starter.streams))
+	}
+
+	// closing a session makes room
+	w = postFrom(mux, "alice", "192.0.2.1:1234", "/close", `{"session_id": "a1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	w = postFrom(mux, "carol", "192.0.2.3:1234", "/open", `{"session_id": "c1"}`)
+	if w.Code != http.StatusOK {
+		t.Error(w.Code, w.Body.String())
+	}
+}
+
+// failingStarter fails to start sessions.
+type failingStarter struct{}
+
+func (failingStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	return nil, errors.New("no ptys")
+}
+
+func TestServerLimitsStartFailure(t *testing.T) {
+	server := NewContextServer(failingStarter{},
+		WithLimits(Limits{MaxSessions: 1, MaxNewSessionsPerMinute: 1}))
+	mux := newTestMux(server)
+	for i := 0; i < 2; i++ {
+		w := post(t, mux, "/open", `{"session_id": "s1"}`)
+		if w.Code != http.StatusInternalServerError {
+			t.Error("sessions that fail to start must not count:", w.Code, w.Body.String())
+		}
+	}
+	server.mu.Lock()
+	if len(server.clientStarts) != 0 {
+		t.Error("failed starts must be forgotten:", server.clientStarts)
+	}
+	server.mu.Unlock()
+}
+
+func TestServerNewSessionRate(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter, WithLimits(Limits{MaxNewSessionsPerMinute: 2}))
+	mux := newTestMux(server)
+
+	for _, id := range []string{"s1", "s2"} {
+		w := postFrom(mux, "alice", "192.0.2.1:1234", "/open", `{"session_id": "`+id+`"}`)
+		if w.Code != http.StatusOK {
+			t.Fatal(w.Code, w.Body.String())
+		}
+	}
+	// the limit is for each client, even after closing sessions
+	postFrom(mux, "alice", "192.0.2.1:1234", "/close", `{"session_id": "s1"}`)
+	w := postFrom(mux, "alice", "192.0.2.1:5678", "/open", `{"session_id": "s3"}`)
+	if w.Code != http.StatusTooManyRequests || !strings.Contains(w.Body.String(), "in the last minute") {
+		t.Error("expected the rate limit:", w.Code, w.Body.String())
+	}
+	w = postFrom(mux, "alice", "192.0.2.2:1234", "/open", `{"session_id": "s4"}`)
+	if w.Code != http.StatusOK {
+		t.Error("other clients must not be limited:", w.Code, w.Body.String())
+	}
+
+	// starts older than a minute do not count
+	server.mu.Lock()
+	starts := server.clientStarts["192.0.2.1"]
+	for i := range starts {
+		starts[i] = starts[i].Add(-newSessionsPeriod)
+	}
+	server.mu.Unlock()
+	w = postFrom(mux, "alice", "192.0.2.1:1234", "/open", `{"session_id": "s3"}`)
+	if w.Code != http.StatusOK {
+		t.Error(w.Code, w.Body.String())
+	}
+	server.mu.Lock()
+	if len(server.clientStarts["192.0.2.1"]) != 1 {
+		t.Error("old starts must be forgotten:", server.clientStarts)
+	}
+	server.mu.Unlock()
+}
+
+func TestServerIdleTimeout(t *testing.T) {
+	starter := &fakeStarter{}
+	const idleTimeout = 50 * time.Millisecond
+	server := NewContextServer(starter, WithLimits(Limits{MaxSessions: 1, IdleTimeout: idleTimeout}))
+	mux := newTestMux(server)
+	sessionCount := func() int {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return server.sessionCount
+	}
+	// waitForClose waits for the session's stream to be closed, and returns true if it was
+	waitForClose := func(stream *fakeStream) bool {
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+			stream.mu.Lock()
+			closed := stream.closed
+			stream.mu.Unlock()
+			if closed {
+				return true
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return false
+	}
+
+	w := post(t, mux, "/open", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	// a read waiting for output keeps the session for longer than the timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 3*idleTimeout)
+	defer cancel()
+	postContext(ctx, t, mux, "/read", `{"session_id": "s1"}`)
+	if sessionCount() != 1 {
+		t.Fatal("sessions being read must not be closed")
+	}
+
+	// the session is closed after the client stops reading, which makes room
+	if !waitForClose(starter.streams[0]) || sessionCount() != 0 {
+		t.Fatal("idle sessions must be closed")
+	}
+	w = post(t, mux, "/read", `{"session_id": "s1"}`)
+	if w.Code != http.StatusGone {
+		t.Error("closed sessions must be finished:", w.Code, w.Body.String())
+	}
+	w = post(t, mux, "/open", `{"session_id": "s2"}`)
+	if w.Code != http.StatusOK {
+		t.Error(w.Code, w.Body.String())
+	}
+
+	// sessions that were never read are closed too
+	if !waitForClose(starter.streams[1]) || sessionCount() != 0 {
+		t.Error("sessions that were never read must be closed")
+	}
+}
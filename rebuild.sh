@@ -16,8 +16,4 @@ npm install
 echo "running make"
 make
 
-echo "running go get esc / go generate"
-go get github.com/mjibson/esc
-go generate -x ./...
-
 echo "NOTE: 'rm -rf build node_modules' to be really clean"
@@ -0,0 +1,241 @@
+package hterm
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"sort"
+)
+
+// OutputFilter rewrites a session's output before it reaches the screen model, viewers and the
+// client. Each session has its own filters, created by the functions passed to
+// WithOutputFilter, so they may keep state between calls.
+type OutputFilter interface {
+	// FilterOutput is called with each chunk of output, and returns the output to pass on. It
+	// may hold back output that could be part of a match, and return it with later output.
+	FilterOutput(data []byte) []byte
+	// FlushIdle returns the output that is held back and can be passed on before the rest of
+	// the output arrives. It is called when the session has not produced output for a short
+	// time, so output like a prompt is not held back while the program waits for input.
+	FlushIdle() []byte
+	// Flush returns all the output that is held back. It is called when the output ends.
+	Flush() []byte
+}
+
+// WithOutputFilter adds a filter to each session: newFilter is called when a session starts,
+// with the request that started it. It may return nil to not filter the session. Filters are
+// applied in the order they were added.
+func WithOutputFilter(newFilter func(req *StartRequest) OutputFilter) ServerOption {
+	return func(s *Server) {
+		s.outputFilters = append(s.outputFilters, newFilter)
+	}
+}
+
+// Redacted replaces the secrets matched by the filters returned by NewRedactor.
+const Redacted = "[REDACTED]"
+
+// DefaultRedactionPatterns match common secrets: AWS access key ids and secret keys, bearer
+// tokens, and GitHub tokens. Key ids and tokens do not need a word boundary before them, since
+// they often follow an escape sequence like "\x1b[1m".
+var DefaultRedactionPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+	regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`),
+	regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`),
+	regexp.MustCompile(`gh[pousr]_[A-Za-z0-9]{36}\b`),
+}
+
+// the most output a redactor holds back, which is the longest secret it can match when it is
+// split between reads
+const maxRedactorHold = 1024
+
+// the shortest text that could be the start of a secret that a redactor holds back when the
+// output is idle: shorter prefixes like "a" or "gh" are common in typed text, and holding them
+// would hide the last character typed at a shell prompt
+const minRedactorIdleHold = 4
+
+// how much output that was already passed on a redactor keeps, so patterns can match text
+// before a secret, like "Bearer "
+const redactorContext = 256
+
+type redactor struct {
+	patterns []*regexp.Regexp
+	// match the start of a match of each pattern at the end of the text
+	prefixes []*regexp.Regexp
+	// output that was passed on, to match the start of patterns
+	context []byte
+	// output that was held back
+	held []byte
+}
+
+// NewRedactor returns an OutputFilter that replaces the text matched by patterns with Redacted.
+// If a pattern has a group, only the text matched by the first group is replaced, so patterns
+// can include a prefix like "Bearer ". Secrets must not contain spaces or control characters:
+// the redactor holds back the partial word at the end of each read, so secrets split between
+// reads are matched. When the output is idle, the partial word is only passed on if it can not
+// be the start of a secret, or if the start of a secret is shorter than 4 bytes, so at most 3
+// bytes of a match are shown if the program pauses.
+func NewRedactor(patterns ...*regexp.Regexp) OutputFilter {
+	r := &redactor{patterns: patterns}
+	for _, pattern := range patterns {
+		r.prefixes = append(r.prefixes, prefixRegexp(pattern))
+	}
+	return r
+}
+
+// prefixRegexp returns a regexp that matches the text at the end of its input that could be the
+// start of a match of pattern, which is empty if there is none.
+func prefixRegexp(pattern *regexp.Regexp) *regexp.Regexp {
+	re, err := syntax.Parse(pattern.String(), syntax.Perl)
+	if err == nil {
+		prefix, err := regexp.Compile(`(?:` + prefixes(re).String() + `)\z`)
+		if err == nil {
+			return prefix
+		}
+	}
+	// any text could be the start of a match
+	return regexp.MustCompile(`(?s).*\z`)
+}
+
+// prefixes returns a regexp that matches the prefixes of the strings matched by re, including
+// the empty string and the strings themselves.
+func prefixes(re *syntax.Regexp) *syntax.Regexp {
+	node := func(op syntax.Op, sub ...*syntax.Regexp) *syntax.Regexp {
+		return &syntax.Regexp{Op: op, Flags: re.Flags, Sub: sub}
+	}
+	switch re.Op {
+	case syntax.OpLiteral:
+		alternate := node(syntax.OpAlternate)
+		for i := 0; i <= len(re.Rune); i++ {
+			literal := node(syntax.OpLiteral)
+			literal.Rune = re.Rune[:i]
+			if i == 0 {
+				literal.Op = syntax.OpEmptyMatch
+			}
+			alternate.Sub = append(alternate.Sub, literal)
+		}
+		return alternate
+	case syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return node(syntax.OpQuest, re)
+	case syntax.OpCapture, syntax.OpQuest:
+		return prefixes(re.Sub[0])
+	case syntax.OpStar, syntax.OpPlus:
+		return node(syntax.OpConcat, node(syntax.OpStar, re.Sub[0]), prefixes(re.Sub[0]))
+	case syntax.OpRepeat:
+		if re.Max == 0 {
+			return node(syntax.OpEmptyMatch)
+		}
+		repeat := node(syntax.OpStar, re.Sub[0])
+		if re.Max > 0 {
+			repeat = node(syntax.OpRepeat, re.Sub[0])
+			repeat.Max = re.Max - 1
+		}
+		return node(syntax.OpConcat, repeat, prefixes(re.Sub[0]))
+	case syntax.OpConcat:
+		// all of the first parts, then a prefix of the next one
+		alternate := node(syntax.OpAlternate)
+		for i := range re.Sub {
+			concat := node(syntax.OpConcat, re.Sub[:i:i]...)
+			concat.Sub = append(concat.Sub, prefixes(re.Sub[i]))
+			alternate.Sub = append(alternate.Sub, concat)
+		}
+		return alternate
+	case syntax.OpAlternate:
+		alternate := node(syntax.OpAlternate)
+		for _, sub := range re.Sub {
+			alternate.Sub = append(alternate.Sub, prefixes(sub))
+		}
+		return alternate
+	case syntax.OpNoMatch:
+		return re
+	default:
+		// assertions like \b depend on the text after them, which has not arrived
+		return node(syntax.OpEmptyMatch)
+	}
+}
+
+// isWordByte returns true for bytes that can be part of a secret.
+func isWordByte(b byte) bool {
+	return b > ' ' && b != 0x7f
+}
+
+func (r *redactor) FilterOutput(data []byte) []byte {
+	buffer := append(r.held, data...)
+	// hold back the partial word at the end, which may be the start of a secret
+	hold := len(buffer)
+	for hold > 0 && len(buffer)-hold < maxRedactorHold && isWordByte(buffer[hold-1]) {
+		hold--
+	}
+	return r.redact(buffer, hold)
+}
+
+func (r *redactor) FlushIdle() []byte {
+	// pass on the held back output up to the first text that could start a secret
+	text := append(append([]byte(nil), r.context...), r.held...)
+	end := len(text)
+	for _, prefix := range r.prefixes {
+		match := prefix.FindIndex(text)
+		if match != nil && match[0] < end && len(text)-match[0] >= minRedactorIdleHold {
+			end = match[0]
+		}
+	}
+	end -= len(r.context)
+	if end < 0 {
+		end = 0
+	}
+	return r.redact(r.held, end)
+}
+
+func (r *redactor) Flush() []byte {
+	return r.redact(r.held, len(r.held))
+}
+
+// redact returns buffer[:end] with the secrets replaced, and holds back the rest. Matches that
+// include text after end are left for later.
+func (r *redactor) redact(buffer []byte, end int) []byte {
+	text := append(append([]byte(nil), r.context...), buffer...)
+	start := len(r.context)
+	end += start
+
+	// find the ranges of text to replace; they only include text that was not passed on
+	var ranges [][2]int
+	for _, pattern := range r.patterns {
+		for _, match := range pattern.FindAllSubmatchIndex(text, -1) {
+			if match[1] > end {
+				continue
+			}
+			secret := [2]int{match[0], match[1]}
+			if len(match) >= 4 && match[2] >= 0 {
+				secret = [2]int{match[2], match[3]}
+			}
+			if secret[1] <= start || secret[0] == secret[1] {
+				continue
+			}
+			if secret[0] < start {
+				secret[0] = start
+			}
+			ranges = append(ranges, secret)
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+
+	var out []byte
+	next := start
+	for _, secret := range ranges {
+		if secret[1] <= next {
+			// contained in the previous range
+			continue
+		}
+		if secret[0] >= next {
+			out = append(out, text[next:secret[0]]...)
+			out = append(out, Redacted...)
+		}
+		next = secret[1]
+	}
+	out = append(out, text[next:end]...)
+
+	r.held = append([]byte(nil), text[end:]...)
+	r.context = text[:end]
+	if len(r.context) > redactorContext {
+		r.context = append([]byte(nil), r.context[len(r.context)-redactorContext:]...)
+	}
+	return out
+}
@@ -0,0 +1,210 @@
+//go:build 386 || amd64 || arm || arm64 || loong64 || riscv64 || s390x
+
+package hterm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// Linux ioctl and termios values missing from the syscall package. These are the asm-generic
+// values: mips, ppc64 and sparc use different ones, so the build tags exclude them.
+const (
+	ioctlTCSBRK    = 0x5409
+	termiosCBAUD   = 0x100f
+	termiosCRTSCTS = 0x80000000
+)
+
+var serialBaudRates = map[int]uint32{
+	1200:   syscall.B1200,
+	2400:   syscall.B2400,
+	4800:   syscall.B4800,
+	9600:   syscall.B9600,
+	19200:  syscall.B19200,
+	38400:  syscall.B38400,
+	57600:  syscall.B57600,
+	115200: syscall.B115200,
+	230400: syscall.B230400,
+	460800: syscall.B460800,
+	921600: syscall.B921600,
+}
+
+// Parity settings for SerialPort.
+const (
+	ParityNone = ""
+	ParityEven = "even"
+	ParityOdd  = "odd"
+)
+
+// Flow control settings for SerialPort.
+const (
+	FlowControlNone     = ""
+	FlowControlHardware = "rtscts"
+	FlowControlSoftware = "xonxoff"
+)
+
+// SerialPort is a serial device that a serial starter can open. The port always uses 8 data bits
+// and 1 stop bit.
+type SerialPort struct {
+	// Path to the device e.g. /dev/ttyUSB0.
+	Device string
+	// Baud rate e.g. 9600 or 115200.
+	Baud int
+	// One of the Parity constants.
+	Parity string
+	// One of the FlowControl constants.
+	FlowControl string
+}
+
+type serialStarter struct {
+	ports map[string]SerialPort
+}
+
+// NewSerialStarter returns a ContextSessionStarter that opens serial ports. The request's
+// Extra["port"] selects the port: it must be one of the keys in ports. Only one session can have
+// a port open at a time. The session stream implements Breaker to send a serial break.
+func NewSerialStarter(ports map[string]SerialPort) ContextSessionStarter {
+	return &serialStarter{ports}
+}
+
+func (s *serialStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	// validate the port: the client controls extra
+	name := req.Extra["port"]
+	port, ok := s.ports[name]
+	if !ok {
+		return nil, errors.New("invalid port: " + name)
+	}
+	speed, ok := serialBaudRates[port.Baud]
+	if !ok {
+		return nil, fmt.Errorf("port %s: unsupported baud rate %d", name, port.Baud)
+	}
+
+	// non-blocking so the open does not wait for carrier detect, and reads use the poller
+	f, err := os.OpenFile(port.Device, os.O_RDWR|syscall.O_NOCTTY|syscall.O_NONBLOCK, 0)
+	if errors.Is(err, syscall.EBUSY) {
+		// another session set TIOCEXCL
+		return nil, fmt.Errorf("port %s: already in use", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = configureSerial(f, port, speed)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("port %s: %s", name, err.Error())
+	}
+	return &serialStream{f}, nil
+}
+
+// fdControl calls f with the file descriptor, without putting it into blocking mode like Fd().
+func fdControl(file *os.File, f func(fd uintptr) error) error {
+	conn, err := file.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var err2 error
+	err = conn.Control(func(fd uintptr) {
+		err2 = f(fd)
+	})
+	if err != nil {
+		return err
+	}
+	return err2
+}
+
+func ioctl(fd uintptr, request uintptr, arg uintptr) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, arg)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// configureSerial locks the port for exclusive access, then puts it in raw mode with the port's
+// settings.
+func configureSerial(file *os.File, port SerialPort, speed uint32) error {
+	return fdControl(file, func(fd uintptr) error {
+		// flock prevents other sessions and cooperating processes from opening the port, even as
+		// root. TIOCEXCL causes other opens to fail, except by root.
+		err := syscall.Flock(int(fd), syscall.LOCK_EX|syscall.LOCK_NB)
+		if err == syscall.EWOULDBLOCK {
+			return errors.New("already in use")
+		}
+		if err != nil {
+			return err
+		}
+		err = ioctl(fd, syscall.TIOCEXCL, 0)
+		if err != nil {
+			return err
+		}
+
+		termios := &syscall.Termios{}
+		err = ioctl(fd, syscall.TCGETS, uintptr(unsafe.Pointer(termios)))
+		if err != nil {
+			return err
+		}
+		err = setTermios(termios, port, speed)
+		if err != nil {
+			return err
+		}
+		return ioctl(fd, syscall.TCSETS, uintptr(unsafe.Pointer(termios)))
+	})
+}
+
+// setTermios modifies termios to be in raw mode (like cfmakeraw) with port's settings.
+func setTermios(termios *syscall.Termios, port SerialPort, speed uint32) error {
+	termios.Iflag &^= syscall.IGNBRK | syscall.BRKINT | syscall.PARMRK | syscall.ISTRIP |
+		syscall.INLCR | syscall.IGNCR | syscall.ICRNL | syscall.IXON | syscall.IXOFF
+	termios.Oflag &^= syscall.OPOST
+	termios.Lflag &^= syscall.ECHO | syscall.ECHONL | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	termios.Cflag &^= syscall.CSIZE | syscall.PARENB | syscall.PARODD | syscall.CSTOPB |
+		termiosCRTSCTS | termiosCBAUD
+	termios.Cflag |= syscall.CS8 | syscall.CREAD | syscall.CLOCAL | speed
+	// return a byte as soon as one is available
+	termios.Cc[syscall.VMIN] = 1
+	termios.Cc[syscall.VTIME] = 0
+
+	switch port.Parity {
+	case ParityNone:
+	case ParityEven:
+		termios.Cflag |= syscall.PARENB
+	case ParityOdd:
+		termios.Cflag |= syscall.PARENB | syscall.PARODD
+	default:
+		return errors.New("invalid parity: " + port.Parity)
+	}
+
+	switch port.FlowControl {
+	case FlowControlNone:
+	case FlowControlHardware:
+		termios.Cflag |= termiosCRTSCTS
+	case FlowControlSoftware:
+		termios.Iflag |= syscall.IXON | syscall.IXOFF
+	default:
+		return errors.New("invalid flow control: " + port.FlowControl)
+	}
+	return nil
+}
+
+// serialStream is an open serial port. Closing it releases the lock.
+type serialStream struct {
+	*os.File
+}
+
+// Resize implements Resizer. Serial lines have no way to send the terminal size.
+func (s *serialStream) Resize(size Size) error {
+	return nil
+}
+
+// SendBreak implements Breaker.
+func (s *serialStream) SendBreak() error {
+	return fdControl(s.File, func(fd uintptr) error {
+		// 0 sends a break for 0.25 to 0.5 seconds, like tcsendbreak(fd, 0)
+		return ioctl(fd, ioctlTCSBRK, 0)
+	})
+}
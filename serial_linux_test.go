@@ -0,0 +1,94 @@
+//go:build 386 || amd64 || arm || arm64 || loong64 || riscv64 || s390x
+
+package hterm
+
+import (
+	"context"
+	"io"
+	"strings"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/kr/pty"
+)
+
+func TestSerialStarter(t *testing.T) {
+	// the pty's tty stands in for the serial device
+	master, tty, err := pty.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	defer tty.Close()
+
+	starter := NewSerialStarter(map[string]SerialPort{
+		"console": {Device: tty.Name(), Baud: 115200, Parity: ParityEven, FlowControl: FlowControlHardware},
+		"badbaud": {Device: tty.Name(), Baud: 1234},
+	})
+	_, err = starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"port": tty.Name()}})
+	if err == nil || !strings.Contains(err.Error(), "invalid port") {
+		t.Error("expected invalid port error:", err)
+	}
+	_, err = starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"port": "badbaud"}})
+	if err == nil || !strings.Contains(err.Error(), "unsupported baud rate") {
+		t.Error("expected baud rate error:", err)
+	}
+
+	stream, err := starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"port": "console"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	termios := &syscall.Termios{}
+	err = ioctl(tty.Fd(), syscall.TCGETS, uintptr(unsafe.Pointer(termios)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if termios.Cflag&termiosCBAUD != syscall.B115200 {
+		t.Errorf("unexpected speed: Cflag=%x", termios.Cflag)
+	}
+	// the pty driver always clears parity, so we can't check it
+	expectedFlags := uint32(syscall.CS8 | termiosCRTSCTS)
+	if termios.Cflag&expectedFlags != expectedFlags {
+		t.Errorf("unexpected Cflag=%x", termios.Cflag)
+	}
+	if termios.Lflag&(syscall.ICANON|syscall.ECHO) != 0 {
+		t.Errorf("expected raw mode: Lflag=%x", termios.Lflag)
+	}
+
+	// only one session can open the port
+	_, err = starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"port": "console"}})
+	if err == nil || !strings.Contains(err.Error(), "already in use") {
+		t.Error("expected port in use error:", err)
+	}
+
+	_, err = stream.Write([]byte("AT\r"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := make([]byte, 3)
+	_, err = io.ReadFull(master, buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buffer) != "AT\r" {
+		t.Errorf("unexpected data: %#v", string(buffer))
+	}
+
+	err = stream.(Breaker).SendBreak()
+	if err != nil {
+		t.Error(err)
+	}
+
+	// closing releases the port
+	err = stream.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err = starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"port": "console"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.Close()
+}
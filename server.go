@@ -1,32 +1,171 @@
 package hterm
 
 import (
+	"context"
+	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"os/exec"
+	"path"
 	"sync"
 	"syscall"
+	"time"
 	"unsafe"
 
+	"github.com/evanj/hterm/vt"
 	"github.com/kr/pty"
 )
 
 var jsonEmptyObject = []byte("{}")
 
+// errSessionFinished is returned for requests to a session that was closed or that ended.
+var errSessionFinished = errors.New("session finished")
+
+// errSessionNotFound is returned for requests that do not start a session, to a session that
+// does not exist.
+var errSessionNotFound = errors.New("session not found")
+
+// how long to remember finished sessions
+const finishedRetention = 10 * time.Minute
+
 // SessionStarter creates a new session when Start is called.
 type SessionStarter interface {
-	// Start creates a new session with extraParams. The stream that is returned must produce
+	// Start creates a new session with extraParams. The file that is returned must produce
 	// terminal output and consume it. It will be closed when the session is terminated or when
-	// it times out.
+	// it times out. If it is a pty, the client can change the terminal size.
 	Start(extraParams map[string]string) (*os.File, error)
 }
 
+// StartRequest describes the HTTP request that starts a new session.
+type StartRequest struct {
+	// Principal is the authenticated user set by WithPrincipal, or empty if the request was not
+	// authenticated.
+	Principal  string
+	RemoteAddr string
+	Header     http.Header
+	Cookies    []*http.Cookie
+	// Size is the initial terminal size. It is zero if the client did not send it.
+	Size Size
+	// Extra is set by the client, so it must be validated.
+	Extra map[string]string
+}
+
+// ContextSessionStarter creates a new session when StartContext is called, with access to the
+// HTTP request that started it. Unlike SessionStarter, sessions do not need to be a file, so it
+// can start sessions on network connections and other streams.
+type ContextSessionStarter interface {
+	// StartContext creates a new session for req. The stream that is returned must produce
+	// terminal output and consume it. It will be closed when the session is terminated. If the
+	// stream is a pty *os.File or implements Resizer, the client can change the terminal size.
+	// ctx is cancelled if the client goes away before StartContext returns, so it must not be
+	// used after it returns: the session outlives the request that started it.
+	StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error)
+}
+
+type sessionStarterAdapter struct {
+	starter SessionStarter
+}
+
+// AdaptSessionStarter returns a ContextSessionStarter that calls starter.Start with the request's
+// extra params. If starter already implements ContextSessionStarter, it is returned unchanged.
+func AdaptSessionStarter(starter SessionStarter) ContextSessionStarter {
+	if contextStarter, ok := starter.(ContextSessionStarter); ok {
+		return contextStarter
+	}
+	return &sessionStarterAdapter{starter}
+}
+
+func (a *sessionStarterAdapter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	f, err := a.starter.Start(req.Extra)
+	if err != nil {
+		// do not return a nil *os.File as a non-nil io.ReadWriteCloser
+		return nil, err
+	}
+	return f, nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx that records that the request was made by principal. This
+// should be called by authentication middleware: Server passes it to the starter in StartRequest.
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal set with WithPrincipal, or the empty string.
+func PrincipalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal
+}
+
+// Size is the size of a terminal.
+type Size struct {
+	Columns int
+	Rows    int
+	// CellWidth and CellHeight are the size of a character cell in pixels, or zero if unknown.
+	CellWidth  int
+	CellHeight int
+}
+
+// largest cell size in pixels that we accept: far larger than any real font
+const maxCellPixels = 1024
+
+// validate returns an error if size does not fit in a pty's window size.
+func (size Size) validate() error {
+	if size.Columns <= 0 || size.Rows <= 0 ||
+		size.Columns > math.MaxUint16 || size.Rows > math.MaxUint16 {
+		return fmt.Errorf("invalid columns/rows: %d/%d", size.Columns, size.Rows)
+	}
+	// the cell size is optional, but both dimensions must be set, and the total pixel size must fit
+	if (size.CellWidth == 0) != (size.CellHeight == 0) ||
+		size.CellWidth < 0 || size.CellHeight < 0 ||
+		size.CellWidth > maxCellPixels || size.CellHeight > maxCellPixels ||
+		size.CellWidth*size.Columns > math.MaxUint16 || size.CellHeight*size.Rows > math.MaxUint16 {
+		return fmt.Errorf("invalid cell width/height: %d/%d", size.CellWidth, size.CellHeight)
+	}
+	return nil
+}
+
+// winsize returns size as a pty window size. size must be valid.
+func (size Size) winsize() *winsize {
+	return &winsize{uint16(size.Rows), uint16(size.Columns),
+		uint16(size.CellWidth * size.Columns), uint16(size.CellHeight * size.Rows)}
+}
+
+// Resizer is implemented by session streams that are not a pty, but that can still change the
+// terminal size.
+type Resizer interface {
+	Resize(size Size) error
+}
+
+// Breaker is implemented by session streams that can send a break, such as serial ports.
+type Breaker interface {
+	SendBreak() error
+}
+
+// ResizeStream changes the terminal size of a session stream that implements Resizer or is a
+// pty *os.File. It returns an error for other streams, or if size is invalid.
+func ResizeStream(stream io.ReadWriteCloser, size Size) error {
+	err := size.validate()
+	if err != nil {
+		return err
+	}
+	switch stream := stream.(type) {
+	case Resizer:
+		return stream.Resize(size)
+	case *os.File:
+		return setSize(stream, size)
+	}
+	return errors.New("session does not support changing the terminal size")
+}
+
 type subprocessStarter struct {
 	command []string
 }
@@ -38,22 +177,165 @@ func NewSubprocessStarter(command []string) SessionStarter {
 
 func (s *subprocessStarter) Start(extraParams map[string]string) (*os.File, error) {
 	cmd := exec.Command(s.command[0], s.command[1:]...)
-	return pty.Start(cmd)
+	return StartPty(cmd, Size{})
+}
+
+// StartContext implements ContextSessionStarter, to start the subprocess at the initial size.
+func (s *subprocessStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	cmd := exec.Command(s.command[0], s.command[1:]...)
+	f, err := StartPty(cmd, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// StartPty starts cmd connected to a new pty with the terminal size set to size, or the default
+// size if size is zero. It returns the pty.
+func StartPty(cmd *exec.Cmd, size Size) (*os.File, error) {
+	if size == (Size{}) {
+		return pty.Start(cmd)
+	}
+	err := size.validate()
+	if err != nil {
+		return nil, err
+	}
+	ws := size.winsize()
+	return pty.StartWithSize(cmd, &pty.Winsize{Rows: ws.Rows, Cols: ws.Cols, X: ws.X, Y: ws.Y})
 }
 
 type sessionState struct {
-	id  string
-	pty *os.File
+	id     string
+	stream io.ReadWriteCloser
+	// the principal that started the session, which it counts against in Server.limits
+	principal string
+	// the client address and time of the start counted in Server.clientStarts
+	client    string
+	startTime time.Time
+	// the contents of the terminal, updated by pumpOutput
+	screen *vt.Screen
+	// applied to input before it is written to stream
+	filters []InputFilter
+	// applied to output before it reaches the screen, viewers and output; only used by
+	// pumpOutput
+	outputFilters []OutputFilter
+
+	mu sync.Mutex
+	// the macro being recorded from the session's input, or nil
+	recording *Macro
+
+	// the group the session is in, or the zero groupKey; protected by Server.mu
+	group groupKey
+
+	// protected by mu; see sessionLifecycle
+	state sessionLifecycle
+	// closed when the session leaves sessionStarting; startErr is set before if the program
+	// failed to start
+	started  chan struct{}
+	startErr error
+	// closed when the session is closed
+	done chan struct{}
+	// maps share ids to the session's read-only links; protected by mu
+	shares map[string]*share
+
+	// output read by pumpOutput that was not yet returned by readHandler, and the error that
+	// ended the output; protected by mu
+	output    []byte
+	outputErr error
+	// signalled when output or outputErr is set, and when output is consumed
+	outputReady chan struct{}
+	outputSpace chan struct{}
+
+	// the reads waiting for output, and when the last one returned; protected by mu, and only
+	// used when Limits.IdleTimeout is set
+	reads     int
+	lastRead  time.Time
+	idleTimer *time.Timer
+}
+
+func newSessionState(id string) *sessionState {
+	return &sessionState{
+		id:          id,
+		started:     make(chan struct{}),
+		done:        make(chan struct{}),
+		shares:      map[string]*share{},
+		outputReady: make(chan struct{}, 1),
+		outputSpace: make(chan struct{}, 1),
+	}
 }
 
 type Server struct {
 	mu       sync.Mutex
 	sessions map[string]*sessionState
-	starter  SessionStarter
+	// ids of recently finished sessions and when they finished, so late requests are not
+	// mistaken for new sessions
+	finished map[string]time.Time
+	starter  ContextSessionStarter
+	// maps the last element of the request path to the endpoint's handler
+	endpoints map[string]http.Handler
+	// nil if macros are disabled
+	macros MacroStore
+	// the sessions in each group
+	groups map[groupKey]map[*sessionState]struct{}
+	// signs share tokens
+	shareKey []byte
+	// maps share ids to the read-only links to all sessions
+	shares map[string]*share
+	// create each session's input filters
+	inputFilters []func(req *StartRequest) InputFilter
+	// create each session's output filters
+	outputFilters []func(req *StartRequest) OutputFilter
+
+	limits Limits
+	// the sessions that are starting or running, in total and for each principal
+	sessionCount      int
+	principalSessions map[string]int
+	// when each client started sessions in the last minute, oldest first
+	clientStarts map[string][]time.Time
+}
+
+// ServerOption configures a Server.
+type ServerOption func(*Server)
+
+// WithMacroStore lets write requests play and record macros, which are stored in store.
+func WithMacroStore(store MacroStore) ServerOption {
+	return func(s *Server) {
+		s.macros = store
+	}
+}
+
+func NewServer(starter SessionStarter, options ...ServerOption) *Server {
+	return NewContextServer(AdaptSessionStarter(starter), options...)
 }
 
-func NewServer(starter SessionStarter) *Server {
-	return &Server{sync.Mutex{}, map[string]*sessionState{}, starter}
+func NewContextServer(starter ContextSessionStarter, options ...ServerOption) *Server {
+	s := &Server{sessions: map[string]*sessionState{}, finished: map[string]time.Time{}, starter: starter,
+		groups: map[groupKey]map[*sessionState]struct{}{}, shareKey: make([]byte, 32),
+		shares: map[string]*share{}, principalSessions: map[string]int{}, clientStarts: map[string][]time.Time{}}
+	// share links are only valid for this server, since shares are kept in memory
+	_, err := rand.Read(s.shareKey)
+	if err != nil {
+		panic(err)
+	}
+	for _, option := range options {
+		option(s)
+	}
+	s.endpoints = map[string]http.Handler{
+		"open":        s.sessionWrapper(s.openHandler, true),
+		"write":       s.sessionWrapper(s.writeHandler, true),
+		"read":        s.sessionWrapper(s.readHandler, true),
+		"setSize":     s.sessionWrapper(s.setSizeHandler, true),
+		"sendBreak":   s.sessionWrapper(s.sendBreakHandler, true),
+		"close":       s.sessionWrapper(s.closeHandler, false),
+		"snapshot":    s.sessionWrapper(s.snapshotHandler, false),
+		"joinGroup":   s.sessionWrapper(s.joinGroupHandler, true),
+		"leaveGroup":  s.sessionWrapper(s.leaveGroupHandler, false),
+		"createShare": s.sessionWrapper(s.createShareHandler, false),
+		"listShares":  s.sessionWrapper(s.listSharesHandler, false),
+		"revokeShare": s.sessionWrapper(s.revokeShareHandler, false),
+		"watch":       s.sessionWrapper(s.watchHandler, false),
+	}
+	return s
 }
 
 // Union for write, read, and setSize requests
@@ -62,22 +344,68 @@ type requestUnion struct {
 	SessionId string            `json:"session_id"`
 	Extra     map[string]string `json:"extra"`
 
+	// setSize; other requests send the current size so new sessions start at the right size
+	Columns    int `json:"columns"`
+	Rows       int `json:"rows"`
+	CellWidth  int `json:"cell_width"`
+	CellHeight int `json:"cell_height"`
+
 	// write
 	Data string `json:"data"`
+	// write: plays the macro with this name before writing data, with macro_delay_ms between
+	// steps if it is not zero
+	Macro        string `json:"macro"`
+	MacroDelayMS int    `json:"macro_delay_ms"`
+	// write: stop_recording saves the macro being recorded, then record_macro starts recording
+	// the data of the following writes into a macro with this name
+	RecordMacro   string `json:"record_macro"`
+	StopRecording bool   `json:"stop_recording"`
+	// write: send data to every session in this session's group
+	Broadcast bool `json:"broadcast"`
+
+	// joinGroup
+	Group string `json:"group"`
+
+	// createShare: how long the link lasts
+	TTLSeconds int `json:"ttl_seconds"`
+	// revokeShare
+	ShareID string `json:"share_id"`
+	// watch: the token from createShare
+	Share string `json:"share"`
+}
 
-	// setSize
-	Columns int `json:"columns"`
-	Rows    int `json:"rows"`
+// size returns the terminal size sent with the request, or the zero Size if it was not sent.
+func (r *requestUnion) size() (Size, error) {
+	size := Size{r.Columns, r.Rows, r.CellWidth, r.CellHeight}
+	if size == (Size{}) {
+		return size, nil
+	}
+	return size, size.validate()
 }
 
 type readResponse struct {
 	Data string `json:"data"`
 }
 
+type snapshotResponse struct {
+	Columns         int    `json:"columns"`
+	Rows            int    `json:"rows"`
+	CursorX         int    `json:"cursor_x"`
+	CursorY         int    `json:"cursor_y"`
+	CursorVisible   bool   `json:"cursor_visible"`
+	Title           string `json:"title"`
+	AlternateScreen bool   `json:"alternate_screen"`
+	Text            string `json:"text"`
+	ANSI            string `json:"ansi"`
+	HTML            string `json:"html"`
+}
+
 type customHandler func(w http.ResponseWriter, r *http.Request,
 	session *sessionState, req *requestUnion) error
 
-func (s *Server) sessionWrapper(h customHandler) http.HandlerFunc {
+// sessionWrapper decodes the request and calls h with its session. If the session does not
+// exist, it is started if start is true, otherwise h is called with a nil session.
+func (s *Server) sessionWrapper(h customHandler, start bool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		err := func() error {
 			if r.Method != http.MethodPost {
@@ -104,61 +432,182 @@ func (s *Server) sessionWrapper(h customHandler) http.HandlerFunc {
 				return errors.New("required field session_id is missing")
 			}
 
-			s.mu.Lock()
-			session := s.sessions[req.SessionId]
-			s.mu.Unlock()
-
-			if session == nil {
-				log.Printf("creating new session id %s", req.SessionId)
-				session = &sessionState{id: req.SessionId}
-
-				session.pty, err = s.starter.Start(req.Extra)
-				if err != nil {
-					return err
-				}
-
-				s.mu.Lock()
-				s.sessions[req.SessionId] = session
-				s.mu.Unlock()
+			session, err := s.lookupSession(r, req, start)
+			if err != nil {
+				return err
 			}
 
 			// pass on the request to the real handler
-			log.Printf("%s session %s", r.URL.Path, session.id)
+			log.Printf("%s session %s", r.URL.Path, req.SessionId)
 			return h(w, r, session, req)
 		}()
 		if err != nil {
 			log.Printf("Error: %s: %s", r.URL.Path, err.Error())
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			status := http.StatusInternalServerError
+			switch err {
+			case errSessionFinished:
+				status = http.StatusGone
+			case errSessionNotFound:
+				status = http.StatusNotFound
+			case errShareInvalid:
+				status = http.StatusForbidden
+			case errTooManyViewers:
+				status = http.StatusTooManyRequests
+			}
+			var rejected *InputRejectedError
+			if errors.As(err, &rejected) {
+				status = http.StatusForbidden
+			}
+			var limited *LimitError
+			if errors.As(err, &limited) {
+				status = http.StatusTooManyRequests
+			}
+			http.Error(w, err.Error(), status)
 		}
 	}
 }
 
+// openHandler starts the session if it does not exist. Other requests also start the session,
+// so clients only need to call this to detect errors before reading or writing.
+func (s *Server) openHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	w.Write(jsonEmptyObject)
+	return nil
+}
+
+// closeHandler terminates the session. Closing a finished session returns errSessionFinished,
+// which clients can treat as success.
+func (s *Server) closeHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	if session != nil {
+		log.Printf("closing session %s", session.id)
+		err := s.closeSession(session)
+		if err != nil {
+			return err
+		}
+	}
+	w.Write(jsonEmptyObject)
+	return nil
+}
+
 func (s *Server) writeHandler(w http.ResponseWriter, r *http.Request,
 	session *sessionState, request *requestUnion) error {
 
-	if request.Data == "" {
+	macroRequest := request.Macro != "" || request.RecordMacro != "" || request.StopRecording
+	if request.Data == "" && !macroRequest {
 		return errors.New("write request missing required data")
 	}
+	if macroRequest {
+		err := s.handleMacros(r, session, request)
+		if err != nil {
+			return err
+		}
+	}
 
-	log.Printf("writeHandler data: %s\n", request.Data)
-	n, err := session.pty.Write([]byte(request.Data))
-	if err != nil {
-		return err
+	if request.Data != "" {
+		log.Printf("writeHandler data: %s\n", request.Data)
+		session.mu.Lock()
+		if session.recording != nil {
+			session.recording.Steps = append(session.recording.Steps, request.Data)
+		}
+		session.mu.Unlock()
+		if request.Broadcast {
+			members, err := s.groupMembers(session)
+			if err != nil {
+				return err
+			}
+			log.Printf("writeHandler: broadcasting to %d sessions", len(members))
+			err = broadcast(r.Context(), members, []byte(request.Data))
+			if err != nil {
+				return err
+			}
+		} else {
+			err := session.writeInput(r.Context(), []byte(request.Data))
+			if err != nil {
+				return err
+			}
+			log.Printf("writeHandler: wrote %d bytes", len(request.Data))
+		}
 	}
-	log.Printf("writeHandler: wrote %d bytes", n)
 	w.Write(jsonEmptyObject)
 	return nil
 }
 
+// handleMacros stops and starts recording, then plays a macro, for a write request. Macros
+// belong to the request's principal.
+func (s *Server) handleMacros(r *http.Request, session *sessionState, request *requestUnion) error {
+	if s.macros == nil {
+		return errMacrosDisabled
+	}
+	principal := PrincipalFromContext(r.Context())
+
+	if request.StopRecording {
+		session.mu.Lock()
+		macro := session.recording
+		session.recording = nil
+		session.mu.Unlock()
+		if macro == nil {
+			return errors.New("no macro is being recorded")
+		}
+		log.Printf("session %s: saving macro %s with %d steps", session.id, macro.Name, len(macro.Steps))
+		err := s.macros.SaveMacro(principal, macro)
+		if err != nil {
+			return err
+		}
+	}
+	if request.RecordMacro != "" {
+		log.Printf("session %s: recording macro %s", session.id, request.RecordMacro)
+		session.mu.Lock()
+		session.recording = &Macro{Name: request.RecordMacro}
+		session.mu.Unlock()
+	}
+
+	if request.Macro != "" {
+		macro, err := s.macros.Macro(principal, request.Macro)
+		if err != nil {
+			return err
+		}
+		if macro == nil {
+			return fmt.Errorf("unknown macro %s", request.Macro)
+		}
+		if request.MacroDelayMS < 0 {
+			return fmt.Errorf("invalid macro_delay_ms: %d", request.MacroDelayMS)
+		}
+		log.Printf("session %s: playing macro %s", session.id, macro.Name)
+		delay := time.Duration(request.MacroDelayMS) * time.Millisecond
+		return playMacro(r.Context(), &inputWriter{r.Context(), session}, macro, delay)
+	}
+	return nil
+}
+
 func (s *Server) setSizeHandler(w http.ResponseWriter, r *http.Request,
 	session *sessionState, request *requestUnion) error {
 
-	if request.Columns <= 0 || request.Rows <= 0 {
-		return fmt.Errorf("invalid columns/rows: %d/%d", request.Columns, request.Rows)
+	size := Size{request.Columns, request.Rows, request.CellWidth, request.CellHeight}
+	err := size.validate()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("setSize %d %d cell %dx%d", size.Columns, size.Rows, size.CellWidth, size.CellHeight)
+	err = ResizeStream(session.stream, size)
+	if err != nil {
+		return err
 	}
+	session.screen.Resize(size.Columns, size.Rows)
+	w.Write(jsonEmptyObject)
+	return nil
+}
 
-	log.Printf("setSize %d %d", request.Columns, request.Rows)
-	err := setSize(session.pty, request.Columns, request.Rows)
+func (s *Server) sendBreakHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+
+	breaker, ok := session.stream.(Breaker)
+	if !ok {
+		return errors.New("session does not support sending a break")
+	}
+	log.Printf("sendBreak session %s", session.id)
+	err := breaker.SendBreak()
 	if err != nil {
 		return err
 	}
@@ -168,42 +617,145 @@ func (s *Server) setSizeHandler(w http.ResponseWriter, r *http.Request,
 
 func (s *Server) readHandler(w http.ResponseWriter, r *http.Request,
 	session *sessionState, request *requestUnion) error {
-	// TODO: reuse buffer?
-	buffer := make([]byte, 4096)
-	n, err := session.pty.Read(buffer)
-	if n > 0 {
-		// handle any bytes read before any errors
-		// TODO: this assumes calling Read again will return the error again
-		log.Printf("readHandler: read %d bytes", n)
+	session.beginRead()
+	defer session.endRead()
+	var data []byte
+	var err error
+	for {
+		session.mu.Lock()
+		data = session.output
+		session.output = nil
+		err = session.outputErr
+		session.mu.Unlock()
+		if len(data) > 0 || err != nil {
+			break
+		}
+		select {
+		case <-session.outputReady:
+		case <-session.done:
+			// closed by another request: the output pump stopped without setting an error
+			return errSessionFinished
+		case <-r.Context().Done():
+			// the output stays buffered for the next read
+			return r.Context().Err()
+		}
+	}
+
+	if len(data) > 0 {
+		// return the output before the error, which stays set for the next read
+		signal(session.outputSpace)
+		log.Printf("readHandler: read %d bytes", len(data))
 
 		// assume we can just convert this to UTF-8; TODO: how to handle escapes?
-		resp := &readResponse{string(buffer[:n])}
+		resp := &readResponse{string(data)}
 		encoder := json.NewEncoder(w)
 		return encoder.Encode(resp)
 	}
-	if err == io.EOF {
-		log.Printf("readHandler: pty.Read returned EOF; session %s finished", session.id)
+	err2 := s.closeSession(session)
+	if err2 != nil {
+		log.Printf("readHandler: error closing session %s: %s", session.id, err2.Error())
 	}
-	return err
+	// the session is finished: a pty returns an error instead of EOF when the process exits
+	log.Printf("readHandler: session %s finished: %s", session.id, err.Error())
+	http.Error(w, "session finished: "+err.Error(), http.StatusGone)
+	return nil
 }
 
+// snapshotHandler returns what the session's terminal currently shows, as plain text, as text
+// with ANSI escape sequences, and as HTML. It does not start the session.
+func (s *Server) snapshotHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	if session == nil {
+		return errSessionNotFound
+	}
+	snapshot := session.screen.Snapshot()
+	resp := &snapshotResponse{
+		Columns:         snapshot.Columns,
+		Rows:            snapshot.Rows,
+		CursorX:         snapshot.CursorX,
+		CursorY:         snapshot.CursorY,
+		CursorVisible:   snapshot.CursorVisible,
+		Title:           snapshot.Title,
+		AlternateScreen: snapshot.Alternate,
+		Text:            snapshot.Text(),
+		ANSI:            snapshot.ANSI(),
+		HTML:            snapshot.HTML(),
+	}
+	return json.NewEncoder(w).Encode(resp)
+}
+
+// closeSession closes the session's stream and forgets it, so it can release any resources. Only
+// the first call closes the stream.
+func (s *Server) closeSession(session *sessionState) error {
+	now := time.Now()
+	s.mu.Lock()
+	if s.sessions[session.id] == session {
+		delete(s.sessions, session.id)
+		s.releaseSessionLocked(session.principal)
+	}
+	s.removeFromGroupLocked(session)
+	s.finished[session.id] = now
+	for id, finished := range s.finished {
+		if now.Sub(finished) > finishedRetention {
+			delete(s.finished, id)
+		}
+	}
+	s.mu.Unlock()
+
+	session.mu.Lock()
+	closing := session.setStateLocked(sessionClosed)
+	if closing {
+		close(session.done)
+	}
+	session.mu.Unlock()
+	if !closing {
+		return nil
+	}
+	if session.idleTimer != nil {
+		session.idleTimer.Stop()
+	}
+	s.closeShares(session)
+	return session.stream.Close()
+}
+
+// Snapshot returns a copy of what the terminal of session sessionID currently shows, or nil if
+// the session does not exist. The screen is updated as soon as the session produces output,
+// even if the client has not read it yet.
+func (s *Server) Snapshot(sessionID string) *vt.Snapshot {
+	s.mu.Lock()
+	session := s.sessions[sessionID]
+	s.mu.Unlock()
+	if session == nil {
+		return nil
+	}
+	return session.screen.Snapshot()
+}
+
+// ServeHTTP dispatches on the last element of the request path (e.g. /console/write calls
+// write), so the server can be mounted at any path, with any router or middleware.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := s.endpoints[path.Base(r.URL.Path)]
+	if endpoint == nil {
+		http.NotFound(w, r)
+		return
+	}
+	endpoint.ServeHTTP(w, r)
+}
+
+// RegisterHandlers adds the endpoints to mux under path, which must end with /.
 func (s *Server) RegisterHandlers(path string, mux *http.ServeMux) {
 	if len(path) == 0 || path[len(path)-1] != '/' {
 		panic("path must end with /")
 	}
-	mux.HandleFunc(path+"write", s.sessionWrapper(s.writeHandler))
-	mux.HandleFunc(path+"read", s.sessionWrapper(s.readHandler))
-	mux.HandleFunc(path+"setSize", s.sessionWrapper(s.setSizeHandler))
+	for name := range s.endpoints {
+		mux.Handle(path+name, s)
+	}
 }
 
-// Setsize resizes pty t to s.
+// Setsize resizes pty t to size, which must be valid.
 // From https://github.com/kr/pty/pull/39/files
-func setSize(t *os.File, columns int, rows int) error {
-	const pixelsPerColumn = 640 / 80
-	const pixelsPerRow = 480 / 24
-	ws := &winsize{uint16(rows), uint16(columns),
-		uint16(pixelsPerRow * columns), uint16(pixelsPerRow * rows)}
-	return windowRectCall(ws, t.Fd(), syscall.TIOCSWINSZ)
+func setSize(t *os.File, size Size) error {
+	return windowRectCall(size.winsize(), t.Fd(), syscall.TIOCSWINSZ)
 }
 
 // Winsize describes the terminal size.
@@ -0,0 +1,378 @@
+package hterm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/kr/pty"
+)
+
+// fakeStream is a session stream: reads return what the test writes to writer, and writes and
+// resizes are recorded.
+type fakeStream struct {
+	output *io.PipeReader
+	writer *io.PipeWriter
+
+	mu     sync.Mutex
+	input  bytes.Buffer
+	sizes  []Size
+	closed bool
+}
+
+func newFakeStream() *fakeStream {
+	r, w := io.Pipe()
+	return &fakeStream{output: r, writer: w}
+}
+
+func (f *fakeStream) Read(p []byte) (int, error) {
+	return f.output.Read(p)
+}
+
+func (f *fakeStream) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.input.Write(p)
+}
+
+func (f *fakeStream) Resize(size Size) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sizes = append(f.sizes, size)
+	return nil
+}
+
+func (f *fakeStream) Close() error {
+	f.mu.Lock()
+	f.closed = true
+	f.mu.Unlock()
+	return f.output.Close()
+}
+
+func (f *fakeStream) written() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.input.String()
+}
+
+// fakeStarter records start requests and returns fakeStreams.
+type fakeStarter struct {
+	mu       sync.Mutex
+	requests []*StartRequest
+	streams  []*fakeStream
+}
+
+func (f *fakeStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stream := newFakeStream()
+	f.requests = append(f.requests, req)
+	f.streams = append(f.streams, stream)
+	return stream, nil
+}
+
+// post sends a JSON request to the server, returning the response.
+func post(t *testing.T, handler http.Handler, path string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	return postContext(context.Background(), t, handler, path, body)
+}
+
+// postContext is post with a context, so requests that wait can time out.
+func postContext(ctx context.Context, t *testing.T, handler http.Handler, path string,
+	body string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	r.RemoteAddr = "192.0.2.1:1234"
+	r.Header.Set("Cookie", "auth=secret")
+	r = r.WithContext(WithPrincipal(ctx, "alice"))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func newTestMux(server *Server) *http.ServeMux {
+	mux := http.NewServeMux()
+	server.RegisterHandlers("/", mux)
+	return mux
+}
+
+func TestServerStartRequest(t *testing.T) {
+	starter := &fakeStarter{}
+	mux := newTestMux(NewContextServer(starter))
+
+	w := post(t, mux, "/setSize", `{"session_id": "s1", "extra": {"k": "v"}, "columns": 80, "rows": 24}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if len(starter.requests) != 1 {
+		t.Fatal("expected one session to be started:", starter.requests)
+	}
+	req := starter.requests[0]
+	if req.Principal != "alice" || req.RemoteAddr != "192.0.2.1:1234" || req.Extra["k"] != "v" ||
+		req.Size != (Size{Columns: 80, Rows: 24}) {
+		t.Errorf("unexpected start request: %#v", req)
+	}
+	if len(req.Cookies) != 1 || req.Cookies[0].Value != "secret" {
+		t.Errorf("unexpected cookies: %#v", req.Cookies)
+	}
+
+	// the same session is reused
+	w = post(t, mux, "/write", `{"session_id": "s1", "data": "hello"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if len(starter.requests) != 1 || starter.streams[0].written() != "hello" {
+		t.Error("expected write to the existing session", len(starter.requests))
+	}
+}
+
+type legacyStarter struct {
+	extra map[string]string
+}
+
+func (l *legacyStarter) Start(extraParams map[string]string) (*os.File, error) {
+	l.extra = extraParams
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	w.Close()
+	return r, nil
+}
+
+func TestAdaptSessionStarter(t *testing.T) {
+	legacy := &legacyStarter{}
+	stream, err := AdaptSessionStarter(legacy).StartContext(context.Background(),
+		&StartRequest{Extra: map[string]string{"k": "v"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream.Close()
+	if legacy.extra["k"] != "v" {
+		t.Error("unexpected extra params:", legacy.extra)
+	}
+
+	// starters that already support contexts are not wrapped
+	starter := &contextAndLegacyStarter{}
+	if AdaptSessionStarter(starter) != ContextSessionStarter(starter) {
+		t.Error("AdaptSessionStarter must return ContextSessionStarters unchanged")
+	}
+}
+
+type contextAndLegacyStarter struct {
+	fakeStarter
+	legacyStarter
+}
+
+func TestStartPtyInitialSize(t *testing.T) {
+	// stty reads the size of its stdin
+	f, err := StartPty(exec.Command("stty", "size"), Size{Columns: 100, Rows: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	out, err := ioutil.ReadAll(f)
+	// reading a pty returns EIO after the child exits
+	if err != nil && !errors.Is(err, syscall.EIO) {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(string(out)) != "40 100" {
+		t.Errorf("unexpected stty output: %#v", string(out))
+	}
+}
+
+// getSize returns the window size of pty f.
+func getSize(t *testing.T, f *os.File) *winsize {
+	t.Helper()
+	ws := &winsize{}
+	err := windowRectCall(ws, f.Fd(), syscall.TIOCGWINSZ)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ws
+}
+
+func TestSetSizePixels(t *testing.T) {
+	master, tty, err := pty.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	defer tty.Close()
+
+	err = setSize(master, Size{Columns: 80, Rows: 24, CellWidth: 9, CellHeight: 17})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// programs read the size from the tty
+	ws := getSize(t, tty)
+	if *ws != (winsize{Rows: 24, Cols: 80, X: 720, Y: 408}) {
+		t.Errorf("unexpected window size: %#v", ws)
+	}
+
+	// without the cell size, the pixel size is unknown
+	err = setSize(master, Size{Columns: 100, Rows: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ws = getSize(t, tty)
+	if *ws != (winsize{Rows: 40, Cols: 100}) {
+		t.Errorf("unexpected window size: %#v", ws)
+	}
+}
+
+func TestSetSizeHandler(t *testing.T) {
+	starter := &fakeStarter{}
+	mux := newTestMux(NewContextServer(starter))
+
+	w := post(t, mux, "/setSize",
+		`{"session_id": "s1", "columns": 80, "rows": 24, "cell_width": 9, "cell_height": 17}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	expected := Size{Columns: 80, Rows: 24, CellWidth: 9, CellHeight: 17}
+	if starter.requests[0].Size != expected {
+		t.Error("unexpected initial size:", starter.requests[0].Size)
+	}
+	if len(starter.streams[0].sizes) != 1 || starter.streams[0].sizes[0] != expected {
+		t.Error("unexpected sizes:", starter.streams[0].sizes)
+	}
+
+	invalid := []string{
+		`"columns": 0, "rows": 24`,
+		`"columns": 80, "rows": 65536`,
+		`"columns": 80, "rows": 24, "cell_width": 9`,
+		`"columns": 80, "rows": 24, "cell_width": -9, "cell_height": 17`,
+		`"columns": 80, "rows": 24, "cell_width": 2000, "cell_height": 17`,
+		`"columns": 8000, "rows": 24, "cell_width": 9, "cell_height": 17`,
+	}
+	for _, params := range invalid {
+		w = post(t, mux, "/setSize", `{"session_id": "s1", `+params+`}`)
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("%s: expected error; got %d", params, w.Code)
+		}
+	}
+	if len(starter.streams[0].sizes) != 1 {
+		t.Error("invalid sizes must not resize:", starter.streams[0].sizes)
+	}
+}
+
+func TestServerServeHTTP(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter)
+
+	// middleware can wrap the server, and it can be mounted with StripPrefix or directly
+	var paths []string
+	logged := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		server.ServeHTTP(w, r)
+	})
+	mux := http.NewServeMux()
+	mux.Handle("/a/", http.StripPrefix("/a", logged))
+	mux.Handle("/b/c/", server)
+
+	w := post(t, mux, "/a/write", `{"session_id": "s1", "data": "hello"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	w = post(t, mux, "/b/c/write", `{"session_id": "s1", "data": " world"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if len(starter.streams) != 1 || starter.streams[0].written() != "hello world" {
+		t.Error("expected writes to the same session")
+	}
+	if len(paths) != 1 || paths[0] != "/write" {
+		t.Error("unexpected paths seen by middleware:", paths)
+	}
+
+	w = post(t, mux, "/a/unknown", `{"session_id": "s1"}`)
+	if w.Code != http.StatusNotFound {
+		t.Error("expected not found for an unknown endpoint:", w.Code)
+	}
+	// late requests for a closed session must not start a new one
+	w = post(t, mux, "/a/close", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	w = post(t, mux, "/a/read", `{"session_id": "s1"}`)
+	if w.Code != http.StatusGone || len(starter.streams) != 1 {
+		t.Error("expected gone for a closed session:", w.Code, len(starter.streams))
+	}
+	w = post(t, mux, "/a/close", `{"session_id": "s1"}`)
+	if w.Code != http.StatusGone {
+		t.Error("expected gone closing a closed session:", w.Code)
+	}
+}
+
+func TestServerSnapshot(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter)
+	mux := newTestMux(server)
+
+	if server.Snapshot("s1") != nil {
+		t.Error("expected no snapshot for a session that does not exist")
+	}
+	w := post(t, mux, "/open", `{"session_id": "s1", "columns": 10, "rows": 2}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	go starter.streams[0].writer.Write([]byte("\x1b]0;title\x07hello\r\nworld"))
+	w = post(t, mux, "/read", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+
+	snapshot := server.Snapshot("s1")
+	if snapshot.Columns != 10 || snapshot.Rows != 2 || snapshot.Title != "title" ||
+		snapshot.CursorX != 5 || snapshot.CursorY != 1 {
+		t.Errorf("unexpected snapshot: %#v", snapshot)
+	}
+	if lines := snapshot.Lines(); len(lines) != 2 || lines[0] != "hello" || lines[1] != "world" {
+		t.Error("unexpected lines:", lines)
+	}
+
+	// resizing the session resizes the screen
+	w = post(t, mux, "/setSize", `{"session_id": "s1", "columns": 3, "rows": 1}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if lines := server.Snapshot("s1").Lines(); len(lines) != 1 || lines[0] != "wor" {
+		t.Error("unexpected lines after resize:", lines)
+	}
+
+	w = post(t, mux, "/snapshot", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	resp := &snapshotResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &snapshotResponse{Columns: 3, Rows: 1, CursorX: 2, CursorY: 0, CursorVisible: true,
+		Title: "title", Text: "wor", ANSI: "wor", HTML: `<pre class="hterm-snapshot">wor</pre>`}
+	if *resp != *expected {
+		t.Errorf("unexpected snapshot response: %#v", resp)
+	}
+	w = post(t, mux, "/snapshot", `{"session_id": "s2"}`)
+	if w.Code != http.StatusNotFound || len(starter.streams) != 1 {
+		t.Error("snapshot must not start a session:", w.Code, len(starter.streams))
+	}
+
+	post(t, mux, "/close", `{"session_id": "s1"}`)
+	if server.Snapshot("s1") != nil {
+		t.Error("expected no snapshot for a closed session")
+	}
+}
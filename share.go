@@ -0,0 +1,383 @@
+package hterm
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Share links last for defaultShareTTL unless the request sets a duration, which may be at most
+// maxShareTTL.
+const (
+	defaultShareTTL = time.Hour
+	maxShareTTL     = 24 * time.Hour
+)
+
+// viewers that have not polled for viewerTimeout are removed
+const viewerTimeout = time.Minute
+
+// the most viewers a share can have at once, since each one can buffer maxViewerPending
+const maxShareViewers = 16
+
+// if a viewer falls further behind than this, its output is discarded and it is sent a redraw of
+// the screen instead
+const maxViewerPending = 1 << 20
+
+// errShareInvalid is returned for share tokens that are forged or malformed.
+var errShareInvalid = errors.New("invalid share link")
+
+// errTooManyViewers is returned when a new viewer would exceed maxShareViewers.
+var errTooManyViewers = fmt.Errorf("share link has the maximum of %d viewers", maxShareViewers)
+
+// share is a read-only link to a session.
+type share struct {
+	id      string
+	session *sessionState
+	expires time.Time
+	// closed when the share is revoked, or the session ends
+	done chan struct{}
+
+	// protected by session.mu; maps the viewer's id to the viewer
+	viewers map[string]*viewer
+}
+
+// viewer is someone watching a session with a share link. It is protected by session.mu.
+type viewer struct {
+	principal  string
+	remoteAddr string
+	joined     time.Time
+	lastSeen   time.Time
+	// true while a watch request is waiting for output
+	polling bool
+	// output that has not been sent to the viewer
+	pending []byte
+	// true if the viewer must be sent a redraw of the screen, instead of pending
+	resync bool
+	// receives a value when output is added to pending
+	wake chan struct{}
+}
+
+type createShareResponse struct {
+	ID    string `json:"id"`
+	Token string `json:"token"`
+	// URL is relative to the server's endpoints
+	URL     string    `json:"url"`
+	Expires time.Time `json:"expires"`
+}
+
+type viewerInfo struct {
+	Principal  string    `json:"principal"`
+	RemoteAddr string    `json:"remote_addr"`
+	Joined     time.Time `json:"joined"`
+	LastSeen   time.Time `json:"last_seen"`
+}
+
+type shareInfo struct {
+	ID      string       `json:"id"`
+	Expires time.Time    `json:"expires"`
+	Viewers []viewerInfo `json:"viewers"`
+}
+
+type listSharesResponse struct {
+	Shares []shareInfo `json:"shares"`
+}
+
+// shareSignature returns the signature of a token's id and expiry.
+func (s *Server) shareSignature(id string, expires int64) string {
+	mac := hmac.New(sha256.New, s.shareKey)
+	fmt.Fprintf(mac, "%s.%d", id, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// shareToken returns the token for share: its id and expiry, signed by the server. It does not
+// contain the session id, which would let viewers write to the session.
+func (s *Server) shareToken(sh *share) string {
+	expires := sh.expires.Unix()
+	return fmt.Sprintf("%s.%d.%s", sh.id, expires, s.shareSignature(sh.id, expires))
+}
+
+// lookupShare returns the share for token. It returns errShareInvalid if the token was not
+// signed by this server, or errSessionFinished if the share expired or was revoked.
+func (s *Server) lookupShare(token string) (*share, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errShareInvalid
+	}
+	expires, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, errShareInvalid
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.shareSignature(parts[0], expires))) {
+		return nil, errShareInvalid
+	}
+	if time.Now().Unix() >= expires {
+		return nil, errSessionFinished
+	}
+
+	s.removeExpiredShares(time.Now())
+	s.mu.Lock()
+	sh := s.shares[parts[0]]
+	s.mu.Unlock()
+	if sh == nil {
+		return nil, errSessionFinished
+	}
+	return sh, nil
+}
+
+// createShareHandler creates a read-only link to the session, which expires after ttl_seconds.
+func (s *Server) createShareHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	if session == nil {
+		return errSessionNotFound
+	}
+	ttl := time.Duration(request.TTLSeconds) * time.Second
+	if ttl == 0 {
+		ttl = defaultShareTTL
+	}
+	if ttl < 0 || ttl > maxShareTTL {
+		return fmt.Errorf("invalid ttl_seconds: %d", request.TTLSeconds)
+	}
+
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return err
+	}
+	sh := &share{
+		id:      base64.RawURLEncoding.EncodeToString(id),
+		session: session,
+		expires: time.Now().Add(ttl),
+		done:    make(chan struct{}),
+		viewers: map[string]*viewer{},
+	}
+
+	s.removeExpiredShares(time.Now())
+	session.mu.Lock()
+	if session.state == sessionClosed {
+		session.mu.Unlock()
+		return errSessionFinished
+	}
+	session.shares[sh.id] = sh
+	session.mu.Unlock()
+	s.mu.Lock()
+	s.shares[sh.id] = sh
+	s.mu.Unlock()
+	log.Printf("session %s: created share %s expiring %s", session.id, sh.id, sh.expires)
+
+	token := s.shareToken(sh)
+	resp := &createShareResponse{sh.id, token, "watch.html?share=" + url.QueryEscape(token), sh.expires}
+	return json.NewEncoder(w).Encode(resp)
+}
+
+// listSharesHandler returns the session's share links and their viewers.
+func (s *Server) listSharesHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	if session == nil {
+		return errSessionNotFound
+	}
+	resp := &listSharesResponse{Shares: []shareInfo{}}
+	now := time.Now()
+	session.mu.Lock()
+	for _, sh := range session.shares {
+		if !now.Before(sh.expires) {
+			continue
+		}
+		pruneViewersLocked(sh, now)
+		info := shareInfo{ID: sh.id, Expires: sh.expires, Viewers: []viewerInfo{}}
+		for _, v := range sh.viewers {
+			info.Viewers = append(info.Viewers, viewerInfo{v.principal, v.remoteAddr, v.joined, v.lastSeen})
+		}
+		sort.Slice(info.Viewers, func(i, j int) bool { return info.Viewers[i].Joined.Before(info.Viewers[j].Joined) })
+		resp.Shares = append(resp.Shares, info)
+	}
+	session.mu.Unlock()
+	sort.Slice(resp.Shares, func(i, j int) bool { return resp.Shares[i].Expires.Before(resp.Shares[j].Expires) })
+	return json.NewEncoder(w).Encode(resp)
+}
+
+// revokeShareHandler removes the session's share link share_id, disconnecting its viewers.
+func (s *Server) revokeShareHandler(w http.ResponseWriter, r *http.Request,
+	session *sessionState, request *requestUnion) error {
+	if session == nil {
+		return errSessionNotFound
+	}
+	session.mu.Lock()
+	sh := session.shares[request.ShareID]
+	session.mu.Unlock()
+	if sh == nil {
+		return fmt.Errorf("unknown share %s", request.ShareID)
+	}
+	s.revokeShare(sh)
+	w.Write(jsonEmptyObject)
+	return nil
+}
+
+// revokeShare removes sh and wakes up its viewers, which are told the session finished.
+func (s *Server) revokeShare(sh *share) {
+	s.mu.Lock()
+	if s.shares[sh.id] == sh {
+		delete(s.shares, sh.id)
+	}
+	s.mu.Unlock()
+
+	sh.session.mu.Lock()
+	if sh.session.shares[sh.id] == sh {
+		log.Printf("session %s: revoking share %s", sh.session.id, sh.id)
+		delete(sh.session.shares, sh.id)
+		close(sh.done)
+	}
+	sh.session.mu.Unlock()
+}
+
+// removeExpiredShares revokes the shares that expired at now, so they and their viewers are
+// not kept until the session ends.
+func (s *Server) removeExpiredShares(now time.Time) {
+	var expired []*share
+	s.mu.Lock()
+	for _, sh := range s.shares {
+		if !now.Before(sh.expires) {
+			expired = append(expired, sh)
+		}
+	}
+	s.mu.Unlock()
+	for _, sh := range expired {
+		s.revokeShare(sh)
+	}
+}
+
+// closeShares revokes all of session's shares when it finishes. Its closed state prevents new
+// ones.
+func (s *Server) closeShares(session *sessionState) {
+	session.mu.Lock()
+	shares := make([]*share, 0, len(session.shares))
+	for _, sh := range session.shares {
+		shares = append(shares, sh)
+	}
+	session.mu.Unlock()
+	for _, sh := range shares {
+		s.revokeShare(sh)
+	}
+}
+
+// fanOutLocked adds output from the session to the pending output of its viewers. session.mu
+// must be held, so viewers that join get a redraw that includes exactly the previous output.
+func fanOutLocked(session *sessionState, data []byte) {
+	now := time.Now()
+	for _, sh := range session.shares {
+		if !now.Before(sh.expires) {
+			// removeExpiredShares revokes it; until then, do not buffer output for its viewers
+			sh.viewers = map[string]*viewer{}
+			continue
+		}
+		pruneViewersLocked(sh, now)
+		for _, v := range sh.viewers {
+			if v.resync {
+				continue
+			}
+			if len(v.pending)+len(data) > maxViewerPending {
+				v.pending = nil
+				v.resync = true
+			} else {
+				v.pending = append(v.pending, data...)
+			}
+			select {
+			case v.wake <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// pruneViewersLocked removes viewers that went away. session.mu must be held.
+func pruneViewersLocked(sh *share, now time.Time) {
+	for id, v := range sh.viewers {
+		if !v.polling && now.Sub(v.lastSeen) > viewerTimeout {
+			delete(sh.viewers, id)
+		}
+	}
+}
+
+// watchHandler returns the output of the session shared by the share token, waiting until there
+// is some. The first request returns a redraw of the screen. Viewers are identified by the
+// request's session_id, which must be unique for each viewer, like a session id. Viewers can
+// not write to the session. A share has at most maxShareViewers viewers at once.
+func (s *Server) watchHandler(w http.ResponseWriter, r *http.Request,
+	_ *sessionState, request *requestUnion) error {
+	sh, err := s.lookupShare(request.Share)
+	if err != nil {
+		return err
+	}
+	session := sh.session
+
+	now := time.Now()
+	session.mu.Lock()
+	if session.shares[sh.id] != sh || !now.Before(sh.expires) {
+		// revoked or expired since lookupShare
+		session.mu.Unlock()
+		return errSessionFinished
+	}
+	v := sh.viewers[request.SessionId]
+	if v == nil {
+		pruneViewersLocked(sh, now)
+		if len(sh.viewers) >= maxShareViewers {
+			session.mu.Unlock()
+			return errTooManyViewers
+		}
+		log.Printf("session %s: share %s: new viewer %s", session.id, sh.id, r.RemoteAddr)
+		v = &viewer{
+			principal:  PrincipalFromContext(r.Context()),
+			remoteAddr: r.RemoteAddr,
+			joined:     now,
+			resync:     true,
+			wake:       make(chan struct{}, 1),
+		}
+		sh.viewers[request.SessionId] = v
+	}
+	v.polling = true
+	v.lastSeen = now
+	session.mu.Unlock()
+	defer func() {
+		session.mu.Lock()
+		v.polling = false
+		v.lastSeen = time.Now()
+		session.mu.Unlock()
+	}()
+
+	expired := time.NewTimer(time.Until(sh.expires))
+	defer expired.Stop()
+	for {
+		session.mu.Lock()
+		data := v.pending
+		v.pending = nil
+		if v.resync {
+			v.resync = false
+			data = []byte(session.screen.Snapshot().Replay())
+		}
+		session.mu.Unlock()
+		if len(data) > 0 {
+			return json.NewEncoder(w).Encode(&readResponse{string(data)})
+		}
+
+		select {
+		case <-v.wake:
+		case <-sh.done:
+			return errSessionFinished
+		case <-expired.C:
+			s.revokeShare(sh)
+			return errSessionFinished
+		case <-r.Context().Done():
+			return r.Context().Err()
+		}
+	}
+}
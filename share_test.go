@@ -0,0 +1,186 @@
+package hterm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServerShares(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter)
+	mux := newTestMux(server)
+
+	w := post(t, mux, "/open", `{"session_id": "s1", "columns": 10, "rows": 2}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	go starter.streams[0].writer.Write([]byte("before"))
+	post(t, mux, "/read", `{"session_id": "s1"}`)
+
+	w = post(t, mux, "/createShare", `{"session_id": "s1", "ttl_seconds": 60}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	created := &createShareResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(created.URL, "watch.html?share=") || time.Until(created.Expires) > time.Minute {
+		t.Errorf("unexpected share: %#v", created)
+	}
+	watchRequest := `{"session_id": "viewer1", "share": "` + created.Token + `"}`
+
+	// a new viewer gets a redraw of the screen
+	w = post(t, mux, "/watch", watchRequest)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "before") {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	// then the output read by the owner
+	go starter.streams[0].writer.Write([]byte("after"))
+	go post(t, mux, "/read", `{"session_id": "s1"}`)
+	w = post(t, mux, "/watch", watchRequest)
+	if w.Code != http.StatusOK || w.Body.String() != "{\"data\":\"after\"}\n" {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if starter.streams[0].written() != "" || len(starter.streams) != 1 {
+		t.Error("watching must not write or start sessions")
+	}
+
+	// the owner can see the viewers
+	w = post(t, mux, "/listShares", `{"session_id": "s1"}`)
+	list := &listSharesResponse{}
+	err = json.Unmarshal(w.Body.Bytes(), list)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Shares) != 1 || list.Shares[0].ID != created.ID || len(list.Shares[0].Viewers) != 1 ||
+		list.Shares[0].Viewers[0].Principal != "alice" || list.Shares[0].Viewers[0].RemoteAddr != "192.0.2.1:1234" {
+		t.Errorf("unexpected shares: %#v", list)
+	}
+
+	// forged and expired tokens are rejected
+	parts := strings.Split(created.Token, ".")
+	forged := []string{
+		"",
+		"invalid",
+		parts[0] + ".9999999999." + parts[2],
+		parts[0] + "." + parts[1] + ".AAAA",
+	}
+	for _, token := range forged {
+		w = post(t, mux, "/watch", `{"session_id": "viewer2", "share": "`+token+`"}`)
+		if w.Code != http.StatusForbidden {
+			t.Errorf("%s: expected forbidden; got %d", token, w.Code)
+		}
+	}
+	expired := &share{id: parts[0], expires: time.Now().Add(-time.Second)}
+	w = post(t, mux, "/watch", `{"session_id": "viewer2", "share": "`+server.shareToken(expired)+`"}`)
+	if w.Code != http.StatusGone {
+		t.Error("expected gone for an expired token:", w.Code)
+	}
+
+	// revoking disconnects waiting viewers
+	done := make(chan int)
+	go func() {
+		done <- post(t, mux, "/watch", watchRequest).Code
+	}()
+	server.mu.Lock()
+	sh := server.shares[created.ID]
+	server.mu.Unlock()
+	for polling := false; !polling; {
+		time.Sleep(time.Millisecond)
+		sh.session.mu.Lock()
+		polling = sh.viewers["viewer1"].polling
+		sh.session.mu.Unlock()
+	}
+	w = post(t, mux, "/revokeShare", `{"session_id": "s1", "share_id": "`+created.ID+`"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	if code := <-done; code != http.StatusGone {
+		t.Error("expected gone after revoking:", code)
+	}
+	w = post(t, mux, "/watch", watchRequest)
+	if w.Code != http.StatusGone {
+		t.Error("expected gone for a revoked share:", w.Code)
+	}
+
+	// closing the session ends its shares
+	w = post(t, mux, "/createShare", `{"session_id": "s1"}`)
+	err = json.Unmarshal(w.Body.Bytes(), created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	post(t, mux, "/close", `{"session_id": "s1"}`)
+	w = post(t, mux, "/watch", `{"session_id": "viewer1", "share": "`+created.Token+`"}`)
+	if w.Code != http.StatusGone {
+		t.Error("expected gone for a closed session:", w.Code)
+	}
+
+	for _, request := range []string{`{"session_id": "s2", "ttl_seconds": 100000}`, `{"session_id": "s2"}`} {
+		w = post(t, mux, "/createShare", request)
+		if w.Code == http.StatusOK || len(starter.streams) != 1 {
+			t.Error("expected an error:", request, w.Code)
+		}
+	}
+}
+
+func TestShareLimits(t *testing.T) {
+	starter := &fakeStarter{}
+	server := NewContextServer(starter)
+	mux := newTestMux(server)
+	w := post(t, mux, "/open", `{"session_id": "s1"}`)
+	if w.Code != http.StatusOK {
+		t.Fatal(w.Code, w.Body.String())
+	}
+	go starter.streams[0].writer.Write([]byte("before"))
+	post(t, mux, "/read", `{"session_id": "s1"}`)
+	w = post(t, mux, "/createShare", `{"session_id": "s1"}`)
+	created := &createShareResponse{}
+	err := json.Unmarshal(w.Body.Bytes(), created)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each share has a limited number of viewers
+	for i := 0; i < maxShareViewers; i++ {
+		w = post(t, mux, "/watch", fmt.Sprintf(`{"session_id": "viewer%d", "share": "%s"}`, i, created.Token))
+		if w.Code != http.StatusOK {
+			t.Fatal(w.Code, w.Body.String())
+		}
+	}
+	w = post(t, mux, "/watch", `{"session_id": "another", "share": "`+created.Token+`"}`)
+	if w.Code != http.StatusTooManyRequests {
+		t.Error("expected the viewer limit:", w.Code, w.Body.String())
+	}
+
+	// expired shares stop buffering output for their viewers, and are removed
+	server.mu.Lock()
+	sh := server.shares[created.ID]
+	server.mu.Unlock()
+	sh.session.mu.Lock()
+	sh.expires = time.Now().Add(-time.Second)
+	sh.session.mu.Unlock()
+	go starter.streams[0].writer.Write([]byte("after"))
+	post(t, mux, "/read", `{"session_id": "s1"}`)
+	sh.session.mu.Lock()
+	if len(sh.viewers) != 0 {
+		t.Error("expired shares must not keep viewers:", len(sh.viewers))
+	}
+	sh.session.mu.Unlock()
+	post(t, mux, "/createShare", `{"session_id": "s1"}`)
+	server.mu.Lock()
+	if server.shares[created.ID] != nil {
+		t.Error("expired shares must be removed")
+	}
+	server.mu.Unlock()
+	sh.session.mu.Lock()
+	if sh.session.shares[created.ID] != nil {
+		t.Error("expired shares must be removed from the session")
+	}
+	sh.session.mu.Unlock()
+}
@@ -0,0 +1,374 @@
+package hterm
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const tcpDialTimeout = 10 * time.Second
+
+// TCPTarget is a network console that a TCP starter can connect to.
+type TCPTarget struct {
+	// Address to dial, as host:port.
+	Address string
+	// Telnet enables the telnet protocol. Otherwise the connection is a raw byte stream.
+	Telnet bool
+}
+
+type tcpStarter struct {
+	targets map[string]TCPTarget
+}
+
+// NewTCPStarter returns a ContextSessionStarter that connects to network consoles, such as the
+// serial ports of a terminal server. The request's Extra["target"] selects the console: it must
+// be one of the keys in targets.
+func NewTCPStarter(targets map[string]TCPTarget) ContextSessionStarter {
+	return &tcpStarter{targets}
+}
+
+func (s *tcpStarter) StartContext(ctx context.Context, req *StartRequest) (io.ReadWriteCloser, error) {
+	// validate the target: the client controls extra
+	name := req.Extra["target"]
+	target, ok := s.targets[name]
+	if !ok {
+		return nil, errors.New("invalid target: " + name)
+	}
+
+	dialer := &net.Dialer{Timeout: tcpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", target.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !target.Telnet {
+		return rawConn{conn}, nil
+	}
+	t := newTelnetConn(conn)
+	// sent as soon as the server accepts NAWS
+	t.size = req.Size
+	err = t.negotiate()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+// rawConn is a raw TCP console.
+type rawConn struct {
+	net.Conn
+}
+
+// Resize implements Resizer. A raw byte stream has no way to send the terminal size.
+func (rawConn) Resize(size Size) error {
+	return nil
+}
+
+// Telnet commands and options (RFC 854, RFC 856, RFC 857, RFC 858, RFC 1073)
+const (
+	telnetSE   = 240
+	telnetSB   = 250
+	telnetWILL = 251
+	telnetWONT = 252
+	telnetDO   = 253
+	telnetDONT = 254
+	telnetIAC  = 255
+
+	telnetOptionBinary = 0
+	telnetOptionEcho   = 1
+	telnetOptionSGA    = 3
+	telnetOptionNAWS   = 31
+)
+
+// options that we are willing to perform
+var telnetLocalOptions = map[byte]bool{
+	telnetOptionBinary: true,
+	telnetOptionNAWS:   true,
+}
+
+// options that we are willing to let the server perform
+var telnetRemoteOptions = map[byte]bool{
+	telnetOptionBinary: true,
+	telnetOptionEcho:   true,
+	telnetOptionSGA:    true,
+}
+
+// The state of an option on one side of the connection. pending means we asked to enable it
+// and are waiting for the reply, which avoids negotiation loops (RFC 854 and RFC 1143).
+type telnetOptionState struct {
+	enabled bool
+	pending bool
+}
+
+// Decoder states for bytes received from the server
+const (
+	telnetStateData = iota
+	telnetStateCR
+	telnetStateIAC
+	telnetStateCommand
+	telnetStateSB
+	telnetStateSBIAC
+)
+
+// telnetConn is a telnet client connection. It negotiates options with the server as it reads,
+// so reads and writes must not be called concurrently with themselves, but Read may be called
+// concurrently with Write and Resize.
+type telnetConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+
+	// decoder state: only accessed by Read
+	state   int
+	command byte
+	buffer  []byte
+
+	// protects everything below, and ensures negotiation is not interleaved with writes
+	mu     sync.Mutex
+	local  [256]telnetOptionState
+	remote [256]telnetOptionState
+	size   Size
+}
+
+func newTelnetConn(conn net.Conn) *telnetConn {
+	return &telnetConn{conn: conn, reader: bufio.NewReader(conn)}
+}
+
+// negotiate requests the options that make a remote console work like a terminal: binary mode
+// in both directions, window size updates, and server echo and character at a time mode.
+func (t *telnetConn) negotiate() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var out []byte
+	for _, option := range []byte{telnetOptionBinary, telnetOptionNAWS} {
+		t.local[option].pending = true
+		out = append(out, telnetIAC, telnetWILL, option)
+	}
+	for _, option := range []byte{telnetOptionBinary, telnetOptionSGA, telnetOptionEcho} {
+		t.remote[option].pending = true
+		out = append(out, telnetIAC, telnetDO, option)
+	}
+	_, err := t.conn.Write(out)
+	return err
+}
+
+func (t *telnetConn) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		// reading nothing from the connection would never return any data
+		return 0, nil
+	}
+	if len(t.buffer) < len(p) {
+		t.buffer = make([]byte, len(p))
+	}
+	for {
+		n, err := t.reader.Read(t.buffer[:len(p)])
+		out, err2 := t.decode(p, t.buffer[:n])
+		if err2 != nil {
+			return out, err2
+		}
+		// only return if there is data, or an error: input may be entirely negotiation
+		if out > 0 || err != nil {
+			return out, err
+		}
+	}
+}
+
+// decode removes telnet commands from in, copies the data to out, and replies to any option
+// negotiation. out must be at least as long as in. It returns the number of bytes written to out.
+func (t *telnetConn) decode(out []byte, in []byte) (int, error) {
+	var replies []byte
+	n := 0
+	for _, b := range in {
+		switch t.state {
+		case telnetStateData, telnetStateCR:
+			if b == telnetIAC {
+				t.state = telnetStateIAC
+				continue
+			}
+			// outside binary mode, CR NUL means a bare CR
+			if t.state == telnetStateCR && b == 0 && !t.remoteEnabled(telnetOptionBinary) {
+				t.state = telnetStateData
+				continue
+			}
+			if b == '\r' {
+				t.state = telnetStateCR
+			} else {
+				t.state = telnetStateData
+			}
+			out[n] = b
+			n++
+
+		case telnetStateIAC:
+			switch b {
+			case telnetIAC:
+				// escaped 255 data byte
+				out[n] = b
+				n++
+				t.state = telnetStateData
+			case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+				t.command = b
+				t.state = telnetStateCommand
+			case telnetSB:
+				t.state = telnetStateSB
+			default:
+				// ignore other commands (NOP, GA, AYT, etc)
+				t.state = telnetStateData
+			}
+
+		case telnetStateCommand:
+			replies = append(replies, t.negotiateOption(t.command, b)...)
+			t.state = telnetStateData
+
+		case telnetStateSB:
+			// we do not support any subnegotiation sent by the server: skip it
+			if b == telnetIAC {
+				t.state = telnetStateSBIAC
+			}
+		case telnetStateSBIAC:
+			if b == telnetSE {
+				t.state = telnetStateData
+			} else {
+				t.state = telnetStateSB
+			}
+		}
+	}
+
+	if len(replies) > 0 {
+		err := t.writeCommands(replies)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (t *telnetConn) remoteEnabled(option byte) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.remote[option].enabled
+}
+
+// negotiateOption updates the state of option for a WILL/WONT/DO/DONT command from the server,
+// and returns the reply to send, if any.
+func (t *telnetConn) negotiateOption(command byte, option byte) []byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch command {
+	case telnetDO, telnetDONT:
+		state := &t.local[option]
+		if command == telnetDONT {
+			return disableOption(state, telnetWONT, option)
+		}
+		if !telnetLocalOptions[option] {
+			return []byte{telnetIAC, telnetWONT, option}
+		}
+		reply := enableOption(state, telnetWILL, option)
+		if option == telnetOptionNAWS {
+			reply = append(reply, t.nawsCommand()...)
+		}
+		return reply
+
+	default:
+		state := &t.remote[option]
+		if command == telnetWONT {
+			return disableOption(state, telnetDONT, option)
+		}
+		if !telnetRemoteOptions[option] {
+			return []byte{telnetIAC, telnetDONT, option}
+		}
+		return enableOption(state, telnetDO, option)
+	}
+}
+
+// enableOption handles a request to enable an option we support. It only replies if this is
+// not the answer to our own request, and the option is not already enabled.
+func enableOption(state *telnetOptionState, reply byte, option byte) []byte {
+	wasEnabled := state.enabled
+	wasPending := state.pending
+	state.enabled = true
+	state.pending = false
+	if wasEnabled || wasPending {
+		return nil
+	}
+	return []byte{telnetIAC, reply, option}
+}
+
+// disableOption handles a refusal or request to disable an option. It only acknowledges
+// disabling an enabled option: a refusal of our own request needs no reply.
+func disableOption(state *telnetOptionState, reply byte, option byte) []byte {
+	wasEnabled := state.enabled
+	state.enabled = false
+	state.pending = false
+	if !wasEnabled {
+		return nil
+	}
+	return []byte{telnetIAC, reply, option}
+}
+
+// nawsCommand returns the NAWS subnegotiation for the current size, or nil if the size is not
+// known yet. Must be called with mu held.
+func (t *telnetConn) nawsCommand() []byte {
+	if !t.local[telnetOptionNAWS].enabled || t.size.Columns <= 0 || t.size.Rows <= 0 {
+		return nil
+	}
+	out := []byte{telnetIAC, telnetSB, telnetOptionNAWS}
+	for _, v := range []int{t.size.Columns, t.size.Rows} {
+		for _, b := range []byte{byte(v >> 8), byte(v)} {
+			out = append(out, b)
+			if b == telnetIAC {
+				out = append(out, telnetIAC)
+			}
+		}
+	}
+	return append(out, telnetIAC, telnetSE)
+}
+
+func (t *telnetConn) writeCommands(commands []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, err := t.conn.Write(commands)
+	return err
+}
+
+func (t *telnetConn) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	binary := t.local[telnetOptionBinary].enabled
+	out := make([]byte, 0, len(p))
+	for _, b := range p {
+		out = append(out, b)
+		if b == telnetIAC {
+			out = append(out, telnetIAC)
+		} else if b == '\r' && !binary {
+			// outside binary mode, a bare CR must be sent as CR NUL
+			out = append(out, 0)
+		}
+	}
+	_, err := t.conn.Write(out)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Resize implements Resizer by sending the size to the server, if it accepted NAWS.
+func (t *telnetConn) Resize(size Size) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.size = size
+	command := t.nawsCommand()
+	if command == nil {
+		return nil
+	}
+	_, err := t.conn.Write(command)
+	return err
+}
+
+func (t *telnetConn) Close() error {
+	return t.conn.Close()
+}
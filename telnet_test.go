@@ -0,0 +1,144 @@
+package hterm
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// listen starts a TCP listener that returns the first accepted connection on the channel.
+func listen(t *testing.T) (string, <-chan net.Conn) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			close(accepted)
+			return
+		}
+		t.Cleanup(func() { conn.Close() })
+		accepted <- conn
+	}()
+	return listener.Addr().String(), accepted
+}
+
+// expectBytes reads len(expected) bytes from conn and checks they match.
+func expectBytes(t *testing.T, conn net.Conn, expected []byte) {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	buffer := make([]byte, len(expected))
+	_, err := io.ReadFull(conn, buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buffer, expected) {
+		t.Errorf("read %v; expected %v", buffer, expected)
+	}
+}
+
+func TestTCPStarterRaw(t *testing.T) {
+	addr, accepted := listen(t)
+	starter := NewTCPStarter(map[string]TCPTarget{"console": {Address: addr}})
+
+	_, err := starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"target": addr}})
+	if err == nil || !strings.Contains(err.Error(), "invalid target") {
+		t.Error("expected invalid target error:", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = starter.StartContext(ctx, &StartRequest{Extra: map[string]string{"target": "console"}})
+	if err == nil {
+		t.Error("expected the cancelled start to fail")
+	}
+
+	stream, err := starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"target": "console"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	conn := <-accepted
+
+	// raw connections pass all bytes through unmodified
+	raw := []byte{'a', telnetIAC, telnetDO, telnetOptionNAWS, '\r'}
+	_, err = stream.Write(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBytes(t, conn, raw)
+	err = stream.(Resizer).Resize(Size{Columns: 80, Rows: 24})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTCPStarterTelnet(t *testing.T) {
+	addr, accepted := listen(t)
+	starter := NewTCPStarter(map[string]TCPTarget{"console": {Address: addr, Telnet: true}})
+	stream, err := starter.StartContext(context.Background(), &StartRequest{Extra: map[string]string{"target": "console"},
+		Size: Size{Columns: 100, Rows: 30}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	conn := <-accepted
+
+	// the client requests the options it wants
+	expectBytes(t, conn, []byte{
+		telnetIAC, telnetWILL, telnetOptionBinary,
+		telnetIAC, telnetWILL, telnetOptionNAWS,
+		telnetIAC, telnetDO, telnetOptionBinary,
+		telnetIAC, telnetDO, telnetOptionSGA,
+		telnetIAC, telnetDO, telnetOptionEcho,
+	})
+
+	// accept NAWS and echo, refuse binary, and ask for terminal type which is not supported
+	const telnetOptionTerminalType = 24
+	_, err = conn.Write([]byte{
+		telnetIAC, telnetDO, telnetOptionNAWS,
+		telnetIAC, telnetWILL, telnetOptionEcho,
+		telnetIAC, telnetDONT, telnetOptionBinary,
+		telnetIAC, telnetDO, telnetOptionTerminalType,
+		telnetIAC, telnetSB, telnetOptionTerminalType, 1, telnetIAC, telnetSE,
+		'o', 'k', telnetIAC, telnetIAC, '\r', 0, '\n',
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := make([]byte, 5)
+	_, err = io.ReadFull(stream, buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buffer, []byte{'o', 'k', telnetIAC, '\r', '\n'}) {
+		t.Errorf("unexpected data: %v", buffer)
+	}
+	n, err := stream.Read(nil)
+	if n != 0 || err != nil {
+		t.Error("empty reads must return immediately:", n, err)
+	}
+	// accepting NAWS sends the initial size; the unsupported option is refused
+	expectBytes(t, conn, []byte{telnetIAC, telnetSB, telnetOptionNAWS, 0, 100, 0, 30, telnetIAC, telnetSE,
+		telnetIAC, telnetWONT, telnetOptionTerminalType})
+
+	err = stream.(Resizer).Resize(Size{Columns: 80, Rows: 255})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBytes(t, conn, []byte{telnetIAC, telnetSB, telnetOptionNAWS, 0, 80, 0, telnetIAC, telnetIAC,
+		telnetIAC, telnetSE})
+
+	// binary mode was refused: CR must be followed by NUL
+	_, err = stream.Write([]byte{'a', telnetIAC, '\r'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBytes(t, conn, []byte{'a', telnetIAC, telnetIAC, '\r', 0})
+}
@@ -0,0 +1,560 @@
+package vt
+
+import (
+	"unicode/utf8"
+)
+
+// Parser states, following https://vt100.net/emu/dec_ansi_parser
+const (
+	stateGround = iota
+	stateEscape
+	stateCSI
+	stateOSC
+	// a DCS, SOS, PM or APC string, which is ignored
+	stateIgnoreString
+)
+
+// limits on sequences so a misbehaving program can't use unbounded memory
+const (
+	maxParams    = 32
+	maxParamPart = 8
+	maxParam     = 65535
+	maxOSC       = 4096
+	// real sequences have one or two
+	maxIntermediates = 4
+)
+
+// parser is the state of the escape sequence parser.
+type parser struct {
+	state int
+	// the previous byte was ESC inside a string: ESC \ is the string terminator
+	stringEscape bool
+
+	// incomplete UTF-8 sequence
+	utf8    [utf8.UTFMax]byte
+	utf8Len int
+
+	// CSI: a private marker like ? and the intermediate bytes
+	private       byte
+	intermediates []byte
+	// the sequence had more than maxIntermediates, so it is ignored
+	ignore bool
+	// params separated by ;, each with parts separated by : (e.g. 38:2::255:0:0)
+	params [][]int
+
+	osc []byte
+}
+
+// Write updates the screen with output from the program. It never returns an error.
+func (s *Screen) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range p {
+		s.parseByte(b)
+	}
+	return len(p), nil
+}
+
+func (s *Screen) parseByte(b byte) {
+	p := &s.parser
+	if p.utf8Len > 0 || (b >= 0x80 && p.state == stateGround) {
+		s.parseUTF8(b)
+		return
+	}
+
+	switch p.state {
+	case stateOSC, stateIgnoreString:
+		s.parseString(b)
+		return
+	}
+
+	// C0 controls are executed in any state except strings
+	switch b {
+	case 0x18, 0x1a:
+		// CAN and SUB abort sequences
+		p.state = stateGround
+		return
+	case 0x1b:
+		p.state = stateEscape
+		p.intermediates = p.intermediates[:0]
+		p.ignore = false
+		return
+	}
+	if b < 0x20 {
+		s.execute(b)
+		return
+	}
+
+	switch p.state {
+	case stateGround:
+		if b != 0x7f {
+			s.put(rune(b))
+		}
+	case stateEscape:
+		s.parseEscape(b)
+	case stateCSI:
+		s.parseCSI(b)
+	}
+}
+
+// parseUTF8 collects a multi-byte UTF-8 character.
+func (s *Screen) parseUTF8(b byte) {
+	p := &s.parser
+	if p.utf8Len > 0 && (b < 0x80 || b >= 0xc0) {
+		// incomplete sequence: replace it, then handle b normally
+		p.utf8Len = 0
+		s.put(utf8.RuneError)
+		s.parseByte(b)
+		return
+	}
+	p.utf8[p.utf8Len] = b
+	p.utf8Len++
+	if !utf8.FullRune(p.utf8[:p.utf8Len]) {
+		return
+	}
+	r, _ := utf8.DecodeRune(p.utf8[:p.utf8Len])
+	p.utf8Len = 0
+	s.put(r)
+}
+
+// execute runs a C0 control character.
+func (s *Screen) execute(b byte) {
+	c := &s.cursor
+	switch b {
+	case '\b':
+		if c.x > 0 {
+			c.x--
+		}
+		c.wrapNext = false
+	case '\t':
+		s.tab(1)
+	case '\n', '\v', '\f':
+		s.index()
+		c.wrapNext = false
+	case '\r':
+		c.x = 0
+		c.wrapNext = false
+	case 0x0e:
+		// SO: use G1
+		c.shift = 1
+	case 0x0f:
+		// SI: use G0
+		c.shift = 0
+	}
+}
+
+func (s *Screen) parseEscape(b byte) {
+	p := &s.parser
+	if 0x20 <= b && b <= 0x2f {
+		p.collectIntermediate(b)
+		return
+	}
+	p.state = stateGround
+	if p.ignore {
+		return
+	}
+
+	if len(p.intermediates) > 0 {
+		switch p.intermediates[0] {
+		case '(', ')':
+			// designate G0 or G1
+			charset := charsetASCII
+			if b == '0' {
+				charset = charsetGraphics
+			}
+			s.cursor.charsets[p.intermediates[0]-'('] = charset
+		case '#':
+			if b == '8' {
+				s.alignmentTest()
+			}
+		}
+		return
+	}
+
+	switch b {
+	case '[':
+		p.state = stateCSI
+		p.private = 0
+		p.params = p.params[:0]
+	case ']':
+		p.state = stateOSC
+		p.osc = p.osc[:0]
+	case 'P', 'X', '^', '_':
+		p.state = stateIgnoreString
+	case '7':
+		s.saveCursor()
+	case '8':
+		s.restoreCursor()
+	case 'D':
+		s.index()
+	case 'E':
+		s.cursor.x = 0
+		s.index()
+		s.cursor.wrapNext = false
+	case 'M':
+		s.reverseIndex()
+		s.cursor.wrapNext = false
+	case 'H':
+		s.tabs[s.cursor.x] = true
+	case 'c':
+		s.reset()
+	}
+}
+
+// parseString collects OSC strings and ignores other strings, until BEL or ESC \.
+func (s *Screen) parseString(b byte) {
+	p := &s.parser
+	if p.stringEscape {
+		p.stringEscape = false
+		if b == '\\' {
+			s.endString()
+			return
+		}
+		// ESC followed by something else: abort the string and start a new escape sequence
+		p.state = stateEscape
+		p.intermediates = p.intermediates[:0]
+		s.parseByte(b)
+		return
+	}
+	switch b {
+	case 0x07:
+		s.endString()
+	case 0x1b:
+		p.stringEscape = true
+	case 0x18, 0x1a:
+		p.state = stateGround
+	default:
+		if p.state == stateOSC && len(p.osc) < maxOSC {
+			p.osc = append(p.osc, b)
+		}
+	}
+}
+
+func (s *Screen) endString() {
+	p := &s.parser
+	if p.state == stateOSC {
+		s.osc(string(p.osc))
+	}
+	p.state = stateGround
+}
+
+// osc runs an operating system command. Only setting the title is supported.
+func (s *Screen) osc(command string) {
+	for i := 0; i < len(command); i++ {
+		if command[i] != ';' {
+			continue
+		}
+		switch command[:i] {
+		case "0", "2":
+			title := command[i+1:]
+			if utf8.ValidString(title) {
+				s.title = title
+			}
+		}
+		return
+	}
+}
+
+func (s *Screen) parseCSI(b byte) {
+	p := &s.parser
+	switch {
+	case '0' <= b && b <= '9':
+		if len(p.params) == 0 {
+			p.params = append(p.params, []int{0})
+		}
+		param := p.params[len(p.params)-1]
+		v := &param[len(param)-1]
+		*v = *v*10 + int(b-'0')
+		if *v > maxParam {
+			*v = maxParam
+		}
+	case b == ';':
+		if len(p.params) == 0 {
+			p.params = append(p.params, []int{0})
+		}
+		if len(p.params) < maxParams {
+			p.params = append(p.params, []int{0})
+		}
+	case b == ':':
+		if len(p.params) == 0 {
+			p.params = append(p.params, []int{0})
+		}
+		last := len(p.params) - 1
+		if len(p.params[last]) < maxParamPart {
+			p.params[last] = append(p.params[last], 0)
+		}
+	case '<' <= b && b <= '?':
+		p.private = b
+	case 0x20 <= b && b <= 0x2f:
+		p.collectIntermediate(b)
+	case 0x40 <= b && b <= 0x7e:
+		p.state = stateGround
+		if !p.ignore {
+			s.dispatchCSI(b)
+		}
+	}
+}
+
+// collectIntermediate adds b to the sequence's intermediate bytes. A sequence with more than
+// maxIntermediates is ignored.
+func (p *parser) collectIntermediate(b byte) {
+	if len(p.intermediates) >= maxIntermediates {
+		p.ignore = true
+		return
+	}
+	p.intermediates = append(p.intermediates, b)
+}
+
+// param returns the first part of parameter i, or def if it is missing or 0.
+func (p *parser) param(i int, def int) int {
+	if i >= len(p.params) || p.params[i][0] == 0 {
+		return def
+	}
+	return p.params[i][0]
+}
+
+func (s *Screen) dispatchCSI(final byte) {
+	p := &s.parser
+	c := &s.cursor
+	if len(p.intermediates) > 0 {
+		// e.g. DECSCUSR (CSI Ps SP q), which does not change the screen
+		return
+	}
+	if p.private == '?' {
+		switch final {
+		case 'h':
+			s.setPrivateModes(true)
+		case 'l':
+			s.setPrivateModes(false)
+		}
+		return
+	}
+	if p.private != 0 {
+		return
+	}
+
+	n := p.param(0, 1)
+	switch final {
+	case '@':
+		s.insertCells(s.active.lines[c.y], c.x, n)
+		c.wrapNext = false
+	case 'A':
+		s.moveVertical(-n)
+	case 'B', 'e':
+		s.moveVertical(n)
+	case 'C', 'a':
+		c.x = clamp(c.x+n, 0, s.columns-1)
+		c.wrapNext = false
+	case 'D':
+		c.x = clamp(c.x-n, 0, s.columns-1)
+		c.wrapNext = false
+	case 'E':
+		s.moveVertical(n)
+		c.x = 0
+	case 'F':
+		s.moveVertical(-n)
+		c.x = 0
+	case 'G', '`':
+		c.x = clamp(n-1, 0, s.columns-1)
+		c.wrapNext = false
+	case 'H', 'f':
+		s.moveTo(p.param(1, 1)-1, n-1)
+	case 'I':
+		s.tab(n)
+	case 'Z':
+		s.tab(-n)
+	case 'J':
+		s.eraseDisplay(p.param(0, 0))
+	case 'K':
+		s.eraseLine(p.param(0, 0))
+	case 'L':
+		if s.top <= c.y && c.y <= s.bottom {
+			s.scrollDown(c.y, s.bottom, n)
+			c.x = 0
+			c.wrapNext = false
+		}
+	case 'M':
+		if s.top <= c.y && c.y <= s.bottom {
+			s.scrollUp(c.y, s.bottom, n)
+			c.x = 0
+			c.wrapNext = false
+		}
+	case 'P':
+		s.deleteCells(s.active.lines[c.y], c.x, n)
+		c.wrapNext = false
+	case 'S':
+		s.scrollUp(s.top, s.bottom, n)
+	case 'T':
+		s.scrollDown(s.top, s.bottom, n)
+	case 'X':
+		s.clearCells(s.active.lines[c.y], c.x, c.x+n)
+		c.wrapNext = false
+	case 'd':
+		x := c.x
+		s.moveTo(x, n-1)
+	case 'g':
+		switch p.param(0, 0) {
+		case 0:
+			s.tabs[c.x] = false
+		case 3:
+			for x := range s.tabs {
+				s.tabs[x] = false
+			}
+		}
+	case 'h', 'l':
+		for i := range p.params {
+			if p.params[i][0] == 4 {
+				s.insert = final == 'h'
+			}
+		}
+	case 'm':
+		s.selectGraphicRendition()
+	case 'r':
+		s.setScrollRegion(p.param(0, 0), p.param(1, 0))
+	case 's':
+		s.saveCursor()
+	case 'u':
+		s.restoreCursor()
+	}
+}
+
+// setPrivateModes implements DECSET and DECRST.
+func (s *Screen) setPrivateModes(set bool) {
+	for _, param := range s.parser.params {
+		switch param[0] {
+		case 6:
+			s.cursor.origin = set
+			s.moveTo(0, 0)
+		case 7:
+			s.autoWrap = set
+			if !set {
+				s.cursor.wrapNext = false
+			}
+		case 25:
+			s.cursorVisible = set
+		case 47:
+			s.useAlternate(set, false)
+		case 1047:
+			s.useAlternate(set, !set)
+		case 1048:
+			if set {
+				s.saveCursor()
+			} else {
+				s.restoreCursor()
+			}
+		case 1049:
+			if set {
+				s.saveCursor()
+				s.useAlternate(true, true)
+			} else {
+				s.useAlternate(false, false)
+				s.restoreCursor()
+			}
+		}
+	}
+}
+
+// selectGraphicRendition implements SGR, which sets the attributes of the following text.
+func (s *Screen) selectGraphicRendition() {
+	params := s.parser.params
+	attr := &s.cursor.attr
+	if len(params) == 0 {
+		*attr = Attr{}
+		return
+	}
+	for i := 0; i < len(params); i++ {
+		param := params[i]
+		switch v := param[0]; {
+		case v == 0:
+			*attr = Attr{}
+		case v == 1:
+			attr.Flags |= Bold
+		case v == 2:
+			attr.Flags |= Dim
+		case v == 3:
+			attr.Flags |= Italic
+		case v == 4:
+			attr.Flags |= Underline
+		case v == 5:
+			attr.Flags |= Blink
+		case v == 7:
+			attr.Flags |= Reverse
+		case v == 8:
+			attr.Flags |= Hidden
+		case v == 9:
+			attr.Flags |= Strikethrough
+		case v == 21:
+			// doubly underlined on xterm
+			attr.Flags |= Underline
+		case v == 22:
+			attr.Flags &^= Bold | Dim
+		case v == 23:
+			attr.Flags &^= Italic
+		case v == 24:
+			attr.Flags &^= Underline
+		case v == 25:
+			attr.Flags &^= Blink
+		case v == 27:
+			attr.Flags &^= Reverse
+		case v == 28:
+			attr.Flags &^= Hidden
+		case v == 29:
+			attr.Flags &^= Strikethrough
+		case 30 <= v && v <= 37:
+			attr.Foreground = PaletteColor(uint8(v - 30))
+		case v == 38:
+			var color Color
+			color, i = extendedColor(params, i)
+			attr.Foreground = color
+		case v == 39:
+			attr.Foreground = ColorDefault
+		case 40 <= v && v <= 47:
+			attr.Background = PaletteColor(uint8(v - 40))
+		case v == 48:
+			var color Color
+			color, i = extendedColor(params, i)
+			attr.Background = color
+		case v == 49:
+			attr.Background = ColorDefault
+		case 90 <= v && v <= 97:
+			attr.Foreground = PaletteColor(uint8(v - 90 + 8))
+		case 100 <= v && v <= 107:
+			attr.Background = PaletteColor(uint8(v - 100 + 8))
+		}
+	}
+}
+
+// extendedColor parses a 256 or 24-bit color starting at params[i], which is 38 or 48. It
+// accepts the ; form (38;5;n and 38;2;r;g;b) and the : form (38:5:n, 38:2::r:g:b and
+// 38:2:r:g:b). It returns the color, or ColorDefault if it is invalid, and the index of the
+// last parameter used.
+func extendedColor(params [][]int, i int) (Color, int) {
+	parts := params[i][1:]
+	if len(parts) == 0 {
+		// ; form: the color uses the following parameters
+		for _, param := range params[i+1:] {
+			parts = append(parts, param[0])
+		}
+		used := 0
+		color := ColorDefault
+		if len(parts) >= 2 && parts[0] == 5 {
+			color, used = PaletteColor(uint8(parts[1])), 2
+		} else if len(parts) >= 4 && parts[0] == 2 {
+			color, used = RGBColor(uint8(parts[1]), uint8(parts[2]), uint8(parts[3])), 4
+		} else if len(parts) >= 1 {
+			used = 1
+		}
+		return color, i + used
+	}
+
+	switch {
+	case parts[0] == 5 && len(parts) >= 2:
+		return PaletteColor(uint8(parts[1])), i
+	case parts[0] == 2 && len(parts) >= 5:
+		// with a color space id, which is ignored
+		return RGBColor(uint8(parts[2]), uint8(parts[3]), uint8(parts[4])), i
+	case parts[0] == 2 && len(parts) == 4:
+		return RGBColor(uint8(parts[1]), uint8(parts[2]), uint8(parts[3])), i
+	}
+	return ColorDefault, i
+}
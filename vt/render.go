@@ -0,0 +1,231 @@
+package vt
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// colors used in HTML for the default foreground and background, which match hterm's defaults
+const (
+	htmlForeground = "#ffffff"
+	htmlBackground = "#000000"
+)
+
+// the first 16 colors of the palette, as used by xterm
+var basicColors = [16][3]uint8{
+	{0x00, 0x00, 0x00}, {0xcd, 0x00, 0x00}, {0x00, 0xcd, 0x00}, {0xcd, 0xcd, 0x00},
+	{0x00, 0x00, 0xee}, {0xcd, 0x00, 0xcd}, {0x00, 0xcd, 0xcd}, {0xe5, 0xe5, 0xe5},
+	{0x7f, 0x7f, 0x7f}, {0xff, 0x00, 0x00}, {0x00, 0xff, 0x00}, {0xff, 0xff, 0x00},
+	{0x5c, 0x5c, 0xff}, {0xff, 0x00, 0xff}, {0x00, 0xff, 0xff}, {0xff, 0xff, 0xff},
+}
+
+// paletteRGB returns the components of color n of the 256 color palette: the basic colors, a
+// 6x6x6 color cube, then 24 shades of grey.
+func paletteRGB(n uint8) (uint8, uint8, uint8) {
+	if n < 16 {
+		c := basicColors[n]
+		return c[0], c[1], c[2]
+	}
+	if n < 232 {
+		level := func(i uint8) uint8 {
+			if i == 0 {
+				return 0
+			}
+			return 55 + 40*i
+		}
+		n -= 16
+		return level(n / 36), level(n / 6 % 6), level(n % 6)
+	}
+	grey := 8 + 10*(n-232)
+	return grey, grey, grey
+}
+
+// css returns c as a CSS color, or def if c is the default color.
+func (c Color) css(def string) string {
+	if n, ok := c.Palette(); ok {
+		r, g, b := paletteRGB(n)
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	}
+	if r, g, b, ok := c.RGB(); ok {
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+	}
+	return def
+}
+
+// sgrParams appends the SGR parameters that select c. base is 30 for the foreground or 40 for
+// the background.
+func (c Color) sgrParams(params []string, base int) []string {
+	if n, ok := c.Palette(); ok {
+		switch {
+		case n < 8:
+			return append(params, strconv.Itoa(base+int(n)))
+		case n < 16:
+			return append(params, strconv.Itoa(base+60+int(n)-8))
+		}
+		return append(params, strconv.Itoa(base+8), "5", strconv.Itoa(int(n)))
+	}
+	if r, g, b, ok := c.RGB(); ok {
+		return append(params, strconv.Itoa(base+8), "2", strconv.Itoa(int(r)), strconv.Itoa(int(g)),
+			strconv.Itoa(int(b)))
+	}
+	return params
+}
+
+// SGR parameters for each flag, in the order of the flags
+var flagParams = []string{"1", "2", "3", "4", "5", "7", "8", "9"}
+
+// sgr returns the escape sequence that resets the attributes and then selects a.
+func (a Attr) sgr() string {
+	params := []string{"0"}
+	for i, param := range flagParams {
+		if a.Flags&(1<<uint(i)) != 0 {
+			params = append(params, param)
+		}
+	}
+	params = a.Foreground.sgrParams(params, 30)
+	params = a.Background.sgrParams(params, 40)
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// style returns the CSS declarations that display a, or the empty string for the default.
+func (a Attr) style() string {
+	if a == (Attr{}) {
+		return ""
+	}
+	var declarations []string
+	foreground := a.Foreground.css("")
+	background := a.Background.css("")
+	if a.Flags&Reverse != 0 {
+		foreground, background = a.Background.css(htmlBackground), a.Foreground.css(htmlForeground)
+	}
+	if a.Flags&Hidden != 0 {
+		foreground = "transparent"
+	}
+	if foreground != "" {
+		declarations = append(declarations, "color:"+foreground)
+	}
+	if background != "" {
+		declarations = append(declarations, "background-color:"+background)
+	}
+	if a.Flags&Bold != 0 {
+		declarations = append(declarations, "font-weight:bold")
+	}
+	if a.Flags&Dim != 0 {
+		declarations = append(declarations, "opacity:0.5")
+	}
+	if a.Flags&Italic != 0 {
+		declarations = append(declarations, "font-style:italic")
+	}
+	var decorations []string
+	if a.Flags&Underline != 0 {
+		decorations = append(decorations, "underline")
+	}
+	if a.Flags&Strikethrough != 0 {
+		decorations = append(decorations, "line-through")
+	}
+	if len(decorations) > 0 {
+		declarations = append(declarations, "text-decoration:"+strings.Join(decorations, " "))
+	}
+	return strings.Join(declarations, ";")
+}
+
+// trimLine returns line without the blank cells with default attributes at the end.
+func trimLine(line []Cell) []Cell {
+	end := len(line)
+	for end > 0 && line[end-1] == blank(Attr{}) {
+		end--
+	}
+	return line[:end]
+}
+
+// Text returns the text on the screen, one line per row, without trailing spaces.
+func (s *Snapshot) Text() string {
+	return strings.Join(s.Lines(), "\n")
+}
+
+// ANSI returns the screen as text with SGR escape sequences for the attributes, with rows
+// separated by CRLF. Printing it on a terminal shows the screen with its colors.
+func (s *Snapshot) ANSI() string {
+	var b strings.Builder
+	for i, line := range s.Cells {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		attr := Attr{}
+		for _, cell := range trimLine(line) {
+			if cell.Rune == 0 {
+				continue
+			}
+			if cell.Attr != attr {
+				attr = cell.Attr
+				b.WriteString(attr.sgr())
+			}
+			b.WriteRune(cell.Rune)
+			b.WriteString(cell.Combining)
+		}
+		if attr != (Attr{}) {
+			b.WriteString("\x1b[0m")
+		}
+	}
+	return b.String()
+}
+
+// HTML returns the screen as a pre element, with spans that set the colors and attributes with
+// inline styles. The default colors are inherited, so the page should set them.
+func (s *Snapshot) HTML() string {
+	var b strings.Builder
+	b.WriteString(`<pre class="hterm-snapshot">`)
+	for i, line := range s.Cells {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		style := ""
+		var text strings.Builder
+		flush := func() {
+			if text.Len() == 0 {
+				return
+			}
+			if style == "" {
+				b.WriteString(html.EscapeString(text.String()))
+			} else {
+				fmt.Fprintf(&b, `<span style="%s">%s</span>`, style, html.EscapeString(text.String()))
+			}
+			text.Reset()
+		}
+		for _, cell := range trimLine(line) {
+			if cell.Rune == 0 {
+				continue
+			}
+			if cellStyle := cell.Attr.style(); cellStyle != style {
+				flush()
+				style = cellStyle
+			}
+			text.WriteRune(cell.Rune)
+			text.WriteString(cell.Combining)
+		}
+		flush()
+	}
+	b.WriteString("</pre>")
+	return b.String()
+}
+
+// Replay returns escape sequences that redraw the screen on a terminal of the same size: they
+// clear it, draw the contents, then restore the title, cursor position and cursor visibility.
+// A viewer that joins a session can show Replay, then the session's output.
+func (s *Snapshot) Replay() string {
+	var b strings.Builder
+	b.WriteString("\x1b[0m\x1b[H\x1b[2J")
+	if s.Title != "" {
+		b.WriteString("\x1b]2;" + s.Title + "\x07")
+	}
+	b.WriteString(s.ANSI())
+	fmt.Fprintf(&b, "\x1b[%d;%dH", s.CursorY+1, s.CursorX+1)
+	if s.CursorVisible {
+		b.WriteString("\x1b[?25h")
+	} else {
+		b.WriteString("\x1b[?25l")
+	}
+	return b.String()
+}
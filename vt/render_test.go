@@ -0,0 +1,70 @@
+package vt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	s := newTestScreen(10, 3, "plain  \r\n\x1b[1;31mred\x1b[0m <b>\x1b[38;5;200;48;2;1;2;3mx\x1b[m\r\n\x1b[7m世\x1b[m")
+	snapshot := s.Snapshot()
+
+	expected := "plain\nred <b>x\n世"
+	if snapshot.Text() != expected {
+		t.Errorf("Text() = %q; expected %q", snapshot.Text(), expected)
+	}
+
+	expected = "plain\r\n\x1b[0;1;31mred\x1b[0m <b>\x1b[0;38;5;200;48;2;1;2;3mx\x1b[0m\r\n\x1b[0;7m世\x1b[0m"
+	if snapshot.ANSI() != expected {
+		t.Errorf("ANSI() = %q; expected %q", snapshot.ANSI(), expected)
+	}
+	// the ANSI text reproduces the screen
+	replay := NewScreen(10, 3)
+	replay.Write([]byte(snapshot.ANSI()))
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 10; x++ {
+			if replay.Cell(x, y) != s.Cell(x, y) {
+				t.Errorf("replay cell %d,%d = %#v; expected %#v", x, y, replay.Cell(x, y), s.Cell(x, y))
+			}
+		}
+	}
+
+	expected = `<pre class="hterm-snapshot">plain` + "\n" +
+		`<span style="color:#cd0000;font-weight:bold">red</span> &lt;b&gt;` +
+		`<span style="color:#ff00d7;background-color:#010203">x</span>` + "\n" +
+		`<span style="color:#000000;background-color:#ffffff">世</span></pre>`
+	if snapshot.HTML() != expected {
+		t.Errorf("HTML() = %q; expected %q", snapshot.HTML(), expected)
+	}
+}
+
+func TestPaletteRGB(t *testing.T) {
+	colors := map[uint8][3]uint8{
+		1:   {0xcd, 0, 0},
+		15:  {0xff, 0xff, 0xff},
+		16:  {0, 0, 0},
+		21:  {0, 0, 0xff},
+		196: {0xff, 0, 0},
+		231: {0xff, 0xff, 0xff},
+		232: {8, 8, 8},
+		255: {0xee, 0xee, 0xee},
+	}
+	for n, expected := range colors {
+		r, g, b := paletteRGB(n)
+		if [3]uint8{r, g, b} != expected {
+			t.Errorf("paletteRGB(%d) = %d,%d,%d; expected %v", n, r, g, b, expected)
+		}
+	}
+}
+
+func TestReplay(t *testing.T) {
+	s := newTestScreen(10, 3, "\x1b]2;title\x07\x1b[32mgreen\x1b[m\r\n\x1b[3;4Hx\x1b[2;2H\x1b[?25l")
+	replay := newTestScreen(10, 3, "previous contents\x1b[31m")
+	replay.Write([]byte(s.Snapshot().Replay()))
+
+	expected := s.Snapshot()
+	actual := replay.Snapshot()
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("unexpected replay:\n%#v\nexpected:\n%#v", actual, expected)
+	}
+}
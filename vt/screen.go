@@ -0,0 +1,689 @@
+// Package vt is a VT100/xterm terminal emulator without a display. It parses the output of a
+// terminal program and keeps the contents of the screen, so the server can take a snapshot of
+// what the user sees.
+package vt
+
+import (
+	"strings"
+	"sync"
+)
+
+// Default size used for invalid sizes.
+const (
+	DefaultColumns = 80
+	DefaultRows    = 24
+)
+
+// Color is a foreground or background color. The zero value is the terminal's default color.
+type Color uint32
+
+const (
+	// ColorDefault is the terminal's default color.
+	ColorDefault Color = 0
+
+	colorPalette = 1 << 24
+	colorRGB     = 2 << 24
+	colorKind    = 0xff << 24
+)
+
+// PaletteColor returns color n of the 256 color palette. Colors 0-7 are the standard colors and
+// 8-15 are the bright colors.
+func PaletteColor(n uint8) Color {
+	return Color(colorPalette | uint32(n))
+}
+
+// RGBColor returns a 24-bit color.
+func RGBColor(r uint8, g uint8, b uint8) Color {
+	return Color(colorRGB | uint32(r)<<16 | uint32(g)<<8 | uint32(b))
+}
+
+// Palette returns the palette index and true if c is a palette color.
+func (c Color) Palette() (uint8, bool) {
+	return uint8(c), c&colorKind == colorPalette
+}
+
+// RGB returns the components and true if c is a 24-bit color.
+func (c Color) RGB() (uint8, uint8, uint8, bool) {
+	return uint8(c >> 16), uint8(c >> 8), uint8(c), c&colorKind == colorRGB
+}
+
+// AttrFlags are the text attributes set by SGR.
+type AttrFlags uint16
+
+// Text attributes.
+const (
+	Bold AttrFlags = 1 << iota
+	Dim
+	Italic
+	Underline
+	Blink
+	Reverse
+	Hidden
+	Strikethrough
+)
+
+// Attr is how a cell is displayed.
+type Attr struct {
+	Foreground Color
+	Background Color
+	Flags      AttrFlags
+}
+
+// Cell is one character position on the screen.
+type Cell struct {
+	// Rune is the character, or 0 if the cell is the second half of a wide character.
+	Rune rune
+	// Combining contains combining characters that follow Rune.
+	Combining string
+	// Wide is true if the character uses this cell and the next one.
+	Wide bool
+	Attr Attr
+}
+
+// Charsets selected with ESC ( and ESC ).
+const (
+	charsetASCII = iota
+	// DEC Special Graphics: line drawing characters
+	charsetGraphics
+)
+
+// decGraphics maps 0x5f-0x7e to the DEC Special Graphics characters.
+var decGraphics = []rune(" ◆▒␉␌␍␊°±␤␋┘┐┌└┼⎺⎻─⎼⎽├┤┴┬│≤≥π≠£·")
+
+// cursor is the cursor state saved by DECSC.
+type cursor struct {
+	x    int
+	y    int
+	attr Attr
+	// set after writing the last column: the next character wraps
+	wrapNext bool
+	origin   bool
+	charsets [2]int
+	// the charset in use: 0 for G0 (SI) or 1 for G1 (SO)
+	shift int
+}
+
+// buffer is the primary or alternate screen.
+type buffer struct {
+	lines [][]Cell
+	saved cursor
+}
+
+// Screen is the state of a terminal. It implements io.Writer: write the program's output to
+// update it. It is safe for concurrent use.
+type Screen struct {
+	mu      sync.Mutex
+	columns int
+	rows    int
+
+	primary   buffer
+	alternate buffer
+	// the buffer in use: primary or alternate
+	active *buffer
+
+	cursor cursor
+	// scroll region: top and bottom rows, inclusive
+	top    int
+	bottom int
+
+	autoWrap      bool
+	insert        bool
+	cursorVisible bool
+	tabs          []bool
+	title         string
+
+	parser parser
+}
+
+// NewScreen returns a blank screen. Invalid sizes are replaced with the default.
+func NewScreen(columns int, rows int) *Screen {
+	s := &Screen{}
+	columns, rows = validSize(columns, rows)
+	s.columns = columns
+	s.rows = rows
+	s.reset()
+	return s
+}
+
+func validSize(columns int, rows int) (int, int) {
+	if columns <= 0 {
+		columns = DefaultColumns
+	}
+	if rows <= 0 {
+		rows = DefaultRows
+	}
+	return columns, rows
+}
+
+// reset clears all state except the size (RIS).
+func (s *Screen) reset() {
+	s.primary = buffer{lines: s.blankLines(s.rows)}
+	s.alternate = buffer{lines: s.blankLines(s.rows)}
+	s.active = &s.primary
+	s.cursor = cursor{}
+	s.top = 0
+	s.bottom = s.rows - 1
+	s.autoWrap = true
+	s.insert = false
+	s.cursorVisible = true
+	s.tabs = make([]bool, s.columns)
+	s.resetTabs(0)
+	s.title = ""
+	s.parser = parser{}
+}
+
+// resetTabs sets a tab stop every 8 columns, starting at column from.
+func (s *Screen) resetTabs(from int) {
+	for x := from; x < len(s.tabs); x++ {
+		s.tabs[x] = x%8 == 0 && x != 0
+	}
+}
+
+func (s *Screen) blankLines(n int) [][]Cell {
+	lines := make([][]Cell, n)
+	for i := range lines {
+		lines[i] = s.blankLine(Attr{})
+	}
+	return lines
+}
+
+// blank returns an erased cell. Erasing uses the current background color.
+func blank(attr Attr) Cell {
+	return Cell{Rune: ' ', Attr: Attr{Background: attr.Background}}
+}
+
+func (s *Screen) blankLine(attr Attr) []Cell {
+	line := make([]Cell, s.columns)
+	b := blank(attr)
+	for i := range line {
+		line[i] = b
+	}
+	return line
+}
+
+// Size returns the number of columns and rows.
+func (s *Screen) Size() (int, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.columns, s.rows
+}
+
+// Resize changes the size of the screen. Lines are truncated or extended on the right. If the
+// screen gets shorter, lines are removed from the top so the cursor stays on screen.
+func (s *Screen) Resize(columns int, rows int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	columns, rows = validSize(columns, rows)
+	if columns == s.columns && rows == s.rows {
+		return
+	}
+
+	oldColumns := s.columns
+	s.columns = columns
+	for _, b := range []*buffer{&s.primary, &s.alternate} {
+		// remove lines from the top if the cursor would be below the screen
+		remove := 0
+		y := s.cursor.y
+		if b != s.active {
+			y = b.saved.y
+		}
+		if y >= rows {
+			remove = y - rows + 1
+		}
+		b.lines = b.lines[remove:]
+		if b == s.active {
+			s.cursor.y -= remove
+		} else {
+			b.saved.y -= remove
+		}
+
+		for i, line := range b.lines {
+			b.lines[i] = s.resizeLine(line)
+		}
+		if len(b.lines) > rows {
+			b.lines = b.lines[:rows]
+		}
+		for len(b.lines) < rows {
+			b.lines = append(b.lines, s.blankLine(Attr{}))
+		}
+		b.saved.x = clamp(b.saved.x, 0, columns-1)
+		b.saved.y = clamp(b.saved.y, 0, rows-1)
+	}
+	s.rows = rows
+
+	tabs := make([]bool, columns)
+	copy(tabs, s.tabs)
+	s.tabs = tabs
+	if columns > oldColumns {
+		s.resetTabs(oldColumns)
+	}
+
+	s.top = 0
+	s.bottom = rows - 1
+	s.cursor.x = clamp(s.cursor.x, 0, columns-1)
+	s.cursor.y = clamp(s.cursor.y, 0, rows-1)
+	s.cursor.wrapNext = false
+}
+
+// resizeLine returns line with s.columns cells.
+func (s *Screen) resizeLine(line []Cell) []Cell {
+	if len(line) > s.columns {
+		line = line[:s.columns]
+		// do not leave half of a wide character
+		if line[s.columns-1].Wide {
+			line[s.columns-1] = blank(line[s.columns-1].Attr)
+		}
+		return line
+	}
+	for len(line) < s.columns {
+		line = append(line, blank(Attr{}))
+	}
+	return line
+}
+
+func clamp(v int, min int, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// Cell returns the cell at column x and row y, counting from 0. It must be on the screen.
+func (s *Screen) Cell(x int, y int) Cell {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active.lines[y][x]
+}
+
+// Cursor returns the cursor position and whether it is visible.
+func (s *Screen) Cursor() (int, int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursor.x, s.cursor.y, s.cursorVisible
+}
+
+// Title returns the window title set by the program.
+func (s *Screen) Title() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.title
+}
+
+// AlternateScreen returns true if the program switched to the alternate screen, which full
+// screen programs like vi use.
+func (s *Screen) AlternateScreen() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.active == &s.alternate
+}
+
+// lineText returns the text of line, without trailing spaces.
+func lineText(line []Cell) string {
+	var b strings.Builder
+	for _, cell := range line {
+		if cell.Rune == 0 {
+			continue
+		}
+		b.WriteRune(cell.Rune)
+		b.WriteString(cell.Combining)
+	}
+	return strings.TrimRight(b.String(), " ")
+}
+
+// Lines returns the text of each row, without trailing spaces or attributes.
+func (s *Screen) Lines() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lines := make([]string, len(s.active.lines))
+	for i, line := range s.active.lines {
+		lines[i] = lineText(line)
+	}
+	return lines
+}
+
+// String returns the text on the screen, one line per row.
+func (s *Screen) String() string {
+	return strings.Join(s.Lines(), "\n")
+}
+
+// Snapshot is a copy of the screen at one moment.
+type Snapshot struct {
+	Columns int
+	Rows    int
+	// Cells contains Rows lines of Columns cells.
+	Cells         [][]Cell
+	CursorX       int
+	CursorY       int
+	CursorVisible bool
+	Title         string
+	Alternate     bool
+}
+
+// Lines returns the text of each row, without trailing spaces or attributes.
+func (s *Snapshot) Lines() []string {
+	lines := make([]string, len(s.Cells))
+	for i, line := range s.Cells {
+		lines[i] = lineText(line)
+	}
+	return lines
+}
+
+// Snapshot returns a copy of the screen's contents and state.
+func (s *Screen) Snapshot() *Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cells := make([][]Cell, len(s.active.lines))
+	for i, line := range s.active.lines {
+		cells[i] = append([]Cell(nil), line...)
+	}
+	return &Snapshot{s.columns, s.rows, cells, s.cursor.x, s.cursor.y, s.cursorVisible, s.title,
+		s.active == &s.alternate}
+}
+
+// put writes r at the cursor and advances it.
+func (s *Screen) put(r rune) {
+	c := &s.cursor
+	if s.cursor.charsets[c.shift] == charsetGraphics && 0x5f <= r && r <= 0x7e {
+		r = decGraphics[r-0x5f]
+	}
+
+	width := runeWidth(r)
+	if width == 0 {
+		s.combine(r)
+		return
+	}
+	if width > s.columns {
+		// a wide character can't fit on a one column screen
+		return
+	}
+
+	if c.wrapNext && s.autoWrap {
+		s.wrap()
+	}
+	if c.x+width > s.columns {
+		if !s.autoWrap {
+			c.x = s.columns - width
+		} else {
+			// a wide character at the last column: blank it and continue on the next line
+			s.clearCells(s.active.lines[c.y], c.x, s.columns)
+			s.wrap()
+		}
+	}
+
+	line := s.active.lines[c.y]
+	if s.insert {
+		s.insertCells(line, c.x, width)
+	}
+	s.fixWide(line, c.x)
+	if width == 2 {
+		s.fixWide(line, c.x+1)
+	}
+	line[c.x] = Cell{Rune: r, Wide: width == 2, Attr: c.attr}
+	if width == 2 {
+		line[c.x+1] = Cell{Attr: c.attr}
+	}
+
+	if c.x+width >= s.columns {
+		c.x = s.columns - 1
+		c.wrapNext = s.autoWrap
+	} else {
+		c.x += width
+	}
+}
+
+// wrap moves the cursor to the start of the next line, scrolling if needed.
+func (s *Screen) wrap() {
+	s.cursor.x = 0
+	s.cursor.wrapNext = false
+	s.index()
+}
+
+// combine adds a combining character to the previous character.
+func (s *Screen) combine(r rune) {
+	c := &s.cursor
+	x := c.x - 1
+	if c.wrapNext {
+		x = c.x
+	}
+	line := s.active.lines[c.y]
+	if x > 0 && line[x].Rune == 0 {
+		// second half of a wide character
+		x--
+	}
+	if x < 0 || line[x].Rune == 0 {
+		return
+	}
+	line[x].Combining += string(r)
+}
+
+// fixWide blanks the other half of a wide character if x is part of one, because it is about to
+// be overwritten.
+func (s *Screen) fixWide(line []Cell, x int) {
+	if x < 0 || x >= len(line) {
+		return
+	}
+	if line[x].Wide && x+1 < len(line) {
+		line[x+1] = blank(line[x+1].Attr)
+	} else if line[x].Rune == 0 && x > 0 {
+		line[x-1] = blank(line[x-1].Attr)
+	}
+}
+
+// clearCells erases cells [start, end) of line.
+func (s *Screen) clearCells(line []Cell, start int, end int) {
+	start = clamp(start, 0, len(line))
+	end = clamp(end, 0, len(line))
+	if start >= end {
+		return
+	}
+	s.fixWide(line, start)
+	s.fixWide(line, end-1)
+	b := blank(s.cursor.attr)
+	for x := start; x < end; x++ {
+		line[x] = b
+	}
+}
+
+// insertCells shifts the cells from x right by n, discarding cells past the end (ICH).
+func (s *Screen) insertCells(line []Cell, x int, n int) {
+	s.fixWide(line, x)
+	n = clamp(n, 0, len(line)-x)
+	copy(line[x+n:], line[x:])
+	s.clearCells(line, x, x+n)
+	// the last cell may now be half of a wide character
+	if last := len(line) - 1; line[last].Wide {
+		line[last] = blank(line[last].Attr)
+	}
+}
+
+// deleteCells shifts the cells after x left by n, adding blanks at the end (DCH).
+func (s *Screen) deleteCells(line []Cell, x int, n int) {
+	n = clamp(n, 0, len(line)-x)
+	s.fixWide(line, x)
+	s.fixWide(line, x+n)
+	copy(line[x:], line[x+n:])
+	b := blank(s.cursor.attr)
+	for i := len(line) - n; i < len(line); i++ {
+		line[i] = b
+	}
+}
+
+// index moves the cursor down, scrolling the region if it is at the bottom margin (IND, LF).
+func (s *Screen) index() {
+	if s.cursor.y == s.bottom {
+		s.scrollUp(s.top, s.bottom, 1)
+	} else if s.cursor.y < s.rows-1 {
+		s.cursor.y++
+	}
+}
+
+// reverseIndex moves the cursor up, scrolling the region if it is at the top margin (RI).
+func (s *Screen) reverseIndex() {
+	if s.cursor.y == s.top {
+		s.scrollDown(s.top, s.bottom, 1)
+	} else if s.cursor.y > 0 {
+		s.cursor.y--
+	}
+}
+
+// scrollUp moves lines top to bottom up by n, adding blank lines at the bottom.
+func (s *Screen) scrollUp(top int, bottom int, n int) {
+	lines := s.active.lines
+	n = clamp(n, 0, bottom-top+1)
+	copy(lines[top:bottom+1], lines[top+n:bottom+1])
+	for y := bottom - n + 1; y <= bottom; y++ {
+		lines[y] = s.blankLine(s.cursor.attr)
+	}
+}
+
+// scrollDown moves lines top to bottom down by n, adding blank lines at the top.
+func (s *Screen) scrollDown(top int, bottom int, n int) {
+	lines := s.active.lines
+	n = clamp(n, 0, bottom-top+1)
+	copy(lines[top+n:bottom+1], lines[top:bottom+1-n])
+	for y := top; y < top+n; y++ {
+		lines[y] = s.blankLine(s.cursor.attr)
+	}
+}
+
+// moveTo moves the cursor to x, y, which are relative to the scroll region in origin mode.
+func (s *Screen) moveTo(x int, y int) {
+	minY, maxY := 0, s.rows-1
+	if s.cursor.origin {
+		y += s.top
+		minY, maxY = s.top, s.bottom
+	}
+	s.cursor.x = clamp(x, 0, s.columns-1)
+	s.cursor.y = clamp(y, minY, maxY)
+	s.cursor.wrapNext = false
+}
+
+// moveVertical moves the cursor up or down by n rows, stopping at the scroll region's margins
+// if it starts inside the region (CUU, CUD).
+func (s *Screen) moveVertical(n int) {
+	c := &s.cursor
+	minY, maxY := 0, s.rows-1
+	if c.y >= s.top && c.y <= s.bottom {
+		minY, maxY = s.top, s.bottom
+	}
+	c.y = clamp(c.y+n, minY, maxY)
+	c.wrapNext = false
+}
+
+// tab moves the cursor to the nth next tab stop, or the previous one if n is negative.
+func (s *Screen) tab(n int) {
+	c := &s.cursor
+	for ; n > 0 && c.x < s.columns-1; n-- {
+		c.x++
+		for c.x < s.columns-1 && !s.tabs[c.x] {
+			c.x++
+		}
+	}
+	for ; n < 0 && c.x > 0; n++ {
+		c.x--
+		for c.x > 0 && !s.tabs[c.x] {
+			c.x--
+		}
+	}
+	c.wrapNext = false
+}
+
+// eraseDisplay implements ED.
+func (s *Screen) eraseDisplay(mode int) {
+	c := &s.cursor
+	lines := s.active.lines
+	switch mode {
+	case 0:
+		s.clearCells(lines[c.y], c.x, s.columns)
+		for y := c.y + 1; y < s.rows; y++ {
+			s.clearCells(lines[y], 0, s.columns)
+		}
+	case 1:
+		for y := 0; y < c.y; y++ {
+			s.clearCells(lines[y], 0, s.columns)
+		}
+		s.clearCells(lines[c.y], 0, c.x+1)
+	case 2, 3:
+		for y := range lines {
+			s.clearCells(lines[y], 0, s.columns)
+		}
+	}
+	c.wrapNext = false
+}
+
+// eraseLine implements EL.
+func (s *Screen) eraseLine(mode int) {
+	c := &s.cursor
+	line := s.active.lines[c.y]
+	switch mode {
+	case 0:
+		s.clearCells(line, c.x, s.columns)
+	case 1:
+		s.clearCells(line, 0, c.x+1)
+	case 2:
+		s.clearCells(line, 0, s.columns)
+	}
+	c.wrapNext = false
+}
+
+// saveCursor implements DECSC. Each screen buffer has its own saved cursor.
+func (s *Screen) saveCursor() {
+	s.active.saved = s.cursor
+}
+
+// restoreCursor implements DECRC.
+func (s *Screen) restoreCursor() {
+	s.cursor = s.active.saved
+	s.cursor.x = clamp(s.cursor.x, 0, s.columns-1)
+	s.cursor.y = clamp(s.cursor.y, 0, s.rows-1)
+}
+
+// useAlternate switches between the primary and alternate screen buffers.
+func (s *Screen) useAlternate(alternate bool, clear bool) {
+	if alternate == (s.active == &s.alternate) {
+		return
+	}
+	if alternate {
+		s.active = &s.alternate
+		if clear {
+			s.alternate.lines = s.blankLines(s.rows)
+		}
+	} else {
+		if clear {
+			s.alternate.lines = s.blankLines(s.rows)
+		}
+		s.active = &s.primary
+	}
+}
+
+// setScrollRegion implements DECSTBM: top and bottom are 1-based, or 0 for the default.
+func (s *Screen) setScrollRegion(top int, bottom int) {
+	if top == 0 {
+		top = 1
+	}
+	if bottom == 0 || bottom > s.rows {
+		bottom = s.rows
+	}
+	if top >= bottom {
+		return
+	}
+	s.top = top - 1
+	s.bottom = bottom - 1
+	s.moveTo(0, 0)
+}
+
+// alignmentTest implements DECALN: fills the screen with E.
+func (s *Screen) alignmentTest() {
+	for _, line := range s.active.lines {
+		for x := range line {
+			line[x] = Cell{Rune: 'E'}
+		}
+	}
+	s.top = 0
+	s.bottom = s.rows - 1
+	s.cursor.origin = false
+	s.moveTo(0, 0)
+}
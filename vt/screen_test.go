@@ -0,0 +1,297 @@
+package vt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newTestScreen returns a screen after writing output.
+func newTestScreen(columns int, rows int, output string) *Screen {
+	s := NewScreen(columns, rows)
+	s.Write([]byte(output))
+	return s
+}
+
+func checkLines(t *testing.T, s *Screen, expected ...string) {
+	t.Helper()
+	lines := s.Lines()
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("unexpected screen:\n%s\nexpected:\n%s",
+			strings.Join(lines, "|\n"), strings.Join(expected, "|\n"))
+	}
+}
+
+func checkCursor(t *testing.T, s *Screen, x int, y int) {
+	t.Helper()
+	cx, cy, _ := s.Cursor()
+	if cx != x || cy != y {
+		t.Errorf("cursor at %d,%d; expected %d,%d", cx, cy, x, y)
+	}
+}
+
+func TestText(t *testing.T) {
+	s := newTestScreen(5, 3, "hello world\r\nx")
+	checkLines(t, s, " worl", "d", "x")
+	checkCursor(t, s, 1, 2)
+
+	// the cursor stays on the last column until the next character
+	s = newTestScreen(5, 3, "hello")
+	checkCursor(t, s, 4, 0)
+	s.Write([]byte("\r\n"))
+	checkLines(t, s, "hello", "", "")
+	checkCursor(t, s, 0, 1)
+
+	// scrolls at the bottom
+	s = newTestScreen(5, 2, "1\r\n2\r\n3")
+	checkLines(t, s, "2", "3")
+
+	// autowrap off: overwrites the last column
+	s = newTestScreen(5, 2, "\x1b[?7lhello world")
+	checkLines(t, s, "helld", "")
+
+	// tabs, backspace
+	s = newTestScreen(20, 1, "a\tb\x08c\x1b[2Id")
+	checkLines(t, s, "a       c          d")
+}
+
+func TestCursorMovement(t *testing.T) {
+	s := newTestScreen(10, 5, "\x1b[3;4Hx\x1b[Ay\x1b[2Bz\x1b[10C!\x1b[3D@")
+	checkLines(t, s, "", "    y", "   x", "     z@  !", "")
+	checkCursor(t, s, 7, 3)
+
+	s = newTestScreen(10, 5, "\x1b[5Gx\x1b[2dy\x1b[99;99Hz\x1b[Fa\x1b[Eb")
+	checkLines(t, s, "    x", "     y", "", "a", "b        z")
+
+	// save and restore
+	s = newTestScreen(10, 3, "ab\x1b7\x1b[3;1Hcd\x1b8ef\x1b[sgh\x1b[1;1H\x1b[ui")
+	checkLines(t, s, "abefih", "", "cd")
+}
+
+func TestErase(t *testing.T) {
+	const fill = "abcde\r\nfghij\r\nklmno\x1b[2;3H"
+	s := newTestScreen(5, 3, fill+"\x1b[J")
+	checkLines(t, s, "abcde", "fg", "")
+	s = newTestScreen(5, 3, fill+"\x1b[1J")
+	checkLines(t, s, "", "   ij", "klmno")
+	s = newTestScreen(5, 3, fill+"\x1b[2J")
+	checkLines(t, s, "", "", "")
+	s = newTestScreen(5, 3, fill+"\x1b[K")
+	checkLines(t, s, "abcde", "fg", "klmno")
+	s = newTestScreen(5, 3, fill+"\x1b[1K")
+	checkLines(t, s, "abcde", "   ij", "klmno")
+	s = newTestScreen(5, 3, fill+"\x1b[2K")
+	checkLines(t, s, "abcde", "", "klmno")
+	s = newTestScreen(5, 3, fill+"\x1b[2X")
+	checkLines(t, s, "abcde", "fg  j", "klmno")
+
+	// insert and delete characters
+	s = newTestScreen(5, 3, fill+"\x1b[2@")
+	checkLines(t, s, "abcde", "fg  h", "klmno")
+	s = newTestScreen(5, 3, fill+"\x1b[P")
+	checkLines(t, s, "abcde", "fgij", "klmno")
+	s = newTestScreen(5, 3, fill+"\x1b[4hXY\x1b[4lZ")
+	checkLines(t, s, "abcde", "fgXYZ", "klmno")
+
+	// erasing uses the background color
+	s = newTestScreen(5, 1, "\x1b[41m\x1b[2K")
+	if s.Cell(0, 0).Attr.Background != PaletteColor(1) {
+		t.Error("expected the erased cell to use the background color:", s.Cell(0, 0))
+	}
+}
+
+func TestSGR(t *testing.T) {
+	s := newTestScreen(20, 1, "\x1b[1;4;31ma\x1b[22;39;42mb\x1b[38;5;200;48;2;1;2;3mc\x1b[38:2::4:5:6md\x1b[0me\x1b[7;97mf")
+	expected := []Attr{
+		{Foreground: PaletteColor(1), Flags: Bold | Underline},
+		{Background: PaletteColor(2), Flags: Underline},
+		{Foreground: PaletteColor(200), Background: RGBColor(1, 2, 3), Flags: Underline},
+		{Foreground: RGBColor(4, 5, 6), Background: RGBColor(1, 2, 3), Flags: Underline},
+		{},
+		{Foreground: PaletteColor(15), Flags: Reverse},
+	}
+	for x, attr := range expected {
+		if s.Cell(x, 0).Attr != attr {
+			t.Errorf("cell %d: unexpected attr %#v; expected %#v", x, s.Cell(x, 0).Attr, attr)
+		}
+	}
+
+	r, g, b, ok := RGBColor(1, 2, 3).RGB()
+	if r != 1 || g != 2 || b != 3 || !ok {
+		t.Error("unexpected RGB", r, g, b, ok)
+	}
+	if _, ok := ColorDefault.Palette(); ok {
+		t.Error("the default color is not a palette color")
+	}
+}
+
+func TestScrollRegion(t *testing.T) {
+	const fill = "1\r\n2\r\n3\r\n4\r\n5"
+	// scroll region rows 2-4: line feeds at the bottom margin only scroll the region
+	s := newTestScreen(5, 5, fill+"\x1b[2;4r\x1b[4;1H\na\nb")
+	checkLines(t, s, "1", "4", "a", " b", "5")
+
+	// reverse index at the top margin
+	s = newTestScreen(5, 5, fill+"\x1b[2;4r\x1b[2;1H\x1bMx")
+	checkLines(t, s, "1", "x", "2", "3", "5")
+
+	// insert and delete lines
+	s = newTestScreen(5, 5, fill+"\x1b[2;4r\x1b[3;2H\x1b[Lx")
+	checkLines(t, s, "1", "2", "x", "3", "5")
+	s = newTestScreen(5, 5, fill+"\x1b[2;4r\x1b[2;1H\x1b[2M")
+	checkLines(t, s, "1", "4", "", "", "5")
+
+	// scroll up and down
+	s = newTestScreen(5, 5, fill+"\x1b[2;4r\x1b[S")
+	checkLines(t, s, "1", "3", "4", "", "5")
+	s = newTestScreen(5, 5, fill+"\x1b[2;4r\x1b[2T")
+	checkLines(t, s, "1", "", "", "2", "5")
+
+	// origin mode: positions are relative to the region
+	s = newTestScreen(5, 5, fill+"\x1b[2;4r\x1b[?6h\x1b[1;1Hx\x1b[9;1Hy")
+	checkLines(t, s, "1", "x", "3", "y", "5")
+}
+
+func TestAlternateScreen(t *testing.T) {
+	s := newTestScreen(5, 3, "abc\x1b[?1049h")
+	if !s.AlternateScreen() {
+		t.Error("expected the alternate screen")
+	}
+	checkLines(t, s, "", "", "")
+	checkCursor(t, s, 3, 0)
+	s.Write([]byte("\x1b[2;2Hvi"))
+	checkLines(t, s, "", " vi", "")
+
+	s.Write([]byte("\x1b[?1049l"))
+	if s.AlternateScreen() {
+		t.Error("expected the primary screen")
+	}
+	checkLines(t, s, "abc", "", "")
+	checkCursor(t, s, 3, 0)
+
+	// 47 keeps the contents of the alternate screen
+	s.Write([]byte("\x1b[?47hxyz\x1b[?47l\x1b[?47h"))
+	checkLines(t, s, "   xy", "zvi", "")
+}
+
+func TestWideAndCombining(t *testing.T) {
+	s := newTestScreen(5, 2, "a世b")
+	checkLines(t, s, "a世b", "")
+	if !s.Cell(1, 0).Wide || s.Cell(2, 0).Rune != 0 {
+		t.Errorf("expected a wide character: %#v %#v", s.Cell(1, 0), s.Cell(2, 0))
+	}
+	checkCursor(t, s, 4, 0)
+
+	// a wide character does not fit in the last column: it wraps
+	s.Write([]byte("界"))
+	checkLines(t, s, "a世b", "界")
+	checkCursor(t, s, 2, 1)
+
+	// overwriting half of a wide character erases the other half
+	s = newTestScreen(5, 1, "世界\x1b[1;2Hx")
+	checkLines(t, s, " x界")
+	s = newTestScreen(5, 1, "世界\x1b[1;3H\x1b[P")
+	checkLines(t, s, "世")
+
+	// combining characters join the previous character
+	s = newTestScreen(5, 1, "éx")
+	checkLines(t, s, "éx")
+	checkCursor(t, s, 2, 0)
+	if s.Cell(0, 0).Combining != "́" {
+		t.Errorf("unexpected cell: %#v", s.Cell(0, 0))
+	}
+}
+
+func TestUTF8(t *testing.T) {
+	s := NewScreen(10, 1)
+	// split across writes
+	data := []byte("é世🙂")
+	for i := range data {
+		s.Write(data[i : i+1])
+	}
+	checkLines(t, s, "é世🙂")
+
+	// invalid sequences are replaced
+	s = newTestScreen(10, 1, "a\xffb\xe4\xb8c")
+	checkLines(t, s, "a�b�c")
+}
+
+func TestSequences(t *testing.T) {
+	// titles end with BEL or ST; other strings are ignored
+	s := newTestScreen(10, 1, "\x1b]0;first\x07a\x1b]2;second\x1b\\b\x1bPignored\x1b\\c")
+	checkLines(t, s, "abc")
+	if s.Title() != "second" {
+		t.Error("unexpected title:", s.Title())
+	}
+
+	// controls are executed inside a sequence; CAN aborts it
+	s = newTestScreen(10, 2, "\x1b[1\n;2Hx\x1b[3\x18y")
+	checkLines(t, s, " xy", "")
+
+	// DEC line drawing in G0, and G1 with shift out
+	s = newTestScreen(10, 1, "\x1b(0lqk\x1b(Bx\x1b)0\x0eq\x0fq")
+	checkLines(t, s, "┌─┐x─q")
+
+	// cursor visibility, DECSCUSR is ignored
+	s = newTestScreen(10, 1, "\x1b[?25l\x1b[2 qa")
+	if _, _, visible := s.Cursor(); visible {
+		t.Error("expected the cursor to be hidden")
+	}
+	checkLines(t, s, "a")
+
+	// reset
+	s = newTestScreen(10, 2, "\x1b[31mabc\x1b]0;t\x07\x1bcx")
+	checkLines(t, s, "x", "")
+	if s.Title() != "" || s.Cell(0, 0).Attr != (Attr{}) {
+		t.Error("expected reset:", s.Title(), s.Cell(0, 0))
+	}
+
+	// alignment test
+	s = newTestScreen(3, 2, "\x1b#8")
+	checkLines(t, s, "EEE", "EEE")
+
+	// sequences with too many intermediate bytes are ignored without storing them
+	s = newTestScreen(10, 1, "\x1b("+strings.Repeat(" ", 10000)+"0q\x1b["+strings.Repeat(" ", 10000)+"2Jx")
+	checkLines(t, s, "qx")
+	if len(s.parser.intermediates) > maxIntermediates {
+		t.Error("intermediates must be limited:", len(s.parser.intermediates))
+	}
+}
+
+func TestResize(t *testing.T) {
+	s := newTestScreen(5, 3, "abcde\r\nfg世\r\nhi")
+	s.Resize(3, 3)
+	checkLines(t, s, "abc", "fg", "hi")
+	s.Resize(6, 2)
+	// lines are removed from the top so the cursor stays on the screen
+	checkLines(t, s, "fg", "hi")
+	checkCursor(t, s, 2, 1)
+	s.Write([]byte("jklmno"))
+	checkLines(t, s, "hijklm", "no")
+
+	snapshot := s.Snapshot()
+	if snapshot.Columns != 6 || snapshot.Rows != 2 || snapshot.CursorX != 2 || snapshot.CursorY != 1 ||
+		!reflect.DeepEqual(snapshot.Lines(), []string{"hijklm", "no"}) {
+		t.Errorf("unexpected snapshot: %#v", snapshot)
+	}
+	// the snapshot is a copy
+	s.Write([]byte("\x1b[2J"))
+	if snapshot.Lines()[0] != "hijklm" {
+		t.Error("snapshot must not change")
+	}
+
+	s = NewScreen(0, -1)
+	columns, rows := s.Size()
+	if columns != DefaultColumns || rows != DefaultRows {
+		t.Error("expected the default size:", columns, rows)
+	}
+}
+
+func TestRuneWidth(t *testing.T) {
+	widths := map[rune]int{'a': 1, 'é': 1, '́': 0, '‍': 0, '世': 2, '한': 2, '🙂': 2, '─': 1, 'Ａ': 2}
+	for r, expected := range widths {
+		if runeWidth(r) != expected {
+			t.Errorf("runeWidth(%U) = %d; expected %d", r, runeWidth(r), expected)
+		}
+	}
+}